@@ -0,0 +1,55 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// +kubebuilder:object:generate=true
+package v1alpha1
+
+type TargetRefKind string
+
+var (
+	Mesh              TargetRefKind = "Mesh"
+	MeshSubset        TargetRefKind = "MeshSubset"
+	MeshService       TargetRefKind = "MeshService"
+	MeshServiceSubset TargetRefKind = "MeshServiceSubset"
+)
+
+var order = map[TargetRefKind]int{
+	Mesh:              1,
+	MeshSubset:        2,
+	MeshService:       3,
+	MeshServiceSubset: 4,
+}
+
+// Less reports whether the kind is less specific than the other one.
+// Policies targeting less specific kinds are overridden by the more specific ones.
+func (k TargetRefKind) Less(o TargetRefKind) bool {
+	return order[k] < order[o]
+}
+
+// TargetRef defines structure that allows attaching policy to various objects
+type TargetRef struct {
+	// Kind of the referenced resource
+	// +kubebuilder:validation:Enum=Mesh;MeshSubset;MeshService;MeshServiceSubset
+	Kind TargetRefKind `json:"kind,omitempty"`
+	// Name of the referenced resource. Can only be used with kinds: `MeshService` and `MeshServiceSubset`
+	Name string `json:"name,omitempty"`
+	// Tags used to select a subset of proxies by tags. Can only be used with kinds
+	// `MeshSubset` and `MeshServiceSubset`
+	Tags map[string]string `json:"tags,omitempty"`
+	// Mesh is reserved for future use to identify cross mesh resources.
+	Mesh string `json:"mesh,omitempty"`
+}
@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Actions of an AuthenticationPolicy
+const (
+	AuthenticationActionNone       = "NONE"
+	AuthenticationActionDisabled   = "DISABLED"
+	AuthenticationActionPermissive = "PERMISSIVE"
+	AuthenticationActionStrict     = "STRICT"
+)
+
+// Actions of an AuthorizationPolicy
+const (
+	AuthorizationActionAllow = "ALLOW"
+	AuthorizationActionDeny  = "DENY"
+	AuthorizationActionAudit = "AUDIT"
+)
+
+// Match types of the rules of an AuthorizationPolicy
+const (
+	AuthorizationMatchTypeAny = "anyMatch"
+	AuthorizationMatchTypeAll = "allMatch"
+)
+
+// Types of the values matched by the condition of an AuthorizationPolicy rule
+const (
+	AuthorizationMatchEquals = "equals"
+	AuthorizationMatchRegex  = "regex"
+	AuthorizationMatchOgnl   = "ognl"
+)
+
+const spiffeScheme = "spiffe://"
+
+// SpiffeID returns the SPIFFE identity issued to the workloads of the service in the mesh.
+func SpiffeID(mesh, service string) string {
+	return spiffeScheme + mesh + "/" + service
+}
+
+// PrincipalURI returns the URI SAN that a principal of a policy refers to.
+// Principals without a scheme are treated as SPIFFE identities.
+func PrincipalURI(principal string) string {
+	if strings.Contains(principal, "://") {
+		return principal
+	}
+	return spiffeScheme + principal
+}
+
+// ExtendURI returns the URI SAN that carries the extended identity of a workload.
+func ExtendURI(key, value string) string {
+	return fmt.Sprintf("dubbo://%s/%s", key, value)
+}
@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.0
+// source: api/mesh/v1alpha1/authentication_policy.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+)
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+import (
+	_ "github.com/apache/dubbo-kubernetes/api/mesh"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuthenticationPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The action to take when a rule is matched, one of NONE, DISABLED,
+	// PERMISSIVE and STRICT.
+	Action    string                           `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Selector  []*AuthenticationPolicySelector  `protobuf:"bytes,2,rep,name=selector,proto3" json:"selector,omitempty"`
+	PortLevel []*AuthenticationPolicyPortLevel `protobuf:"bytes,3,rep,name=portLevel,json=PortLevel,proto3" json:"portLevel,omitempty"`
+}
+
+func (x *AuthenticationPolicy) Reset() {
+	*x = AuthenticationPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticationPolicy) ProtoMessage() {}
+
+func (x *AuthenticationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticationPolicy.ProtoReflect.Descriptor instead.
+func (*AuthenticationPolicy) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authentication_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthenticationPolicy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuthenticationPolicy) GetSelector() []*AuthenticationPolicySelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *AuthenticationPolicy) GetPortLevel() []*AuthenticationPolicyPortLevel {
+	if x != nil {
+		return x.PortLevel
+	}
+	return nil
+}
+
+type AuthenticationPolicySelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The namespaces to match of the source workload.
+	Namespaces []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// The namespaces not to match of the source workload.
+	NotNamespaces []string `protobuf:"bytes,2,rep,name=notNamespaces,proto3" json:"notNamespaces,omitempty"`
+	// The IP addresses to match of the source workload.
+	IpBlocks []string `protobuf:"bytes,3,rep,name=ipBlocks,proto3" json:"ipBlocks,omitempty"`
+	// The IP addresses not to match of the source workload.
+	NotIpBlocks []string `protobuf:"bytes,4,rep,name=notIpBlocks,proto3" json:"notIpBlocks,omitempty"`
+	// The identities(from spiffe) to match of the source workload.
+	Principals []string `protobuf:"bytes,5,rep,name=principals,proto3" json:"principals,omitempty"`
+	// The identities(from spiffe) not to match of the source workload.
+	NotPrincipals []string `protobuf:"bytes,6,rep,name=notPrincipals,proto3" json:"notPrincipals,omitempty"`
+	// The extended identities(from Dubbo Auth) to match of the source workload.
+	Extends []*AuthenticationPolicyExtend `protobuf:"bytes,7,rep,name=extends,proto3" json:"extends,omitempty"`
+	// The extended identities(from Dubbo Auth) not to match of the source
+	// workload.
+	NotExtends []*AuthenticationPolicyExtend `protobuf:"bytes,8,rep,name=notExtends,proto3" json:"notExtends,omitempty"`
+}
+
+func (x *AuthenticationPolicySelector) Reset() {
+	*x = AuthenticationPolicySelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticationPolicySelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticationPolicySelector) ProtoMessage() {}
+
+func (x *AuthenticationPolicySelector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticationPolicySelector.ProtoReflect.Descriptor instead.
+func (*AuthenticationPolicySelector) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authentication_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuthenticationPolicySelector) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *AuthenticationPolicySelector) GetNotNamespaces() []string {
+	if x != nil {
+		return x.NotNamespaces
+	}
+	return nil
+}
+
+func (x *AuthenticationPolicySelector) GetIpBlocks() []string {
+	if x != nil {
+		return x.IpBlocks
+	}
+	return nil
+}
+
+func (x *AuthenticationPolicySelector) GetNotIpBlocks() []string {
+	if x != nil {
+		return x.NotIpBlocks
+	}
+	return nil
+}
+
+func (x *AuthenticationPolicySelector) GetPrincipals() []string {
+	if x != nil {
+		return x.Principals
+	}
+	return nil
+}
+
+func (x *AuthenticationPolicySelector) GetNotPrincipals() []string {
+	if x != nil {
+		return x.NotPrincipals
+	}
+	return nil
+}
+
+func (x *AuthenticationPolicySelector) GetExtends() []*AuthenticationPolicyExtend {
+	if x != nil {
+		return x.Extends
+	}
+	return nil
+}
+
+func (x *AuthenticationPolicySelector) GetNotExtends() []*AuthenticationPolicyExtend {
+	if x != nil {
+		return x.NotExtends
+	}
+	return nil
+}
+
+type AuthenticationPolicyPortLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port   int32  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *AuthenticationPolicyPortLevel) Reset() {
+	*x = AuthenticationPolicyPortLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticationPolicyPortLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticationPolicyPortLevel) ProtoMessage() {}
+
+func (x *AuthenticationPolicyPortLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticationPolicyPortLevel.ProtoReflect.Descriptor instead.
+func (*AuthenticationPolicyPortLevel) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authentication_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthenticationPolicyPortLevel) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *AuthenticationPolicyPortLevel) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type AuthenticationPolicyExtend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AuthenticationPolicyExtend) Reset() {
+	*x = AuthenticationPolicyExtend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticationPolicyExtend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticationPolicyExtend) ProtoMessage() {}
+
+func (x *AuthenticationPolicyExtend) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticationPolicyExtend.ProtoReflect.Descriptor instead.
+func (*AuthenticationPolicyExtend) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authentication_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuthenticationPolicyExtend) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AuthenticationPolicyExtend) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_api_mesh_v1alpha1_authentication_policy_proto protoreflect.FileDescriptor
+
+var file_api_mesh_v1alpha1_authentication_policy_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x13, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x1a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x02, 0x0a,
+	0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x09,
+	0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x3a, 0x76,
+	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x70, 0x0a, 0x1c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68,
+	0x52, 0x02, 0x10, 0x01, 0x3a, 0x2e, 0x12, 0x16, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x0a, 0x14,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x68, 0x01, 0x22, 0x84, 0x03, 0x0a, 0x1c, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x6f, 0x74,
+	0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x6f, 0x74, 0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e,
+	0x6f, 0x74, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x73, 0x12, 0x49, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x4f, 0x0a, 0x0a,
+	0x6e, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x4b, 0x0a,
+	0x1d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x1a, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x70, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2d, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_mesh_v1alpha1_authentication_policy_proto_rawDescOnce sync.Once
+	file_api_mesh_v1alpha1_authentication_policy_proto_rawDescData = file_api_mesh_v1alpha1_authentication_policy_proto_rawDesc
+)
+
+func file_api_mesh_v1alpha1_authentication_policy_proto_rawDescGZIP() []byte {
+	file_api_mesh_v1alpha1_authentication_policy_proto_rawDescOnce.Do(func() {
+		file_api_mesh_v1alpha1_authentication_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_mesh_v1alpha1_authentication_policy_proto_rawDescData)
+	})
+	return file_api_mesh_v1alpha1_authentication_policy_proto_rawDescData
+}
+
+var file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_mesh_v1alpha1_authentication_policy_proto_goTypes = []interface{}{
+	(*AuthenticationPolicy)(nil),          // 0: dubbo.mesh.v1alpha1.AuthenticationPolicy
+	(*AuthenticationPolicySelector)(nil),  // 1: dubbo.mesh.v1alpha1.AuthenticationPolicySelector
+	(*AuthenticationPolicyPortLevel)(nil), // 2: dubbo.mesh.v1alpha1.AuthenticationPolicyPortLevel
+	(*AuthenticationPolicyExtend)(nil),    // 3: dubbo.mesh.v1alpha1.AuthenticationPolicyExtend
+}
+var file_api_mesh_v1alpha1_authentication_policy_proto_depIdxs = []int32{
+	1, // 0: dubbo.mesh.v1alpha1.AuthenticationPolicy.selector:type_name -> dubbo.mesh.v1alpha1.AuthenticationPolicySelector
+	2, // 1: dubbo.mesh.v1alpha1.AuthenticationPolicy.portLevel:type_name -> dubbo.mesh.v1alpha1.AuthenticationPolicyPortLevel
+	3, // 2: dubbo.mesh.v1alpha1.AuthenticationPolicySelector.extends:type_name -> dubbo.mesh.v1alpha1.AuthenticationPolicyExtend
+	3, // 3: dubbo.mesh.v1alpha1.AuthenticationPolicySelector.notExtends:type_name -> dubbo.mesh.v1alpha1.AuthenticationPolicyExtend
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_mesh_v1alpha1_authentication_policy_proto_init() }
+func file_api_mesh_v1alpha1_authentication_policy_proto_init() {
+	if File_api_mesh_v1alpha1_authentication_policy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationPolicySelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationPolicyPortLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationPolicyExtend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_mesh_v1alpha1_authentication_policy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_mesh_v1alpha1_authentication_policy_proto_goTypes,
+		DependencyIndexes: file_api_mesh_v1alpha1_authentication_policy_proto_depIdxs,
+		MessageInfos:      file_api_mesh_v1alpha1_authentication_policy_proto_msgTypes,
+	}.Build()
+	File_api_mesh_v1alpha1_authentication_policy_proto = out.File
+	file_api_mesh_v1alpha1_authentication_policy_proto_rawDesc = nil
+	file_api_mesh_v1alpha1_authentication_policy_proto_goTypes = nil
+	file_api_mesh_v1alpha1_authentication_policy_proto_depIdxs = nil
+}
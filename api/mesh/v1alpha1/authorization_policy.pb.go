@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.0
+// source: api/mesh/v1alpha1/authorization_policy.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+)
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+import (
+	_ "github.com/apache/dubbo-kubernetes/api/mesh"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuthorizationPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The action to take when a rule is matched, one of ALLOW, DENY and AUDIT.
+	Action string                     `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Rules  []*AuthorizationPolicyRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	// The sample rate of the rule. The value is between 0 and 100.
+	Samples float32 `protobuf:"fixed32,3,opt,name=samples,proto3" json:"samples,omitempty"`
+	// The match type of the rules, either anyMatch or allMatch.
+	MatchType string `protobuf:"bytes,4,opt,name=matchType,proto3" json:"matchType,omitempty"`
+}
+
+func (x *AuthorizationPolicy) Reset() {
+	*x = AuthorizationPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationPolicy) ProtoMessage() {}
+
+func (x *AuthorizationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationPolicy.ProtoReflect.Descriptor instead.
+func (*AuthorizationPolicy) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authorization_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthorizationPolicy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuthorizationPolicy) GetRules() []*AuthorizationPolicyRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicy) GetSamples() float32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *AuthorizationPolicy) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+type AuthorizationPolicyRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The source of the traffic to be matched.
+	From *AuthorizationPolicySource `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// The destination of the traffic to be matched.
+	To   *AuthorizationPolicyTarget    `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	When *AuthorizationPolicyCondition `protobuf:"bytes,3,opt,name=when,proto3" json:"when,omitempty"`
+}
+
+func (x *AuthorizationPolicyRule) Reset() {
+	*x = AuthorizationPolicyRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationPolicyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationPolicyRule) ProtoMessage() {}
+
+func (x *AuthorizationPolicyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationPolicyRule.ProtoReflect.Descriptor instead.
+func (*AuthorizationPolicyRule) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authorization_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuthorizationPolicyRule) GetFrom() *AuthorizationPolicySource {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicyRule) GetTo() *AuthorizationPolicyTarget {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicyRule) GetWhen() *AuthorizationPolicyCondition {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+type AuthorizationPolicySource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The namespaces to match of the source workload.
+	Namespaces []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// The namespaces not to match of the source workload.
+	NotNamespaces []string `protobuf:"bytes,2,rep,name=notNamespaces,proto3" json:"notNamespaces,omitempty"`
+	// The IP addresses to match of the source workload.
+	IpBlocks []string `protobuf:"bytes,3,rep,name=ipBlocks,proto3" json:"ipBlocks,omitempty"`
+	// The IP addresses not to match of the source workload.
+	NotIpBlocks []string `protobuf:"bytes,4,rep,name=notIpBlocks,proto3" json:"notIpBlocks,omitempty"`
+	// The identities(from spiffe) to match of the source workload.
+	Principals []string `protobuf:"bytes,5,rep,name=principals,proto3" json:"principals,omitempty"`
+	// The identities(from spiffe) not to match of the source workload.
+	NotPrincipals []string `protobuf:"bytes,6,rep,name=notPrincipals,proto3" json:"notPrincipals,omitempty"`
+	// The extended identities(from Dubbo Auth) to match of the source workload.
+	Extends []*AuthorizationPolicyExtend `protobuf:"bytes,7,rep,name=extends,proto3" json:"extends,omitempty"`
+	// The extended identities(from Dubbo Auth) not to match of the source
+	// workload.
+	NotExtends []*AuthorizationPolicyExtend `protobuf:"bytes,8,rep,name=notExtends,proto3" json:"notExtends,omitempty"`
+}
+
+func (x *AuthorizationPolicySource) Reset() {
+	*x = AuthorizationPolicySource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationPolicySource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationPolicySource) ProtoMessage() {}
+
+func (x *AuthorizationPolicySource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationPolicySource.ProtoReflect.Descriptor instead.
+func (*AuthorizationPolicySource) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authorization_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthorizationPolicySource) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicySource) GetNotNamespaces() []string {
+	if x != nil {
+		return x.NotNamespaces
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicySource) GetIpBlocks() []string {
+	if x != nil {
+		return x.IpBlocks
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicySource) GetNotIpBlocks() []string {
+	if x != nil {
+		return x.NotIpBlocks
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicySource) GetPrincipals() []string {
+	if x != nil {
+		return x.Principals
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicySource) GetNotPrincipals() []string {
+	if x != nil {
+		return x.NotPrincipals
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicySource) GetExtends() []*AuthorizationPolicyExtend {
+	if x != nil {
+		return x.Extends
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicySource) GetNotExtends() []*AuthorizationPolicyExtend {
+	if x != nil {
+		return x.NotExtends
+	}
+	return nil
+}
+
+type AuthorizationPolicyTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The IP addresses to match of the destination workload.
+	IpBlocks []string `protobuf:"bytes,1,rep,name=ipBlocks,proto3" json:"ipBlocks,omitempty"`
+	// The IP addresses not to match of the destination workload.
+	NotIpBlocks []string `protobuf:"bytes,2,rep,name=notIpBlocks,proto3" json:"notIpBlocks,omitempty"`
+	// The identities(from spiffe) to match of the destination workload.
+	Principals []string `protobuf:"bytes,3,rep,name=principals,proto3" json:"principals,omitempty"`
+	// The identities(from spiffe) not to match of the destination workload.
+	NotPrincipals []string `protobuf:"bytes,4,rep,name=notPrincipals,proto3" json:"notPrincipals,omitempty"`
+	// The extended identities(from Dubbo Auth) to match of the destination
+	// workload.
+	Extends []*AuthorizationPolicyExtend `protobuf:"bytes,5,rep,name=extends,proto3" json:"extends,omitempty"`
+	// The extended identities(from Dubbo Auth) not to match of the destination
+	// workload.
+	NotExtends []*AuthorizationPolicyExtend `protobuf:"bytes,6,rep,name=notExtends,proto3" json:"notExtends,omitempty"`
+}
+
+func (x *AuthorizationPolicyTarget) Reset() {
+	*x = AuthorizationPolicyTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationPolicyTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationPolicyTarget) ProtoMessage() {}
+
+func (x *AuthorizationPolicyTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationPolicyTarget.ProtoReflect.Descriptor instead.
+func (*AuthorizationPolicyTarget) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authorization_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuthorizationPolicyTarget) GetIpBlocks() []string {
+	if x != nil {
+		return x.IpBlocks
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicyTarget) GetNotIpBlocks() []string {
+	if x != nil {
+		return x.NotIpBlocks
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicyTarget) GetPrincipals() []string {
+	if x != nil {
+		return x.Principals
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicyTarget) GetNotPrincipals() []string {
+	if x != nil {
+		return x.NotPrincipals
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicyTarget) GetExtends() []*AuthorizationPolicyExtend {
+	if x != nil {
+		return x.Extends
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicyTarget) GetNotExtends() []*AuthorizationPolicyExtend {
+	if x != nil {
+		return x.NotExtends
+	}
+	return nil
+}
+
+type AuthorizationPolicyCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string                      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values    []*AuthorizationPolicyMatch `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	NotValues []*AuthorizationPolicyMatch `protobuf:"bytes,3,rep,name=notValues,proto3" json:"notValues,omitempty"`
+}
+
+func (x *AuthorizationPolicyCondition) Reset() {
+	*x = AuthorizationPolicyCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationPolicyCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationPolicyCondition) ProtoMessage() {}
+
+func (x *AuthorizationPolicyCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationPolicyCondition.ProtoReflect.Descriptor instead.
+func (*AuthorizationPolicyCondition) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authorization_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthorizationPolicyCondition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AuthorizationPolicyCondition) GetValues() []*AuthorizationPolicyMatch {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *AuthorizationPolicyCondition) GetNotValues() []*AuthorizationPolicyMatch {
+	if x != nil {
+		return x.NotValues
+	}
+	return nil
+}
+
+type AuthorizationPolicyMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the match, one of equals, regex and ognl.
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AuthorizationPolicyMatch) Reset() {
+	*x = AuthorizationPolicyMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationPolicyMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationPolicyMatch) ProtoMessage() {}
+
+func (x *AuthorizationPolicyMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationPolicyMatch.ProtoReflect.Descriptor instead.
+func (*AuthorizationPolicyMatch) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authorization_policy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuthorizationPolicyMatch) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AuthorizationPolicyMatch) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AuthorizationPolicyExtend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AuthorizationPolicyExtend) Reset() {
+	*x = AuthorizationPolicyExtend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationPolicyExtend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationPolicyExtend) ProtoMessage() {}
+
+func (x *AuthorizationPolicyExtend) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationPolicyExtend.ProtoReflect.Descriptor instead.
+func (*AuthorizationPolicyExtend) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_authorization_policy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuthorizationPolicyExtend) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AuthorizationPolicyExtend) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_api_mesh_v1alpha1_authorization_policy_proto protoreflect.FileDescriptor
+
+var file_api_mesh_v1alpha1_authorization_policy_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13,
+	0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x1a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x02, 0x0a, 0x13,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x75, 0x62,
+	0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x72, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x6c, 0x0a,
+	0x1b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x13, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x52, 0x02, 0x10, 0x01, 0x3a, 0x2c, 0x0a, 0x13, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x68, 0x01, 0x22, 0xe4, 0x01, 0x0a, 0x17,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x3e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x45, 0x0a, 0x04, 0x77,
+	0x68, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x64, 0x75, 0x62, 0x62,
+	0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x77, 0x68,
+	0x65, 0x6e, 0x22, 0xff, 0x02, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x49, 0x70, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x50, 0x72, 0x69, 0x6e, 0x63,
+	0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x74,
+	0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x48, 0x0a, 0x07, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x75,
+	0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x73, 0x22, 0xb9, 0x02, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x50, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x48, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73,
+	0x12, 0x4e, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73,
+	0x22, 0xc4, 0x01, 0x0a, 0x1c, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x6e, 0x6f,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x09, 0x6e, 0x6f,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x43, 0x0a,
+	0x19, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x70, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2d, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_api_mesh_v1alpha1_authorization_policy_proto_rawDescOnce sync.Once
+	file_api_mesh_v1alpha1_authorization_policy_proto_rawDescData = file_api_mesh_v1alpha1_authorization_policy_proto_rawDesc
+)
+
+func file_api_mesh_v1alpha1_authorization_policy_proto_rawDescGZIP() []byte {
+	file_api_mesh_v1alpha1_authorization_policy_proto_rawDescOnce.Do(func() {
+		file_api_mesh_v1alpha1_authorization_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_mesh_v1alpha1_authorization_policy_proto_rawDescData)
+	})
+	return file_api_mesh_v1alpha1_authorization_policy_proto_rawDescData
+}
+
+var file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_mesh_v1alpha1_authorization_policy_proto_goTypes = []interface{}{
+	(*AuthorizationPolicy)(nil),          // 0: dubbo.mesh.v1alpha1.AuthorizationPolicy
+	(*AuthorizationPolicyRule)(nil),      // 1: dubbo.mesh.v1alpha1.AuthorizationPolicyRule
+	(*AuthorizationPolicySource)(nil),    // 2: dubbo.mesh.v1alpha1.AuthorizationPolicySource
+	(*AuthorizationPolicyTarget)(nil),    // 3: dubbo.mesh.v1alpha1.AuthorizationPolicyTarget
+	(*AuthorizationPolicyCondition)(nil), // 4: dubbo.mesh.v1alpha1.AuthorizationPolicyCondition
+	(*AuthorizationPolicyMatch)(nil),     // 5: dubbo.mesh.v1alpha1.AuthorizationPolicyMatch
+	(*AuthorizationPolicyExtend)(nil),    // 6: dubbo.mesh.v1alpha1.AuthorizationPolicyExtend
+}
+var file_api_mesh_v1alpha1_authorization_policy_proto_depIdxs = []int32{
+	1,  // 0: dubbo.mesh.v1alpha1.AuthorizationPolicy.rules:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicyRule
+	2,  // 1: dubbo.mesh.v1alpha1.AuthorizationPolicyRule.from:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicySource
+	3,  // 2: dubbo.mesh.v1alpha1.AuthorizationPolicyRule.to:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicyTarget
+	4,  // 3: dubbo.mesh.v1alpha1.AuthorizationPolicyRule.when:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicyCondition
+	6,  // 4: dubbo.mesh.v1alpha1.AuthorizationPolicySource.extends:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicyExtend
+	6,  // 5: dubbo.mesh.v1alpha1.AuthorizationPolicySource.notExtends:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicyExtend
+	6,  // 6: dubbo.mesh.v1alpha1.AuthorizationPolicyTarget.extends:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicyExtend
+	6,  // 7: dubbo.mesh.v1alpha1.AuthorizationPolicyTarget.notExtends:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicyExtend
+	5,  // 8: dubbo.mesh.v1alpha1.AuthorizationPolicyCondition.values:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicyMatch
+	5,  // 9: dubbo.mesh.v1alpha1.AuthorizationPolicyCondition.notValues:type_name -> dubbo.mesh.v1alpha1.AuthorizationPolicyMatch
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_api_mesh_v1alpha1_authorization_policy_proto_init() }
+func file_api_mesh_v1alpha1_authorization_policy_proto_init() {
+	if File_api_mesh_v1alpha1_authorization_policy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationPolicyRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationPolicySource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationPolicyTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationPolicyCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationPolicyMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationPolicyExtend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_mesh_v1alpha1_authorization_policy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_mesh_v1alpha1_authorization_policy_proto_goTypes,
+		DependencyIndexes: file_api_mesh_v1alpha1_authorization_policy_proto_depIdxs,
+		MessageInfos:      file_api_mesh_v1alpha1_authorization_policy_proto_msgTypes,
+	}.Build()
+	File_api_mesh_v1alpha1_authorization_policy_proto = out.File
+	file_api_mesh_v1alpha1_authorization_policy_proto_rawDesc = nil
+	file_api_mesh_v1alpha1_authorization_policy_proto_goTypes = nil
+	file_api_mesh_v1alpha1_authorization_policy_proto_depIdxs = nil
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.0
+// source: api/mesh/v1alpha1/ca_backends.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+)
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+import (
+	v1alpha1 "github.com/apache/dubbo-kubernetes/api/system/v1alpha1"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BuiltinCertificateAuthorityConfig defines configuration for Builtin CA
+// backend
+type BuiltinCertificateAuthorityConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Configuration of the root certificate
+	CaCert *BuiltinCertificateAuthorityConfig_CaCert `protobuf:"bytes,1,opt,name=caCert,proto3" json:"caCert,omitempty"`
+}
+
+func (x *BuiltinCertificateAuthorityConfig) Reset() {
+	*x = BuiltinCertificateAuthorityConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_ca_backends_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_ca_backends_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_ca_backends_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BuiltinCertificateAuthorityConfig) GetCaCert() *BuiltinCertificateAuthorityConfig_CaCert {
+	if x != nil {
+		return x.CaCert
+	}
+	return nil
+}
+
+// ProvidedCertificateAuthorityConfig defines configuration for Provided CA
+// backend
+type ProvidedCertificateAuthorityConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data source for the certificate of CA
+	Cert *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"`
+	// Data source for the key of CA
+	Key *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ProvidedCertificateAuthorityConfig) Reset() {
+	*x = ProvidedCertificateAuthorityConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_ca_backends_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvidedCertificateAuthorityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvidedCertificateAuthorityConfig) ProtoMessage() {}
+
+func (x *ProvidedCertificateAuthorityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_ca_backends_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvidedCertificateAuthorityConfig.ProtoReflect.Descriptor instead.
+func (*ProvidedCertificateAuthorityConfig) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_ca_backends_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProvidedCertificateAuthorityConfig) GetCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *ProvidedCertificateAuthorityConfig) GetKey() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// CaCert defines configuration for the root certificate of the CA
+type BuiltinCertificateAuthorityConfig_CaCert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of bits of the RSA key of the root certificate
+	RSAbits *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=RSAbits,proto3" json:"RSAbits,omitempty"`
+	// Time after which the root certificate will expire
+	Expiration string `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *BuiltinCertificateAuthorityConfig_CaCert) Reset() {
+	*x = BuiltinCertificateAuthorityConfig_CaCert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_ca_backends_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_CaCert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig_CaCert) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig_CaCert) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_ca_backends_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig_CaCert.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig_CaCert) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_ca_backends_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_CaCert) GetRSAbits() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.RSAbits
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig_CaCert) GetExpiration() string {
+	if x != nil {
+		return x.Expiration
+	}
+	return ""
+}
+
+var File_api_mesh_v1alpha1_ca_backends_proto protoreflect.FileDescriptor
+
+var file_api_mesh_v1alpha1_ca_backends_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x63, 0x61, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x61, 0x70, 0x69, 0x2f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xdc, 0x01, 0x0a, 0x21, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x55, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
+	0x61, 0x43, 0x65, 0x72, 0x74, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x1a, 0x60, 0x0a,
+	0x06, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x52, 0x53, 0x41, 0x62, 0x69,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x52, 0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x90, 0x01, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x33, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x75, 0x62,
+	0x62, 0x6f, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x70, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2d, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_api_mesh_v1alpha1_ca_backends_proto_rawDescOnce sync.Once
+	file_api_mesh_v1alpha1_ca_backends_proto_rawDescData = file_api_mesh_v1alpha1_ca_backends_proto_rawDesc
+)
+
+func file_api_mesh_v1alpha1_ca_backends_proto_rawDescGZIP() []byte {
+	file_api_mesh_v1alpha1_ca_backends_proto_rawDescOnce.Do(func() {
+		file_api_mesh_v1alpha1_ca_backends_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_mesh_v1alpha1_ca_backends_proto_rawDescData)
+	})
+	return file_api_mesh_v1alpha1_ca_backends_proto_rawDescData
+}
+
+var file_api_mesh_v1alpha1_ca_backends_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_mesh_v1alpha1_ca_backends_proto_goTypes = []interface{}{
+	(*BuiltinCertificateAuthorityConfig)(nil),        // 0: dubbo.mesh.v1alpha1.BuiltinCertificateAuthorityConfig
+	(*ProvidedCertificateAuthorityConfig)(nil),       // 1: dubbo.mesh.v1alpha1.ProvidedCertificateAuthorityConfig
+	(*BuiltinCertificateAuthorityConfig_CaCert)(nil), // 2: dubbo.mesh.v1alpha1.BuiltinCertificateAuthorityConfig.CaCert
+	(*v1alpha1.DataSource)(nil),                      // 3: dubbo.system.v1alpha1.DataSource
+	(*wrapperspb.UInt32Value)(nil),                   // 4: google.protobuf.UInt32Value
+}
+var file_api_mesh_v1alpha1_ca_backends_proto_depIdxs = []int32{
+	2, // 0: dubbo.mesh.v1alpha1.BuiltinCertificateAuthorityConfig.caCert:type_name -> dubbo.mesh.v1alpha1.BuiltinCertificateAuthorityConfig.CaCert
+	3, // 1: dubbo.mesh.v1alpha1.ProvidedCertificateAuthorityConfig.cert:type_name -> dubbo.system.v1alpha1.DataSource
+	3, // 2: dubbo.mesh.v1alpha1.ProvidedCertificateAuthorityConfig.key:type_name -> dubbo.system.v1alpha1.DataSource
+	4, // 3: dubbo.mesh.v1alpha1.BuiltinCertificateAuthorityConfig.CaCert.RSAbits:type_name -> google.protobuf.UInt32Value
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_mesh_v1alpha1_ca_backends_proto_init() }
+func file_api_mesh_v1alpha1_ca_backends_proto_init() {
+	if File_api_mesh_v1alpha1_ca_backends_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_mesh_v1alpha1_ca_backends_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_ca_backends_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvidedCertificateAuthorityConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_ca_backends_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig_CaCert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_mesh_v1alpha1_ca_backends_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_mesh_v1alpha1_ca_backends_proto_goTypes,
+		DependencyIndexes: file_api_mesh_v1alpha1_ca_backends_proto_depIdxs,
+		MessageInfos:      file_api_mesh_v1alpha1_ca_backends_proto_msgTypes,
+	}.Build()
+	File_api_mesh_v1alpha1_ca_backends_proto = out.File
+	file_api_mesh_v1alpha1_ca_backends_proto_rawDesc = nil
+	file_api_mesh_v1alpha1_ca_backends_proto_goTypes = nil
+	file_api_mesh_v1alpha1_ca_backends_proto_depIdxs = nil
+}
@@ -0,0 +1,430 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.0
+// source: api/mesh/v1alpha1/external_service.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+)
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+import (
+	_ "github.com/apache/dubbo-kubernetes/api/mesh"
+	v1alpha1 "github.com/apache/dubbo-kubernetes/api/system/v1alpha1"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExternalService defines configuration of a service that lives outside of
+// the mesh, i.e. a provider registered only in a legacy registry or a third
+// party API. Data plane proxies reach it like any other service of the mesh,
+// so the mesh policies are applied to the traffic as well.
+type ExternalService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Networking describes how the external service is reached.
+	Networking *ExternalService_Networking `protobuf:"bytes,1,opt,name=networking,proto3" json:"networking,omitempty"`
+	// Tags associated with the external service. "dubbo.io/service" is
+	// required, "dubbo.io/protocol" defines the protocol of the traffic and
+	// "dubbo.io/zone" limits the zone the service is reachable from.
+	Tags map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ExternalService) Reset() {
+	*x = ExternalService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_external_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalService) ProtoMessage() {}
+
+func (x *ExternalService) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_external_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalService.ProtoReflect.Descriptor instead.
+func (*ExternalService) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_external_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExternalService) GetNetworking() *ExternalService_Networking {
+	if x != nil {
+		return x.Networking
+	}
+	return nil
+}
+
+func (x *ExternalService) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ExternalService_Networking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the external service in the host:port format. The host can
+	// be either an IP or a hostname.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// TLS settings used to reach the external service
+	Tls *ExternalService_Networking_TLS `protobuf:"bytes,2,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *ExternalService_Networking) Reset() {
+	*x = ExternalService_Networking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_external_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalService_Networking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalService_Networking) ProtoMessage() {}
+
+func (x *ExternalService_Networking) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_external_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalService_Networking.ProtoReflect.Descriptor instead.
+func (*ExternalService_Networking) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_external_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ExternalService_Networking) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ExternalService_Networking) GetTls() *ExternalService_Networking_TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+type ExternalService_Networking_TLS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Denotes that the external service uses TLS
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Data source for the certificate of CA
+	CaCert *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	// Data source for the authentication
+	ClientCert *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=clientCert,proto3" json:"clientCert,omitempty"`
+	// Data source for the authentication
+	ClientKey *v1alpha1.DataSource `protobuf:"bytes,4,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	// If true then TLS session will allow renegotiation.
+	// It's not recommended to set this to true because of security reasons.
+	// However, some servers requires this setting, especially when using
+	// mTLS.
+	AllowRenegotiation bool `protobuf:"varint,5,opt,name=allowRenegotiation,proto3" json:"allowRenegotiation,omitempty"`
+	// ServerName overrides the default Server Name Indicator set by Dubbo.
+	// The default value is set to the "address" hostname.
+	ServerName *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	// If true then the hostname of the external service is not verified
+	// against the certificate it presents.
+	SkipHostnameVerification bool `protobuf:"varint,7,opt,name=skipHostnameVerification,proto3" json:"skipHostnameVerification,omitempty"`
+}
+
+func (x *ExternalService_Networking_TLS) Reset() {
+	*x = ExternalService_Networking_TLS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_external_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalService_Networking_TLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalService_Networking_TLS) ProtoMessage() {}
+
+func (x *ExternalService_Networking_TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_external_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalService_Networking_TLS.ProtoReflect.Descriptor instead.
+func (*ExternalService_Networking_TLS) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_external_service_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *ExternalService_Networking_TLS) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ExternalService_Networking_TLS) GetCaCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.CaCert
+	}
+	return nil
+}
+
+func (x *ExternalService_Networking_TLS) GetClientCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.ClientCert
+	}
+	return nil
+}
+
+func (x *ExternalService_Networking_TLS) GetClientKey() *v1alpha1.DataSource {
+	if x != nil {
+		return x.ClientKey
+	}
+	return nil
+}
+
+func (x *ExternalService_Networking_TLS) GetAllowRenegotiation() bool {
+	if x != nil {
+		return x.AllowRenegotiation
+	}
+	return false
+}
+
+func (x *ExternalService_Networking_TLS) GetServerName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ServerName
+	}
+	return nil
+}
+
+func (x *ExternalService_Networking_TLS) GetSkipHostnameVerification() bool {
+	if x != nil {
+		return x.SkipHostnameVerification
+	}
+	return false
+}
+
+var File_api_mesh_v1alpha1_external_service_proto protoreflect.FileDescriptor
+
+var file_api_mesh_v1alpha1_external_service_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x64, 0x75, 0x62, 0x62,
+	0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a,
+	0x16, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x06,
+	0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0xf8, 0x03, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x45, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64,
+	0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x4c,
+	0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x1a, 0x88, 0x03, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x63, 0x61, 0x43,
+	0x65, 0x72, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x75, 0x62, 0x62,
+	0x6f, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f,
+	0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x73, 0x6b, 0x69, 0x70, 0x48, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x73, 0x6b, 0x69, 0x70, 0x48, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x61, 0xaa, 0x8c, 0x89, 0xa6,
+	0x01, 0x5b, 0x0a, 0x17, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0f, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x04, 0x6d, 0x65,
+	0x73, 0x68, 0x52, 0x02, 0x10, 0x01, 0x3a, 0x23, 0x12, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x0a, 0x0f, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x68, 0x01, 0x42, 0x36, 0x5a,
+	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x61, 0x63,
+	0x68, 0x65, 0x2f, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2d, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_mesh_v1alpha1_external_service_proto_rawDescOnce sync.Once
+	file_api_mesh_v1alpha1_external_service_proto_rawDescData = file_api_mesh_v1alpha1_external_service_proto_rawDesc
+)
+
+func file_api_mesh_v1alpha1_external_service_proto_rawDescGZIP() []byte {
+	file_api_mesh_v1alpha1_external_service_proto_rawDescOnce.Do(func() {
+		file_api_mesh_v1alpha1_external_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_mesh_v1alpha1_external_service_proto_rawDescData)
+	})
+	return file_api_mesh_v1alpha1_external_service_proto_rawDescData
+}
+
+var file_api_mesh_v1alpha1_external_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_mesh_v1alpha1_external_service_proto_goTypes = []interface{}{
+	(*ExternalService)(nil),                // 0: dubbo.mesh.v1alpha1.ExternalService
+	(*ExternalService_Networking)(nil),     // 1: dubbo.mesh.v1alpha1.ExternalService.Networking
+	nil,                                    // 2: dubbo.mesh.v1alpha1.ExternalService.TagsEntry
+	(*ExternalService_Networking_TLS)(nil), // 3: dubbo.mesh.v1alpha1.ExternalService.Networking.TLS
+	(*v1alpha1.DataSource)(nil),            // 4: dubbo.system.v1alpha1.DataSource
+	(*wrapperspb.StringValue)(nil),         // 5: google.protobuf.StringValue
+}
+var file_api_mesh_v1alpha1_external_service_proto_depIdxs = []int32{
+	1, // 0: dubbo.mesh.v1alpha1.ExternalService.networking:type_name -> dubbo.mesh.v1alpha1.ExternalService.Networking
+	2, // 1: dubbo.mesh.v1alpha1.ExternalService.tags:type_name -> dubbo.mesh.v1alpha1.ExternalService.TagsEntry
+	3, // 2: dubbo.mesh.v1alpha1.ExternalService.Networking.tls:type_name -> dubbo.mesh.v1alpha1.ExternalService.Networking.TLS
+	4, // 3: dubbo.mesh.v1alpha1.ExternalService.Networking.TLS.caCert:type_name -> dubbo.system.v1alpha1.DataSource
+	4, // 4: dubbo.mesh.v1alpha1.ExternalService.Networking.TLS.clientCert:type_name -> dubbo.system.v1alpha1.DataSource
+	4, // 5: dubbo.mesh.v1alpha1.ExternalService.Networking.TLS.clientKey:type_name -> dubbo.system.v1alpha1.DataSource
+	5, // 6: dubbo.mesh.v1alpha1.ExternalService.Networking.TLS.serverName:type_name -> google.protobuf.StringValue
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_mesh_v1alpha1_external_service_proto_init() }
+func file_api_mesh_v1alpha1_external_service_proto_init() {
+	if File_api_mesh_v1alpha1_external_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_mesh_v1alpha1_external_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_external_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalService_Networking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_external_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalService_Networking_TLS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_mesh_v1alpha1_external_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_mesh_v1alpha1_external_service_proto_goTypes,
+		DependencyIndexes: file_api_mesh_v1alpha1_external_service_proto_depIdxs,
+		MessageInfos:      file_api_mesh_v1alpha1_external_service_proto_msgTypes,
+	}.Build()
+	File_api_mesh_v1alpha1_external_service_proto = out.File
+	file_api_mesh_v1alpha1_external_service_proto_rawDesc = nil
+	file_api_mesh_v1alpha1_external_service_proto_goTypes = nil
+	file_api_mesh_v1alpha1_external_service_proto_depIdxs = nil
+}
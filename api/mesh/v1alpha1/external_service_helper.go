@@ -0,0 +1,44 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+// ZoneEgressServiceName is the service name in the identity issued to zone egresses.
+// Dataplanes verify it when they send traffic for external services through the egress.
+const ZoneEgressServiceName = "zone-egress"
+
+// GetService returns a service name represented by this external service.
+//
+// The purpose of this method is to encapsulate implementation detail
+// that service is modeled as a tag rather than a separate field.
+func (es *ExternalService) GetService() string {
+	if es == nil || es.GetTags() == nil {
+		return ""
+	}
+	return es.GetTags()[ServiceTag]
+}
+
+// GetProtocol returns a protocol of the traffic sent to this external service.
+//
+// The purpose of this method is to encapsulate implementation detail
+// that protocol is modeled as a tag rather than a separate field.
+func (es *ExternalService) GetProtocol() string {
+	if es == nil || es.GetTags() == nil {
+		return ""
+	}
+	return es.GetTags()[ProtocolTag]
+}
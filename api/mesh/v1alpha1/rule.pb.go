@@ -0,0 +1,342 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.0
+// source: api/mesh/v1alpha1/rule.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+)
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RuleSyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Nonce     string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// type of the subscribed rules, one of TagRoute, ConditionRoute and
+	// DynamicConfig.
+	RuleType string `protobuf:"bytes,3,opt,name=ruleType,proto3" json:"ruleType,omitempty"`
+	// keys of the subscribed rules, application name for application scope
+	// rules and '{interface name}:{version}:{group}' for service scope rules.
+	// The keys replace the ones subscribed by the previous request of the rule
+	// type, a request without keys unsubscribes from the rule type.
+	Keys []string `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *RuleSyncRequest) Reset() {
+	*x = RuleSyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSyncRequest) ProtoMessage() {}
+
+func (x *RuleSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSyncRequest.ProtoReflect.Descriptor instead.
+func (*RuleSyncRequest) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RuleSyncRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RuleSyncRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *RuleSyncRequest) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *RuleSyncRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RuleSyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nonce           string            `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Revision        int64             `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	RuleType        string            `protobuf:"bytes,3,opt,name=ruleType,proto3" json:"ruleType,omitempty"`
+	TagRoutes       []*TagRoute       `protobuf:"bytes,4,rep,name=tagRoutes,proto3" json:"tagRoutes,omitempty"`
+	ConditionRoutes []*ConditionRoute `protobuf:"bytes,5,rep,name=conditionRoutes,proto3" json:"conditionRoutes,omitempty"`
+	DynamicConfigs  []*DynamicConfig  `protobuf:"bytes,6,rep,name=dynamicConfigs,proto3" json:"dynamicConfigs,omitempty"`
+	// errorDetail is set when the request of the rule type was rejected, e.g.
+	// because the rule type is unknown. The subscriptions stay unchanged.
+	ErrorDetail string `protobuf:"bytes,7,opt,name=errorDetail,proto3" json:"errorDetail,omitempty"`
+}
+
+func (x *RuleSyncResponse) Reset() {
+	*x = RuleSyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_mesh_v1alpha1_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSyncResponse) ProtoMessage() {}
+
+func (x *RuleSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSyncResponse.ProtoReflect.Descriptor instead.
+func (*RuleSyncResponse) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RuleSyncResponse) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *RuleSyncResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RuleSyncResponse) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *RuleSyncResponse) GetTagRoutes() []*TagRoute {
+	if x != nil {
+		return x.TagRoutes
+	}
+	return nil
+}
+
+func (x *RuleSyncResponse) GetConditionRoutes() []*ConditionRoute {
+	if x != nil {
+		return x.ConditionRoutes
+	}
+	return nil
+}
+
+func (x *RuleSyncResponse) GetDynamicConfigs() []*DynamicConfig {
+	if x != nil {
+		return x.DynamicConfigs
+	}
+	return nil
+}
+
+func (x *RuleSyncResponse) GetErrorDetail() string {
+	if x != nil {
+		return x.ErrorDetail
+	}
+	return ""
+}
+
+var File_api_mesh_v1alpha1_rule_proto protoreflect.FileDescriptor
+
+var file_api_mesh_v1alpha1_rule_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13,
+	0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x1a, 0x27, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x61, 0x67, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x75, 0x0a, 0x0f, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xda,
+	0x02, 0x0a, 0x10, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x3b, 0x0a, 0x09, 0x74, 0x61, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x09, 0x74, 0x61, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x4d,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a,
+	0x0e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x64, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x32, 0x6a, 0x0a, 0x0b, 0x52,
+	0x75, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x08, 0x52, 0x75,
+	0x6c, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x24, 0x2e, 0x64, 0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64,
+	0x75, 0x62, 0x62, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x64, 0x75, 0x62,
+	0x62, 0x6f, 0x2d, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_mesh_v1alpha1_rule_proto_rawDescOnce sync.Once
+	file_api_mesh_v1alpha1_rule_proto_rawDescData = file_api_mesh_v1alpha1_rule_proto_rawDesc
+)
+
+func file_api_mesh_v1alpha1_rule_proto_rawDescGZIP() []byte {
+	file_api_mesh_v1alpha1_rule_proto_rawDescOnce.Do(func() {
+		file_api_mesh_v1alpha1_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_mesh_v1alpha1_rule_proto_rawDescData)
+	})
+	return file_api_mesh_v1alpha1_rule_proto_rawDescData
+}
+
+var file_api_mesh_v1alpha1_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_mesh_v1alpha1_rule_proto_goTypes = []interface{}{
+	(*RuleSyncRequest)(nil),  // 0: dubbo.mesh.v1alpha1.RuleSyncRequest
+	(*RuleSyncResponse)(nil), // 1: dubbo.mesh.v1alpha1.RuleSyncResponse
+	(*TagRoute)(nil),         // 2: dubbo.mesh.v1alpha1.TagRoute
+	(*ConditionRoute)(nil),   // 3: dubbo.mesh.v1alpha1.ConditionRoute
+	(*DynamicConfig)(nil),    // 4: dubbo.mesh.v1alpha1.DynamicConfig
+}
+var file_api_mesh_v1alpha1_rule_proto_depIdxs = []int32{
+	2, // 0: dubbo.mesh.v1alpha1.RuleSyncResponse.tagRoutes:type_name -> dubbo.mesh.v1alpha1.TagRoute
+	3, // 1: dubbo.mesh.v1alpha1.RuleSyncResponse.conditionRoutes:type_name -> dubbo.mesh.v1alpha1.ConditionRoute
+	4, // 2: dubbo.mesh.v1alpha1.RuleSyncResponse.dynamicConfigs:type_name -> dubbo.mesh.v1alpha1.DynamicConfig
+	0, // 3: dubbo.mesh.v1alpha1.RuleService.RuleSync:input_type -> dubbo.mesh.v1alpha1.RuleSyncRequest
+	1, // 4: dubbo.mesh.v1alpha1.RuleService.RuleSync:output_type -> dubbo.mesh.v1alpha1.RuleSyncResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_mesh_v1alpha1_rule_proto_init() }
+func file_api_mesh_v1alpha1_rule_proto_init() {
+	if File_api_mesh_v1alpha1_rule_proto != nil {
+		return
+	}
+	file_api_mesh_v1alpha1_condition_route_proto_init()
+	file_api_mesh_v1alpha1_dynamic_config_proto_init()
+	file_api_mesh_v1alpha1_tag_route_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_api_mesh_v1alpha1_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleSyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_mesh_v1alpha1_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleSyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_mesh_v1alpha1_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_mesh_v1alpha1_rule_proto_goTypes,
+		DependencyIndexes: file_api_mesh_v1alpha1_rule_proto_depIdxs,
+		MessageInfos:      file_api_mesh_v1alpha1_rule_proto_msgTypes,
+	}.Build()
+	File_api_mesh_v1alpha1_rule_proto = out.File
+	file_api_mesh_v1alpha1_rule_proto_rawDesc = nil
+	file_api_mesh_v1alpha1_rule_proto_goTypes = nil
+	file_api_mesh_v1alpha1_rule_proto_depIdxs = nil
+}
@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+)
+
+import (
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RuleServiceClient is the client API for RuleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RuleServiceClient interface {
+	// RuleSync from cp to dp, control plane sync TagRoute, ConditionRoute and
+	// DynamicConfig to data plane.
+	//
+	// data plane and control plane keep a streaming link:
+	// when a subscribed rule updated, control plane sync rules of the
+	// subscribed keys to data plane.
+	RuleSync(ctx context.Context, opts ...grpc.CallOption) (RuleService_RuleSyncClient, error)
+}
+
+type ruleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRuleServiceClient(cc grpc.ClientConnInterface) RuleServiceClient {
+	return &ruleServiceClient{cc}
+}
+
+func (c *ruleServiceClient) RuleSync(ctx context.Context, opts ...grpc.CallOption) (RuleService_RuleSyncClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RuleService_ServiceDesc.Streams[0], "/dubbo.mesh.v1alpha1.RuleService/RuleSync", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ruleServiceRuleSyncClient{stream}
+	return x, nil
+}
+
+type RuleService_RuleSyncClient interface {
+	Send(*RuleSyncRequest) error
+	Recv() (*RuleSyncResponse, error)
+	grpc.ClientStream
+}
+
+type ruleServiceRuleSyncClient struct {
+	grpc.ClientStream
+}
+
+func (x *ruleServiceRuleSyncClient) Send(m *RuleSyncRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ruleServiceRuleSyncClient) Recv() (*RuleSyncResponse, error) {
+	m := new(RuleSyncResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RuleServiceServer is the server API for RuleService service.
+// All implementations must embed UnimplementedRuleServiceServer
+// for forward compatibility
+type RuleServiceServer interface {
+	// RuleSync from cp to dp, control plane sync TagRoute, ConditionRoute and
+	// DynamicConfig to data plane.
+	//
+	// data plane and control plane keep a streaming link:
+	// when a subscribed rule updated, control plane sync rules of the
+	// subscribed keys to data plane.
+	RuleSync(RuleService_RuleSyncServer) error
+	mustEmbedUnimplementedRuleServiceServer()
+}
+
+// UnimplementedRuleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRuleServiceServer struct {
+}
+
+func (UnimplementedRuleServiceServer) RuleSync(RuleService_RuleSyncServer) error {
+	return status.Errorf(codes.Unimplemented, "method RuleSync not implemented")
+}
+func (UnimplementedRuleServiceServer) mustEmbedUnimplementedRuleServiceServer() {}
+
+// UnsafeRuleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RuleServiceServer will
+// result in compilation errors.
+type UnsafeRuleServiceServer interface {
+	mustEmbedUnimplementedRuleServiceServer()
+}
+
+func RegisterRuleServiceServer(s grpc.ServiceRegistrar, srv RuleServiceServer) {
+	s.RegisterService(&RuleService_ServiceDesc, srv)
+}
+
+func _RuleService_RuleSync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RuleServiceServer).RuleSync(&ruleServiceRuleSyncServer{stream})
+}
+
+type RuleService_RuleSyncServer interface {
+	Send(*RuleSyncResponse) error
+	Recv() (*RuleSyncRequest, error)
+	grpc.ServerStream
+}
+
+type ruleServiceRuleSyncServer struct {
+	grpc.ServerStream
+}
+
+func (x *ruleServiceRuleSyncServer) Send(m *RuleSyncResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ruleServiceRuleSyncServer) Recv() (*RuleSyncRequest, error) {
+	m := new(RuleSyncRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RuleService_ServiceDesc is the grpc.ServiceDesc for RuleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RuleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dubbo.mesh.v1alpha1.RuleService",
+	HandlerType: (*RuleServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RuleSync",
+			Handler:       _RuleService_RuleSync_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/mesh/v1alpha1/rule.proto",
+}
@@ -38,6 +38,7 @@ import (
 	dds_zone "github.com/apache/dubbo-kubernetes/pkg/dds/zone"
 	"github.com/apache/dubbo-kubernetes/pkg/defaults"
 	"github.com/apache/dubbo-kubernetes/pkg/diagnostics"
+	"github.com/apache/dubbo-kubernetes/pkg/dns"
 	dp_server "github.com/apache/dubbo-kubernetes/pkg/dp-server"
 	"github.com/apache/dubbo-kubernetes/pkg/dubbo"
 	"github.com/apache/dubbo-kubernetes/pkg/hds"
@@ -122,6 +123,10 @@ func newRunCmdWithOpts(opts dubbo_cmd.RunCmdOpts) *cobra.Command {
 				runLog.Error(err, "unable to set up DP Server")
 				return err
 			}
+			if err := dns.Setup(rt); err != nil {
+				runLog.Error(err, "unable to set up DNS")
+				return err
+			}
 			if err := defaults.Setup(rt); err != nil {
 				runLog.Error(err, "unable to set up Defaults")
 				return err
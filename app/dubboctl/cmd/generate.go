@@ -27,4 +27,7 @@ func addGenerate(rootCmd *cobra.Command) {
 	}
 	rootCmd.AddCommand(generateCmd)
 	NewGenerateCertificateCmd(generateCmd)
+	NewGenerateDataplaneTokenCmd(generateCmd)
+	NewGenerateZoneTokenCmd(generateCmd)
+	NewGenerateSigningKeyCmd(generateCmd)
 }
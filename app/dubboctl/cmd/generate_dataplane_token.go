@@ -0,0 +1,186 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/dataplane"
+	util_rsa "github.com/apache/dubbo-kubernetes/pkg/util/rsa"
+)
+
+const dataplaneTokenPath = "/api/v1/tokens/dataplane"
+
+type generateDataplaneTokenContext struct {
+	args struct {
+		adminAddress   string
+		timeout        time.Duration
+		name           string
+		mesh           string
+		proxyType      string
+		tags           []string
+		validFor       time.Duration
+		signingKeyPath string
+		keyID          string
+	}
+}
+
+// generateTokenResp is the response envelope of the token endpoints of the admin API.
+type generateTokenResp struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data string `json:"data"`
+}
+
+func NewGenerateDataplaneTokenCmd(baseCmd *cobra.Command) {
+	ctx := &generateDataplaneTokenContext{}
+	cmd := &cobra.Command{
+		Use:   "dataplane-token",
+		Short: "Generate a dataplane token",
+		Long: `Generate a token a dataplane proxy uses to authenticate to the control plane.
+The token is issued by the control plane unless it is signed offline with --signing-key-path,
+in which case the public key has to be configured in the control plane.`,
+		Example: `
+  # Generate a token for any dataplane proxy of the default mesh
+  dubboctl generate dataplane-token --valid-for=24h > /tmp/token
+
+  # Generate a token for the dataplane proxy "dp-1" with the tag "app=demo"
+  dubboctl generate dataplane-token --mesh=default --name=dp-1 --tag=app=demo --valid-for=720h
+
+  # Sign a token offline with a signing key generated by 'dubboctl generate signing-key'
+  dubboctl generate dataplane-token --valid-for=24h --signing-key-path=/tmp/key.pem --kid=1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tags, err := parseTokenTags(ctx.args.tags)
+			if err != nil {
+				return err
+			}
+			req := &dataplane.TokenRequest{
+				Name:     ctx.args.name,
+				Mesh:     ctx.args.mesh,
+				Tags:     tags,
+				Type:     ctx.args.proxyType,
+				ValidFor: ctx.args.validFor.String(),
+			}
+
+			var token string
+			if ctx.args.signingKeyPath != "" {
+				signingKey, err := readSigningKey(ctx.args.signingKeyPath, ctx.args.keyID)
+				if err != nil {
+					return err
+				}
+				token, err = dataplane.NewOfflineIssuer(signingKey, ctx.args.keyID).Generate(context.Background(), req.Identity(), ctx.args.validFor)
+				if err != nil {
+					return errors.Wrap(err, "could not sign the token")
+				}
+			} else {
+				token, err = requestToken(cmd.Context(), ctx.args.adminAddress, ctx.args.timeout, dataplaneTokenPath, req)
+				if err != nil {
+					return err
+				}
+			}
+			_, err = cmd.OutOrStdout().Write([]byte(token))
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.adminAddress, "admin-address", "http://127.0.0.1:8888", "address of the admin server")
+	cmd.Flags().DurationVar(&ctx.args.timeout, "timeout", 10*time.Second, "timeout of the request to the admin server")
+	cmd.Flags().StringVar(&ctx.args.name, "name", "", "name of the dataplane, the token is valid for any dataplane when empty")
+	cmd.Flags().StringVarP(&ctx.args.mesh, "mesh", "m", "default", "mesh of the dataplane")
+	cmd.Flags().StringVar(&ctx.args.proxyType, "proxy-type", string(mesh_proto.DataplaneProxyType), "type of the proxy")
+	cmd.Flags().StringArrayVar(&ctx.args.tags, "tag", []string{}, "tag the dataplane has to have, e.g. app=demo. Repeat the tag to allow multiple values")
+	cmd.Flags().DurationVar(&ctx.args.validFor, "valid-for", 0, "how long the token is valid, e.g. 24h")
+	cmd.Flags().StringVar(&ctx.args.signingKeyPath, "signing-key-path", "", "path to a PEM encoded private key to sign the token offline")
+	cmd.Flags().StringVar(&ctx.args.keyID, "kid", "", "ID of the signing key, required with --signing-key-path")
+	_ = cmd.MarkFlagRequired("valid-for")
+
+	baseCmd.AddCommand(cmd)
+}
+
+func parseTokenTags(tags []string) (map[string][]string, error) {
+	result := map[string][]string{}
+	for _, tag := range tags {
+		key, value, ok := strings.Cut(tag, "=")
+		if !ok || key == "" || value == "" {
+			return nil, errors.Errorf("invalid tag %q, the tag has to be in the format key=value", tag)
+		}
+		result[key] = append(result[key], value)
+	}
+	return result, nil
+}
+
+func readSigningKey(path string, keyID string) (*rsa.PrivateKey, error) {
+	if keyID == "" {
+		return nil, errors.New("--kid is required when the token is signed offline")
+	}
+	keyBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the signing key")
+	}
+	if !util_rsa.IsPrivateKeyPEMBytes(keyBytes) {
+		return nil, errors.Errorf("%s is not a PEM encoded RSA private key", path)
+	}
+	return util_rsa.FromPEMBytesToPrivateKey(keyBytes)
+}
+
+func requestToken(ctx context.Context, adminAddress string, timeout time.Duration, path string, req interface{}) (string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	url := strings.TrimSuffix(adminAddress, "/") + path
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: timeout}
+	response, err := client.Do(request)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not reach the admin server at %s", adminAddress)
+	}
+	defer response.Body.Close()
+
+	resp := &generateTokenResp{}
+	if err := json.NewDecoder(response.Body).Decode(resp); err != nil {
+		return "", errors.Wrapf(err, "could not decode the response of the admin server (status %d)", response.StatusCode)
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", errors.Errorf("admin server responded with %d: %s", response.StatusCode, resp.Msg)
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"os"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+)
+
+import (
+	util_rsa "github.com/apache/dubbo-kubernetes/pkg/util/rsa"
+)
+
+type generateSigningKeyContext struct {
+	args struct {
+		publicKeyFile string
+	}
+}
+
+func NewGenerateSigningKeyCmd(baseCmd *cobra.Command) {
+	ctx := &generateSigningKeyContext{}
+	cmd := &cobra.Command{
+		Use:   "signing-key",
+		Short: "Generate a signing key for tokens",
+		Long: `Generate a PEM encoded RSA private key that signs dataplane or zone tokens.
+
+To rotate the keys used by the control plane, store the key as a Secret with the next serial number,
+e.g. "dataplane-token-signing-key-{mesh}-2" or "zone-token-signing-key-2". New tokens are signed
+with the key of the highest serial number. Tokens signed with the old key stay valid until the old
+Secret is removed. A single token can be revoked by adding its ID to the "dataplane-token-revocations-{mesh}"
+or "zone-token-revocations" Secret.
+
+To sign tokens offline, pass the key to 'dubboctl generate dataplane-token --signing-key-path'
+and configure its public key in the control plane.`,
+		Example: `
+  # Generate a signing key and its public key
+  dubboctl generate signing-key --public-key-file=/tmp/public.pem > /tmp/key.pem`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+			if err != nil {
+				return errors.Wrap(err, "could not generate a signing key")
+			}
+			if ctx.args.publicKeyFile != "" {
+				publicKey, err := util_rsa.FromPrivateKeyToPublicKeyPEMBytes(key)
+				if err != nil {
+					return err
+				}
+				if err := os.WriteFile(ctx.args.publicKeyFile, publicKey, 0o600); err != nil {
+					return errors.Wrap(err, "could not write the public key file")
+				}
+			}
+			keyBytes, err := util_rsa.FromPrivateKeyToPEMBytes(key)
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(keyBytes)
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.publicKeyFile, "public-key-file", "", "path to a file to write the public key to")
+
+	baseCmd.AddCommand(cmd)
+}
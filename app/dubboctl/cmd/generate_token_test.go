@@ -0,0 +1,144 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+import (
+	config_types "github.com/apache/dubbo-kubernetes/pkg/config/types"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/dataplane"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/zone"
+	resources_memory "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+)
+
+func TestGenerateToken(t *testing.T) {
+	var dataplaneRequests []*dataplane.TokenRequest
+	var zoneRequests []*zone.TokenRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case dataplaneTokenPath:
+			req := &dataplane.TokenRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			dataplaneRequests = append(dataplaneRequests, req)
+			if req.Mesh == "disabled" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"code":500,"msg":"token issuer is disabled"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"code":200,"msg":"success","data":"dataplane-token"}`))
+		case zoneTokenPath:
+			req := &zone.TokenRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			zoneRequests = append(zoneRequests, req)
+			_, _ = w.Write([]byte(`{"code":200,"msg":"success","data":"zone-token"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		desc    string
+		cmd     string
+		want    string
+		wantErr bool
+	}{
+		{
+			desc: "request a dataplane token",
+			cmd:  "generate dataplane-token --admin-address " + server.URL + " --name dp-1 --tag app=demo --tag app=demo-canary --valid-for 24h",
+			want: "dataplane-token",
+		},
+		{
+			desc:    "report the error of the admin server",
+			cmd:     "generate dataplane-token --admin-address " + server.URL + " --mesh disabled --valid-for 24h",
+			wantErr: true,
+		},
+		{
+			desc:    "reject an invalid tag",
+			cmd:     "generate dataplane-token --admin-address " + server.URL + " --tag app --valid-for 24h",
+			wantErr: true,
+		},
+		{
+			desc: "request a zone token",
+			cmd:  "generate zone-token --admin-address " + server.URL + " --zone zone-1 --scope ingress --valid-for 1h",
+			want: "zone-token",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			res := testExecute(t, test.cmd, test.wantErr)
+			if test.want != "" && test.want != res {
+				t.Errorf("want:\n%s\nbutgot:\n%s\n", test.want, res)
+			}
+		})
+	}
+
+	if len(dataplaneRequests) != 2 {
+		t.Fatalf("want 2 dataplane token requests but got %d", len(dataplaneRequests))
+	}
+	if req := dataplaneRequests[0]; req.Name != "dp-1" || req.Mesh != "default" || len(req.Tags["app"]) != 2 || req.ValidFor != "24h0m0s" {
+		t.Errorf("unexpected request: %+v", req)
+	}
+	if len(zoneRequests) != 1 || zoneRequests[0].Zone != "zone-1" || len(zoneRequests[0].Scope) != 1 {
+		t.Errorf("unexpected zone token requests: %+v", zoneRequests)
+	}
+}
+
+func TestGenerateDataplaneTokenOffline(t *testing.T) {
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "key.pem")
+	publicKeyPath := filepath.Join(dir, "public.pem")
+
+	key := testExecute(t, "generate signing-key --public-key-file "+publicKeyPath, false)
+	if err := os.WriteFile(keyPath, []byte(key), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	testExecute(t, "generate dataplane-token --valid-for 1h --signing-key-path "+keyPath, true)
+	token := testExecute(t, "generate dataplane-token --name dp-1 --valid-for 1h --signing-key-path "+keyPath+" --kid 1", false)
+
+	validator, err := dataplane.NewValidator(manager.NewResourceManager(resources_memory.NewStore()), []config_types.MeshedPublicKey{{
+		PublicKey: config_types.PublicKey{KID: "1", KeyFile: publicKeyPath},
+		Mesh:      "default",
+	}}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := validator.Validate(context.Background(), token)
+	if err != nil {
+		t.Fatalf("token signed offline is not valid: %s", err)
+	}
+	if identity.Name != "dp-1" || identity.Mesh != "default" {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+}
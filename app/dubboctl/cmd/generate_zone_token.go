@@ -0,0 +1,102 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"time"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/zone"
+)
+
+const zoneTokenPath = "/api/v1/tokens/zone"
+
+type generateZoneTokenContext struct {
+	args struct {
+		adminAddress   string
+		timeout        time.Duration
+		zone           string
+		scope          []string
+		validFor       time.Duration
+		signingKeyPath string
+		keyID          string
+	}
+}
+
+func NewGenerateZoneTokenCmd(baseCmd *cobra.Command) {
+	ctx := &generateZoneTokenContext{}
+	cmd := &cobra.Command{
+		Use:   "zone-token",
+		Short: "Generate a zone token",
+		Long: `Generate a token zone ingresses and egresses use to authenticate to the control plane.
+The token is issued by the control plane unless it is signed offline with --signing-key-path,
+in which case the public key has to be configured in the control plane.`,
+		Example: `
+  # Generate a token for zone ingresses and egresses of the zone "zone-1"
+  dubboctl generate zone-token --zone=zone-1 --valid-for=720h > /tmp/token
+
+  # Generate a token for zone ingresses only
+  dubboctl generate zone-token --zone=zone-1 --scope=ingress --valid-for=720h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &zone.TokenRequest{
+				Zone:     ctx.args.zone,
+				Scope:    ctx.args.scope,
+				ValidFor: ctx.args.validFor.String(),
+			}
+
+			var token string
+			var err error
+			if ctx.args.signingKeyPath != "" {
+				signingKey, err := readSigningKey(ctx.args.signingKeyPath, ctx.args.keyID)
+				if err != nil {
+					return err
+				}
+				token, err = zone.NewOfflineIssuer(signingKey, ctx.args.keyID).Generate(context.Background(), req.Identity(), ctx.args.validFor)
+				if err != nil {
+					return errors.Wrap(err, "could not sign the token")
+				}
+			} else {
+				token, err = requestToken(cmd.Context(), ctx.args.adminAddress, ctx.args.timeout, zoneTokenPath, req)
+				if err != nil {
+					return err
+				}
+			}
+			_, err = cmd.OutOrStdout().Write([]byte(token))
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.adminAddress, "admin-address", "http://127.0.0.1:8888", "address of the admin server")
+	cmd.Flags().DurationVar(&ctx.args.timeout, "timeout", 10*time.Second, "timeout of the request to the admin server")
+	cmd.Flags().StringVar(&ctx.args.zone, "zone", "", "name of the zone")
+	cmd.Flags().StringSliceVar(&ctx.args.scope, "scope", zone.FullScope, "proxy types the token can be used by")
+	cmd.Flags().DurationVar(&ctx.args.validFor, "valid-for", 0, "how long the token is valid, e.g. 24h")
+	cmd.Flags().StringVar(&ctx.args.signingKeyPath, "signing-key-path", "", "path to a PEM encoded private key to sign the token offline")
+	cmd.Flags().StringVar(&ctx.args.keyID, "kid", "", "ID of the signing key, required with --signing-key-path")
+	_ = cmd.MarkFlagRequired("zone")
+	_ = cmd.MarkFlagRequired("valid-for")
+
+	baseCmd.AddCommand(cmd)
+}
@@ -0,0 +1,42 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func addInstall(rootCmd *cobra.Command) {
+	installCmd := &cobra.Command{
+		Use:   "install",
+		Short: "Install Dubbo components on the host",
+		Long:  `Install Dubbo components on the host.`,
+	}
+	rootCmd.AddCommand(installCmd)
+	NewInstallTransparentProxyCmd(installCmd)
+}
+
+func addUninstall(rootCmd *cobra.Command) {
+	uninstallCmd := &cobra.Command{
+		Use:   "uninstall",
+		Short: "Uninstall Dubbo components from the host",
+		Long:  `Uninstall Dubbo components from the host.`,
+	}
+	rootCmd.AddCommand(uninstallCmd)
+	NewUninstallTransparentProxyCmd(uninstallCmd)
+}
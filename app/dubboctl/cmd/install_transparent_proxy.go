@@ -0,0 +1,157 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/proxy/transparentproxy"
+)
+
+type installTransparentProxyContext struct {
+	args struct {
+		redirectPortInbound   uint32
+		redirectPortOutbound  uint32
+		excludeInboundPorts   []uint
+		excludeOutboundPorts  []uint
+		excludeOutboundUIDs   []string
+		dubboDpUID            string
+		redirectDNS           bool
+		redirectAllDNSTraffic bool
+		dnsPort               uint32
+		resolvConfPath        string
+		ipv6                  bool
+		dryRun                bool
+		verbose               bool
+	}
+}
+
+func NewInstallTransparentProxyCmd(baseCmd *cobra.Command) {
+	ctx := &installTransparentProxyContext{}
+	defaults := transparentproxy.DefaultConfig()
+	cmd := &cobra.Command{
+		Use:   "transparent-proxy",
+		Short: "Install the transparent proxy using iptables",
+		Long: `Install the transparent proxy using iptables so applications on the host join the mesh without changes.
+The inbound and outbound TCP traffic is redirected to the sidecar started with 'dubboctl proxy',
+except the traffic of the user running the sidecar. With --redirect-dns the DNS queries are
+redirected to the DNS server of the sidecar, so mesh service names can be resolved.
+
+Installing the rules requires root privileges, use --dry-run to print them instead.`,
+		Example: `
+  # Print the rules without applying them
+  dubboctl install transparent-proxy --dubbo-dp-uid=5678 --dry-run
+
+  # Redirect the traffic and the DNS queries, but keep SSH reachable
+  dubboctl install transparent-proxy --dubbo-dp-uid=5678 --redirect-dns --exclude-inbound-ports=22`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := transparentproxy.DefaultConfig()
+			cfg.RedirectPortInbound = ctx.args.redirectPortInbound
+			cfg.RedirectPortOutbound = ctx.args.redirectPortOutbound
+			cfg.ExcludeInboundPorts = toPorts(ctx.args.excludeInboundPorts)
+			cfg.ExcludeOutboundPorts = toPorts(ctx.args.excludeOutboundPorts)
+			cfg.ExcludeOutboundUIDs = ctx.args.excludeOutboundUIDs
+			cfg.DubboDpUID = ctx.args.dubboDpUID
+			cfg.RedirectDNS = ctx.args.redirectDNS
+			cfg.RedirectAllDNSTraffic = ctx.args.redirectAllDNSTraffic
+			cfg.DNSPort = ctx.args.dnsPort
+			cfg.IPv6 = ctx.args.ipv6
+			cfg.DryRun = ctx.args.dryRun
+			cfg.Verbose = ctx.args.verbose
+			cfg.Stdout = cmd.OutOrStdout()
+			cfg.Stderr = cmd.ErrOrStderr()
+
+			if cfg.RedirectDNS && !cfg.RedirectAllDNSTraffic {
+				servers, err := transparentproxy.ReadNameservers(ctx.args.resolvConfPath)
+				if err != nil {
+					return errors.Wrapf(err, "could not read nameservers from %s", ctx.args.resolvConfPath)
+				}
+				cfg.DNSServers = servers
+			}
+
+			if err := transparentproxy.Setup(cmd.Context(), cfg); err != nil {
+				return err
+			}
+			if !cfg.DryRun {
+				cmd.Println("transparent proxy set up successfully")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Uint32Var(&ctx.args.redirectPortInbound, "redirect-inbound-port", defaults.RedirectPortInbound, "port the inbound traffic is redirected to")
+	cmd.Flags().Uint32Var(&ctx.args.redirectPortOutbound, "redirect-outbound-port", defaults.RedirectPortOutbound, "port the outbound traffic is redirected to")
+	cmd.Flags().UintSliceVar(&ctx.args.excludeInboundPorts, "exclude-inbound-ports", nil, "comma separated list of inbound ports that are not redirected")
+	cmd.Flags().UintSliceVar(&ctx.args.excludeOutboundPorts, "exclude-outbound-ports", nil, "comma separated list of outbound ports that are not redirected")
+	cmd.Flags().StringSliceVar(&ctx.args.excludeOutboundUIDs, "exclude-outbound-uids", nil, "comma separated list of IDs of users whose outbound traffic is not redirected")
+	cmd.Flags().StringVar(&ctx.args.dubboDpUID, "dubbo-dp-uid", defaults.DubboDpUID, "ID of the user running the sidecar")
+	cmd.Flags().BoolVar(&ctx.args.redirectDNS, "redirect-dns", false, "redirect DNS queries to the DNS server of the sidecar")
+	cmd.Flags().BoolVar(&ctx.args.redirectAllDNSTraffic, "redirect-all-dns-traffic", false, "redirect DNS queries to any server, not only the nameservers of the host")
+	cmd.Flags().Uint32Var(&ctx.args.dnsPort, "redirect-dns-port", defaults.DNSPort, "port of the DNS server of the sidecar")
+	cmd.Flags().StringVar(&ctx.args.resolvConfPath, "resolv-conf", "/etc/resolv.conf", "file the nameservers of the host are read from")
+	cmd.Flags().BoolVar(&ctx.args.ipv6, "ipv6", false, "redirect the IPv6 traffic with ip6tables as well")
+	cmd.Flags().BoolVar(&ctx.args.dryRun, "dry-run", false, "print the rules instead of applying them")
+	cmd.Flags().BoolVar(&ctx.args.verbose, "verbose", false, "print the rules while applying them")
+
+	baseCmd.AddCommand(cmd)
+}
+
+func NewUninstallTransparentProxyCmd(baseCmd *cobra.Command) {
+	args := struct {
+		ipv6    bool
+		dryRun  bool
+		verbose bool
+	}{}
+	cmd := &cobra.Command{
+		Use:   "transparent-proxy",
+		Short: "Uninstall the transparent proxy",
+		Long: `Uninstall the transparent proxy installed with 'dubboctl install transparent-proxy'.
+Removing the rules requires root privileges, use --dry-run to print them instead.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := transparentproxy.DefaultConfig()
+			cfg.IPv6 = args.ipv6
+			cfg.DryRun = args.dryRun
+			cfg.Verbose = args.verbose
+			cfg.Stdout = cmd.OutOrStdout()
+			cfg.Stderr = cmd.ErrOrStderr()
+			if err := transparentproxy.Cleanup(cmd.Context(), cfg); err != nil {
+				return err
+			}
+			if !cfg.DryRun {
+				cmd.Println("transparent proxy cleaned up successfully")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&args.ipv6, "ipv6", false, "remove the IPv6 rules installed with ip6tables as well")
+	cmd.Flags().BoolVar(&args.dryRun, "dry-run", false, "print the rules instead of applying them")
+	cmd.Flags().BoolVar(&args.verbose, "verbose", false, "print the rules while applying them")
+
+	baseCmd.AddCommand(cmd)
+}
+
+func toPorts(values []uint) []uint32 {
+	var ports []uint32
+	for _, value := range values {
+		ports = append(ports, uint32(value))
+	}
+	return ports
+}
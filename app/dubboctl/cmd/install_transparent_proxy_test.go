@@ -0,0 +1,80 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInstallTransparentProxy(t *testing.T) {
+	resolvConf := filepath.Join(t.TempDir(), "resolv.conf")
+	if err := os.WriteFile(resolvConf, []byte("search default.svc.cluster.local\nnameserver 10.96.0.10\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		desc    string
+		cmd     string
+		want    []string
+		wantErr bool
+	}{
+		{
+			desc: "print the rules of the init container",
+			cmd:  "install transparent-proxy --dry-run --redirect-inbound-port=15006 --redirect-outbound-port=15001 --dubbo-dp-uid=5678 --exclude-inbound-ports=9000,22",
+			want: []string{
+				"-A DUBBO_INBOUND -p tcp --dport 9000 -j RETURN\n",
+				"-A DUBBO_INBOUND -p tcp --dport 22 -j RETURN\n",
+				"-A DUBBO_IN_REDIRECT -p tcp -j REDIRECT --to-ports 15006\n",
+				"-A DUBBO_REDIRECT -p tcp -j REDIRECT --to-ports 15001\n",
+			},
+		},
+		{
+			desc: "redirect DNS queries to the nameservers of the host",
+			cmd:  "install transparent-proxy --dry-run --redirect-dns --resolv-conf " + resolvConf,
+			want: []string{
+				"-A DUBBO_OUTPUT -d 10.96.0.10 -p udp --dport 53 -j REDIRECT --to-ports 15053\n",
+			},
+		},
+		{
+			desc:    "reject the same inbound and outbound redirect port",
+			cmd:     "install transparent-proxy --dry-run --redirect-inbound-port=15001",
+			wantErr: true,
+		},
+		{
+			desc: "print the cleanup rules",
+			cmd:  "uninstall transparent-proxy --dry-run",
+			want: []string{
+				"-D OUTPUT -j DUBBO_OUTPUT\n",
+				"-X DUBBO_INBOUND\n",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			out := testExecute(t, test.cmd, test.wantErr)
+			for _, want := range test.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("output %q does not contain %q", out, want)
+				}
+			}
+		})
+	}
+}
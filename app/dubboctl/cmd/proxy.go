@@ -135,8 +135,9 @@ func addProxy(opts dubbo_cmd.RunCmdOpts, cmd *cobra.Command) {
 				core.SetLogger(core.NewLogger(level))
 			}
 			proxyTypeMap := map[string]model.ResourceType{
-				string(mesh_proto.IngressProxyType): mesh.ZoneIngressType,
-				string(mesh_proto.EgressProxyType):  mesh.ZoneEgressType,
+				string(mesh_proto.DataplaneProxyType): mesh.DataplaneType,
+				string(mesh_proto.IngressProxyType):   mesh.ZoneIngressType,
+				string(mesh_proto.EgressProxyType):    mesh.ZoneEgressType,
 			}
 			if _, ok := proxyTypeMap[cfg.Dataplane.ProxyType]; !ok {
 				return errors.Errorf("invalid proxy type %q", cfg.Dataplane.ProxyType)
@@ -181,11 +182,17 @@ func addProxy(opts dubbo_cmd.RunCmdOpts, cmd *cobra.Command) {
 			//}
 			//runLog.Info("fetched Envoy version", "version", envoyVersion)
 			runLog.Info("generating bootstrap configuration")
+			// the control plane generates the DNS listener only for the proxies that declare the DNS ports
+			var dnsPort, emptyDNSPort uint32
+			if cfg.DNS.Enabled {
+				dnsPort = cfg.DNS.EnvoyDNSPort
+				emptyDNSPort = cfg.DNS.CoreDNSEmptyPort
+			}
 
 			bootstrap, _, err := proxyArgs.BootstrapGenerator(gracefulCtx, opts.Config.ControlPlane.URL, opts.Config, envoy.BootstrapParams{
 				Dataplane:           opts.Dataplane,
-				DNSPort:             cfg.DNS.EnvoyDNSPort,
-				EmptyDNSPort:        cfg.DNS.CoreDNSEmptyPort,
+				DNSPort:             dnsPort,
+				EmptyDNSPort:        emptyDNSPort,
 				Workdir:             cfg.DataplaneRuntime.SocketDir,
 				AccessLogSocketPath: core_xds.AccessLogSocketName(cfg.DataplaneRuntime.SocketDir, cfg.Dataplane.Name, cfg.Dataplane.Mesh),
 				MetricsSocketPath:   core_xds.MetricsHijackerSocketName(cfg.DataplaneRuntime.SocketDir, cfg.Dataplane.Name, cfg.Dataplane.Mesh),
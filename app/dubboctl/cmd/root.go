@@ -91,9 +91,12 @@ func addSubCommands(rootCmd *cobra.Command, newClient ClientFactory) {
 	addDeploy(rootCmd, newClient)
 	addManifest(rootCmd)
 	addGenerate(rootCmd)
+	addInstall(rootCmd)
+	addUninstall(rootCmd)
 	addProfile(rootCmd)
 	addDashboard(rootCmd)
 	addRegistryCmd(rootCmd)
+	addRoute(rootCmd)
 	addProxy(cmd2.DefaultRunCmdOpts, rootCmd)
 }
 
@@ -0,0 +1,179 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+)
+
+import (
+	dubbo_cmd "github.com/apache/dubbo-kubernetes/pkg/core/cmd"
+	"github.com/apache/dubbo-kubernetes/pkg/core/route"
+)
+
+const simulateRoutePath = "/api/v1/traffic/simulate"
+
+type routeSimulateContext struct {
+	args struct {
+		adminAddress string
+		timeout      time.Duration
+		output       string
+		request      route.Request
+	}
+}
+
+// simulateRouteResp is the response envelope of the admin API.
+type simulateRouteResp struct {
+	Code int          `json:"code"`
+	Msg  string       `json:"msg"`
+	Data route.Result `json:"data"`
+}
+
+func NewRouteSimulateCmd(baseCmd *cobra.Command) {
+	ctx := &routeSimulateContext{}
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate which providers a request would reach",
+		Long: `Evaluate the condition routes, tag routes and dynamic configs of the mesh against the
+current providers of a service, and print the providers the request would reach.`,
+		Example: `
+  # Simulate a call of the greet method from the consumer application
+  dubboctl route simulate --service=org.apache.dubbo.samples.GreetService --application=consumer --method=greet
+
+  # Simulate a call carrying the gray tag and print the result as JSON
+  dubboctl route simulate --service=org.apache.dubbo.samples.GreetService --attachment=dubbo.tag=gray -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch ctx.args.output {
+			case "text", "json":
+			default:
+				return errors.Errorf("invalid output format %q", ctx.args.output)
+			}
+
+			result, err := simulateRoute(cmd.Context(), ctx.args.adminAddress, ctx.args.timeout, &ctx.args.request)
+			if err != nil {
+				return err
+			}
+
+			if ctx.args.output == "json" {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(result)
+			}
+			return printRouteSimulation(cmd.OutOrStdout(), result)
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.adminAddress, "admin-address", "http://127.0.0.1:8888", "address of the admin server")
+	cmd.Flags().DurationVar(&ctx.args.timeout, "timeout", 10*time.Second, "timeout of the request to the admin server")
+	cmd.Flags().StringVarP(&ctx.args.output, "output", "o", "text", dubbo_cmd.UsageOptions("output format", "text", "json"))
+	cmd.Flags().StringVar(&ctx.args.request.Service, "service", "", "interface name of the service")
+	cmd.Flags().StringVar(&ctx.args.request.Version, "version", "", "version of the service")
+	cmd.Flags().StringVar(&ctx.args.request.Group, "group", "", "group of the service")
+	cmd.Flags().StringVar(&ctx.args.request.Method, "method", "", "method to invoke")
+	cmd.Flags().StringArrayVar(&ctx.args.request.Arguments, "argument", []string{}, "arguments of the invocation in order")
+	cmd.Flags().StringToStringVar(&ctx.args.request.Attachments, "attachment", map[string]string{}, "attachments of the invocation, e.g. dubbo.tag=gray")
+	cmd.Flags().StringVar(&ctx.args.request.Application, "application", "", "name of the consumer application")
+	cmd.Flags().StringVar(&ctx.args.request.Address, "address", "", "IP of the consumer")
+	_ = cmd.MarkFlagRequired("service")
+
+	baseCmd.AddCommand(cmd)
+}
+
+func simulateRoute(ctx context.Context, adminAddress string, timeout time.Duration, req *route.Request) (*route.Result, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	url := strings.TrimSuffix(adminAddress, "/") + simulateRoutePath
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: timeout}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not reach the admin server at %s", adminAddress)
+	}
+	defer response.Body.Close()
+
+	resp := &simulateRouteResp{}
+	if err := json.NewDecoder(response.Body).Decode(resp); err != nil {
+		return nil, errors.Wrapf(err, "could not decode the response of the admin server (status %d)", response.StatusCode)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("admin server responded with %d: %s", response.StatusCode, resp.Msg)
+	}
+	return &resp.Data, nil
+}
+
+func printRouteSimulation(out io.Writer, result *route.Result) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ENDPOINT\tAPPLICATION\tADDRESS")
+	for _, endpoint := range result.Endpoints {
+		address := net.JoinHostPort(endpoint.Address, strconv.FormatInt(endpoint.Port, 10))
+		fmt.Fprintf(w, "%s\t%s\t%s\n", endpoint.Name, endpoint.Application, address)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(result.Parameters) > 0 {
+		keys := make([]string, 0, len(result.Parameters))
+		for key := range result.Parameters {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fmt.Fprintln(out, "\nConsumer parameters:")
+		for _, key := range keys {
+			fmt.Fprintf(out, "  %s=%s\n", key, result.Parameters[key])
+		}
+	}
+
+	if len(result.Steps) > 0 {
+		fmt.Fprintln(out, "\nSteps:")
+		for i, step := range result.Steps {
+			rule := step.Rule
+			if rule == "" {
+				rule = "-"
+			}
+			fmt.Fprintf(out, "  %d. %s %s: %s -> [%s]\n", i+1, step.Type, rule, step.Message, strings.Join(step.Endpoints, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/route"
+)
+
+func TestRouteSimulate(t *testing.T) {
+	var requests []*route.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != simulateRoutePath || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		received := &route.Request{}
+		if err := json.NewDecoder(r.Body).Decode(received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, received)
+		if received.Service == "unknown" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":500,"msg":"service: must be defined"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":200,"msg":"success","data":{
+			"endpoints":[{"name":"provider-1","application":"provider","address":"10.0.0.1","port":20880}],
+			"parameters":{"timeout":"5000"},
+			"steps":[{"type":"TagRoute","rule":"provider.tag-router","message":"selected endpoints of \"provider\" by tag \"gray\"","endpoints":["provider-1"]}]}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		desc    string
+		cmd     string
+		want    string
+		wantErr bool
+	}{
+		{
+			desc: "print the selected endpoints",
+			cmd:  "route simulate --admin-address " + server.URL + " --service greet --method hello --argument a --attachment dubbo.tag=gray",
+			want: `ENDPOINT    APPLICATION  ADDRESS
+provider-1  provider     10.0.0.1:20880
+
+Consumer parameters:
+  timeout=5000
+
+Steps:
+  1. TagRoute provider.tag-router: selected endpoints of "provider" by tag "gray" -> [provider-1]
+`,
+		},
+		{
+			desc:    "report the error of the admin server",
+			cmd:     "route simulate --admin-address " + server.URL + " --service unknown",
+			wantErr: true,
+		},
+		{
+			desc:    "reject unknown output format",
+			cmd:     "route simulate --admin-address " + server.URL + " --service greet -o yaml",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			res := testExecute(t, test.cmd, test.wantErr)
+			if test.want != "" && test.want != res {
+				t.Errorf("want:\n%s\nbutgot:\n%s\n", test.want, res)
+			}
+		})
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("want 2 requests but got %d", len(requests))
+	}
+	if req := requests[0]; req.Method != "hello" || req.Attachments["dubbo.tag"] != "gray" || len(req.Arguments) != 1 {
+		t.Errorf("unexpected request: %+v", req)
+	}
+}
@@ -17,36 +17,101 @@
 
 package handler
 
+// API Definition: https://app.apifox.com/project/3732499
+// 资源详情-服务
+
 import (
+	"errors"
+	"net/http"
+
 	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
+	"github.com/apache/dubbo-kubernetes/pkg/admin/service"
 	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
 	"github.com/gin-gonic/gin"
-	"net/http"
 )
 
-// API Definition: https://app.apifox.com/project/3732499
-// 资源详情-应用
-
 func SearchServices(rt core_runtime.Runtime) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		//req := &model.SearchInstanceReq{}
+		req := &model.ServiceSearchReq{}
+		if err := c.ShouldBindQuery(req); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		services, total, err := service.SearchServices(rt, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.NewErrorResp(err.Error()))
+			return
+		}
 
-		c.JSON(http.StatusOK, model.NewSuccessResp(""))
+		pageRes := &model.PageData{}
+		c.JSON(http.StatusOK, model.NewSuccessResp(pageRes.WithData(services).WithTotal(total).WithCurPage(req.CurPage).WithPageSize(req.PageSize)))
 	}
 }
 
 func ListServices(rt core_runtime.Runtime) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		//req := &model.SearchInstanceReq{}
+		req := &model.ServiceListReq{}
+		if err := c.ShouldBindQuery(req); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
 
-		c.JSON(http.StatusOK, model.NewSuccessResp(""))
+		services, err := service.ListServices(rt, req)
+		if err != nil {
+			c.JSON(serviceErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(services))
 	}
 }
 
 func GetServiceDetail(rt core_runtime.Runtime) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		//req := &model.SearchInstanceReq{}
+		req := &model.ServiceDetailReq{}
+		if err := c.ShouldBindQuery(req); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		resp, err := service.GetServiceDetail(rt, req)
+		if err != nil {
+			c.JSON(serviceErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(resp))
+	}
+}
+
+func GetServiceDistribution(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &model.ServiceDistributionReq{}
+		if err := c.ShouldBindQuery(req); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		distribution, total, err := service.GetServiceDistribution(rt, req)
+		if err != nil {
+			c.JSON(serviceErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		pageRes := &model.PageData{}
+		c.JSON(http.StatusOK, model.NewSuccessResp(pageRes.WithData(distribution).WithTotal(total).WithCurPage(req.CurPage).WithPageSize(req.PageSize)))
+	}
+}
 
-		c.JSON(http.StatusOK, model.NewSuccessResp(""))
+func serviceErrorStatus(err error) int {
+	switch {
+	case validators.IsValidationError(err):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrServiceNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
 	}
 }
@@ -0,0 +1,81 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
+	"github.com/apache/dubbo-kubernetes/pkg/admin/service"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/dataplane"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/zone"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	"github.com/gin-gonic/gin"
+)
+
+func GenerateDataplaneToken(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &dataplane.TokenRequest{}
+		if err := c.ShouldBindJSON(req); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		token, err := service.GenerateDataplaneToken(rt, req)
+		if err != nil {
+			c.JSON(tokenErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(token))
+	}
+}
+
+func GenerateZoneToken(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &zone.TokenRequest{}
+		if err := c.ShouldBindJSON(req); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		token, err := service.GenerateZoneToken(rt, req)
+		if err != nil {
+			c.JSON(tokenErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(token))
+	}
+}
+
+func tokenErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrIssuerDisabled):
+		return http.StatusForbidden
+	case validators.IsValidationError(err):
+		return http.StatusBadRequest
+	case store.IsResourceNotFound(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
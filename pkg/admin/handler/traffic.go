@@ -0,0 +1,263 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+// API Definition: https://app.apifox.com/project/3732499
+// 流量管控
+
+import (
+	"errors"
+	"net/http"
+
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
+	"github.com/apache/dubbo-kubernetes/pkg/admin/service"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/core/route"
+	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	"github.com/gin-gonic/gin"
+)
+
+const ruleNameParam = "ruleName"
+
+type searchTrafficRulesFunc func(core_runtime.Runtime, *model.SearchTrafficRuleReq) ([]*model.TrafficRuleResp, int, error)
+
+func SearchConditionRoutes(rt core_runtime.Runtime) gin.HandlerFunc {
+	return searchTrafficRules(rt, service.SearchConditionRoutes)
+}
+
+func GetConditionRoute(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec, err := service.GetConditionRoute(rt, c.Param(ruleNameParam))
+		if err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(spec))
+	}
+}
+
+func CreateConditionRoute(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec := &mesh_proto.ConditionRoute{}
+		if err := c.ShouldBindJSON(spec); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		if err := service.CreateConditionRoute(rt, c.Param(ruleNameParam), spec); err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(spec))
+	}
+}
+
+func UpdateConditionRoute(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec := &mesh_proto.ConditionRoute{}
+		if err := c.ShouldBindJSON(spec); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		if err := service.UpdateConditionRoute(rt, c.Param(ruleNameParam), spec); err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(spec))
+	}
+}
+
+func DeleteConditionRoute(rt core_runtime.Runtime) gin.HandlerFunc {
+	return deleteTrafficRule(rt, service.DeleteConditionRoute)
+}
+
+func SearchTagRoutes(rt core_runtime.Runtime) gin.HandlerFunc {
+	return searchTrafficRules(rt, service.SearchTagRoutes)
+}
+
+func GetTagRoute(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec, err := service.GetTagRoute(rt, c.Param(ruleNameParam))
+		if err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(spec))
+	}
+}
+
+func CreateTagRoute(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec := &mesh_proto.TagRoute{}
+		if err := c.ShouldBindJSON(spec); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		if err := service.CreateTagRoute(rt, c.Param(ruleNameParam), spec); err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(spec))
+	}
+}
+
+func UpdateTagRoute(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec := &mesh_proto.TagRoute{}
+		if err := c.ShouldBindJSON(spec); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		if err := service.UpdateTagRoute(rt, c.Param(ruleNameParam), spec); err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(spec))
+	}
+}
+
+func DeleteTagRoute(rt core_runtime.Runtime) gin.HandlerFunc {
+	return deleteTrafficRule(rt, service.DeleteTagRoute)
+}
+
+func SearchDynamicConfigs(rt core_runtime.Runtime) gin.HandlerFunc {
+	return searchTrafficRules(rt, service.SearchDynamicConfigs)
+}
+
+func GetDynamicConfig(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec, err := service.GetDynamicConfig(rt, c.Param(ruleNameParam))
+		if err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(spec))
+	}
+}
+
+func CreateDynamicConfig(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec := &mesh_proto.DynamicConfig{}
+		if err := c.ShouldBindJSON(spec); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		if err := service.CreateDynamicConfig(rt, c.Param(ruleNameParam), spec); err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(spec))
+	}
+}
+
+func UpdateDynamicConfig(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec := &mesh_proto.DynamicConfig{}
+		if err := c.ShouldBindJSON(spec); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		if err := service.UpdateDynamicConfig(rt, c.Param(ruleNameParam), spec); err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(spec))
+	}
+}
+
+func DeleteDynamicConfig(rt core_runtime.Runtime) gin.HandlerFunc {
+	return deleteTrafficRule(rt, service.DeleteDynamicConfig)
+}
+
+// SimulateRoute evaluates the traffic rules for a request and returns the providers it would reach.
+func SimulateRoute(rt core_runtime.Runtime) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &route.Request{}
+		if err := c.ShouldBindJSON(req); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		result, err := service.SimulateRoute(rt, req)
+		if err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(result))
+	}
+}
+
+func searchTrafficRules(rt core_runtime.Runtime, search searchTrafficRulesFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &model.SearchTrafficRuleReq{}
+		if err := c.ShouldBindQuery(req); err != nil {
+			c.JSON(http.StatusBadRequest, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		rules, total, err := search(rt, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.NewErrorResp(err.Error()))
+			return
+		}
+
+		pageRes := &model.PageData{}
+		c.JSON(http.StatusOK, model.NewSuccessResp(pageRes.WithData(rules).WithTotal(total).WithCurPage(req.CurPage).WithPageSize(req.PageSize)))
+	}
+}
+
+func deleteTrafficRule(rt core_runtime.Runtime, del func(core_runtime.Runtime, string) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := del(rt, c.Param(ruleNameParam)); err != nil {
+			c.JSON(trafficRuleErrorStatus(err), model.NewErrorResp(err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, model.NewSuccessResp(nil))
+	}
+}
+
+func trafficRuleErrorStatus(err error) int {
+	switch {
+	case validators.IsValidationError(err):
+		return http.StatusBadRequest
+	case store.IsResourceNotFound(err):
+		return http.StatusNotFound
+	case errors.Is(err, &store.ResourceConflictError{}):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
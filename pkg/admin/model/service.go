@@ -0,0 +1,216 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+)
+
+const (
+	serviceMethodsKey = "methods"
+	serviceRetriesKey = "retries"
+	serviceDelayKey   = "delay"
+)
+
+type ServiceSearchReq struct {
+	ServiceName string `form:"serviceName"`
+	Version     string `form:"version"`
+	Group       string `form:"group"`
+	PageReq
+}
+
+type ServiceSearchResp struct {
+	ServiceName  string          `json:"serviceName"`
+	VersionGroup []*VersionGroup `json:"versionGroup"`
+}
+
+type ServiceListReq struct {
+	AppName string `form:"appName"`
+	Side    string `form:"side"`
+}
+
+type ServiceDetailReq struct {
+	ServiceName string `form:"serviceName" binding:"required"`
+	Version     string `form:"version"`
+	Group       string `form:"group"`
+}
+
+type ServiceDetailResp struct {
+	ServiceName  string          `json:"serviceName"`
+	VersionGroup []*VersionGroup `json:"versionGroup"`
+	Protocols    []string        `json:"protocols"`
+	Providers    []string        `json:"providers"`
+	Consumers    []string        `json:"consumers"`
+	Methods      []string        `json:"methods"`
+	Delay        string          `json:"delay"`
+	Timeout      string          `json:"timeOut"`
+	Retry        string          `json:"retry"`
+}
+
+type ServiceDistributionReq struct {
+	ServiceName string `form:"serviceName" binding:"required"`
+	Version     string `form:"version"`
+	Group       string `form:"group"`
+	Side        string `form:"side"`
+	PageReq
+}
+
+type ServiceDistributionResp struct {
+	AppName      string `json:"applicationName"`
+	InstanceNum  int    `json:"instanceNum"`
+	InstanceName string `json:"instanceName"`
+	Endpoint     string `json:"rpcPort"`
+	Timeout      string `json:"timeout"`
+	Retries      string `json:"retryNum"`
+	Label        string `json:"label"`
+	Zone         string `json:"zone"`
+	Version      string `json:"version"`
+	Group        string `json:"group"`
+}
+
+func (r *ServiceDistributionResp) FromServiceRecord(record *ServiceRecord, dataplane *mesh.DataplaneResource, instanceNum int) *ServiceDistributionResp {
+	r.AppName = record.App
+	r.InstanceNum = instanceNum
+	r.InstanceName = dataplane.GetMeta().GetName()
+	r.Endpoint = dataplane.GetIP()
+	if port := record.Info.GetPort(); port != 0 && record.Side() == consts.ProviderSide {
+		r.Endpoint = dataplane.GetIP() + consts.Colon + strconv.FormatInt(port, 10)
+	}
+	r.Timeout = record.Info.GetParams()[consts.TimeoutKey]
+	r.Retries = record.Info.GetParams()[serviceRetriesKey]
+	r.Label = formatLabels(dataplane.GetMeta().GetLabels())
+	r.Zone = record.Zone
+	if zone, ok := dataplane.GetMeta().GetLabels()[mesh_proto.ZoneTag]; ok && r.Zone == "" {
+		r.Zone = zone
+	}
+	r.Version = record.Info.GetVersion()
+	r.Group = record.Info.GetGroup()
+	return r
+}
+
+type VersionGroup struct {
+	Version string `json:"version"`
+	Group   string `json:"group"`
+}
+
+// ServiceRecord is a service provided or consumed by an application, as reported in its metadata.
+type ServiceRecord struct {
+	App  string
+	Zone string
+	Info *mesh_proto.ServiceInfo
+}
+
+// Side returns whether the application provides or consumes the service.
+func (r *ServiceRecord) Side() string {
+	if side := r.Info.GetParams()[consts.Side]; side != "" {
+		return side
+	}
+	return consts.ProviderSide
+}
+
+func (r *ServiceRecord) Matches(version, group string) bool {
+	return (version == "" || r.Info.GetVersion() == version) &&
+		(group == "" || r.Info.GetGroup() == group)
+}
+
+// ServiceDetail aggregates all the records of one service.
+type ServiceDetail struct {
+	versionGroups map[VersionGroup]struct{}
+	Protocols     Set
+	Providers     Set
+	Consumers     Set
+	Methods       Set
+	params        map[string]string
+}
+
+func NewServiceDetail() *ServiceDetail {
+	return &ServiceDetail{
+		versionGroups: make(map[VersionGroup]struct{}),
+		Protocols:     NewSet(),
+		Providers:     NewSet(),
+		Consumers:     NewSet(),
+		Methods:       NewSet(),
+	}
+}
+
+func (d *ServiceDetail) Merge(record *ServiceRecord) {
+	if record.Side() == consts.ConsumerSide {
+		d.Consumers.Add(record.App)
+		return
+	}
+	d.versionGroups[VersionGroup{Version: record.Info.GetVersion(), Group: record.Info.GetGroup()}] = struct{}{}
+	d.Providers.Add(record.App)
+	if protocol := record.Info.GetProtocol(); protocol != "" {
+		d.Protocols.Add(protocol)
+	}
+	if methods := record.Info.GetParams()[serviceMethodsKey]; methods != "" {
+		d.Methods.Add(strings.Split(methods, ",")...)
+	}
+	if d.params == nil {
+		// the configuration of the first provider is shown
+		d.params = record.Info.GetParams()
+	}
+}
+
+func (d *ServiceDetail) VersionGroups() []*VersionGroup {
+	res := make([]*VersionGroup, 0, len(d.versionGroups))
+	for vg := range d.versionGroups {
+		vg := vg
+		res = append(res, &vg)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Version != res[j].Version {
+			return res[i].Version < res[j].Version
+		}
+		return res[i].Group < res[j].Group
+	})
+	return res
+}
+
+func (r *ServiceDetailResp) FromServiceDetail(sd *ServiceDetail) *ServiceDetailResp {
+	r.VersionGroup = sd.VersionGroups()
+	r.Protocols = sortedValues(sd.Protocols)
+	r.Providers = sortedValues(sd.Providers)
+	r.Consumers = sortedValues(sd.Consumers)
+	r.Methods = sortedValues(sd.Methods)
+	r.Delay = sd.params[serviceDelayKey]
+	r.Timeout = sd.params[consts.TimeoutKey]
+	r.Retry = sd.params[serviceRetriesKey]
+	return r
+}
+
+func sortedValues(s Set) []string {
+	values := s.Values()
+	sort.Strings(values)
+	return values
+}
+
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,73 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+type SearchTrafficRuleReq struct {
+	Keywords string `form:"serviceGovernance"`
+	PageReq
+}
+
+type TrafficRuleResp struct {
+	RuleName string `json:"ruleName"`
+	// RuleGranularity is true when the rule is bound to a service and false for an application.
+	RuleGranularity bool   `json:"ruleGranularity"`
+	Enable          bool   `json:"enable"`
+	CreateTime      string `json:"createTime"`
+}
+
+func (r *TrafficRuleResp) FromConditionRouteResource(cr *mesh.ConditionRouteResource) *TrafficRuleResp {
+	r.fromMeta(cr.GetMeta(), cr.Spec.GetKey(), consts.ConditionRuleSuffix)
+	r.RuleGranularity = cr.Spec.GetScope() == consts.Service
+	r.Enable = cr.Spec.GetEnabled()
+	return r
+}
+
+func (r *TrafficRuleResp) FromTagRouteResource(tr *mesh.TagRouteResource) *TrafficRuleResp {
+	r.fromMeta(tr.GetMeta(), tr.Spec.GetKey(), consts.TagRuleSuffix)
+	// tag route can only be bound to an application
+	r.RuleGranularity = false
+	r.Enable = tr.Spec.GetEnabled()
+	return r
+}
+
+func (r *TrafficRuleResp) FromDynamicConfigResource(dc *mesh.DynamicConfigResource) *TrafficRuleResp {
+	r.fromMeta(dc.GetMeta(), dc.Spec.GetKey(), consts.ConfiguratorRuleSuffix)
+	r.RuleGranularity = dc.Spec.GetScope() == consts.Service
+	r.Enable = dc.Spec.GetEnabled()
+	return r
+}
+
+// fromMeta fills the rule name and creation time. The rule name is derived from the key when
+// possible, because the name in the store is rewritten on kubernetes.
+func (r *TrafficRuleResp) fromMeta(meta core_model.ResourceMeta, key string, suffix string) {
+	r.RuleName = meta.GetName()
+	if key != "" {
+		r.RuleName = key + suffix
+	}
+	if t := meta.GetCreationTime(); !t.IsZero() {
+		r.CreateTime = t.Format(time.DateTime)
+	}
+}
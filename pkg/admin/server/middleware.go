@@ -0,0 +1,45 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"net"
+	"net/http"
+)
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
+)
+
+// localhostOnly rejects the requests which don't come from the loopback interface. The admin server
+// doesn't authenticate its clients, so the endpoints issuing credentials are served only to the local ones.
+// The address of the connection is checked instead of the client IP, which the clients can forge with headers.
+func localhostOnly() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		host, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+		if ip := net.ParseIP(host); err != nil || ip == nil || !ip.IsLoopback() {
+			c.AbortWithStatusJSON(http.StatusForbidden, model.NewErrorResp("the endpoint is available only from localhost"))
+			return
+		}
+		c.Next()
+	}
+}
@@ -36,9 +36,55 @@ func initRouter(r *gin.Engine, rt core_runtime.Runtime) {
 		application.GET("/instance/info", handler.GetApplicationTabInstanceInfo(rt))
 	}
 
+	{
+		service := router.Group("/service")
+		service.GET("/search", handler.SearchServices(rt))
+		service.GET("/list", handler.ListServices(rt))
+		service.GET("/detail", handler.GetServiceDetail(rt))
+		service.GET("/distribution", handler.GetServiceDistribution(rt))
+	}
+
 	{
 		dev := router.Group("/dev")
 		dev.GET("/instances", handler.GetInstances(rt))
 		dev.GET("/metas", handler.GetMetas(rt))
 	}
+
+	{
+		routingRule := router.Group("/routingRule")
+		routingRule.GET("/search", handler.SearchConditionRoutes(rt))
+		routingRule.GET("/:ruleName", handler.GetConditionRoute(rt))
+		routingRule.POST("/:ruleName", handler.CreateConditionRoute(rt))
+		routingRule.PUT("/:ruleName", handler.UpdateConditionRoute(rt))
+		routingRule.DELETE("/:ruleName", handler.DeleteConditionRoute(rt))
+	}
+
+	{
+		tagRule := router.Group("/tagRule")
+		tagRule.GET("/search", handler.SearchTagRoutes(rt))
+		tagRule.GET("/:ruleName", handler.GetTagRoute(rt))
+		tagRule.POST("/:ruleName", handler.CreateTagRoute(rt))
+		tagRule.PUT("/:ruleName", handler.UpdateTagRoute(rt))
+		tagRule.DELETE("/:ruleName", handler.DeleteTagRoute(rt))
+	}
+
+	{
+		dynamicConfig := router.Group("/dynamicConfig")
+		dynamicConfig.GET("/search", handler.SearchDynamicConfigs(rt))
+		dynamicConfig.GET("/:ruleName", handler.GetDynamicConfig(rt))
+		dynamicConfig.POST("/:ruleName", handler.CreateDynamicConfig(rt))
+		dynamicConfig.PUT("/:ruleName", handler.UpdateDynamicConfig(rt))
+		dynamicConfig.DELETE("/:ruleName", handler.DeleteDynamicConfig(rt))
+	}
+
+	{
+		traffic := router.Group("/traffic")
+		traffic.POST("/simulate", handler.SimulateRoute(rt))
+	}
+
+	{
+		tokens := router.Group("/tokens", localhostOnly())
+		tokens.POST("/dataplane", handler.GenerateDataplaneToken(rt))
+		tokens.POST("/zone", handler.GenerateZoneToken(rt))
+	}
 }
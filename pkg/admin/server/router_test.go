@@ -0,0 +1,82 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/admin/server"
+	"github.com/apache/dubbo-kubernetes/pkg/config/admin"
+)
+
+var _ = Describe("Token endpoints", func() {
+	var adminServer *server.AdminServer
+
+	BeforeEach(func() {
+		adminServer = server.NewAdminServer(*admin.DefaultAdminConfig(), "dubbo-system").InitHTTPRouter(nil)
+	})
+
+	request := func(path, remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader("{"))
+		req.RemoteAddr = remoteAddr
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		recorder := httptest.NewRecorder()
+		adminServer.Engine.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	DescribeTable("should reject the requests which don't come from localhost",
+		func(path string, headers map[string]string) {
+			// when
+			resp := request(path, "10.0.0.1:41234", headers)
+
+			// then
+			Expect(resp.Code).To(Equal(http.StatusForbidden))
+		},
+		Entry("dataplane token", "/api/v1/tokens/dataplane", nil),
+		Entry("zone token", "/api/v1/tokens/zone", nil),
+		Entry("dataplane token with a forged client IP", "/api/v1/tokens/dataplane", map[string]string{
+			"X-Forwarded-For": "127.0.0.1",
+			"X-Real-IP":       "127.0.0.1",
+		}),
+	)
+
+	DescribeTable("should serve the requests from localhost",
+		func(remoteAddr string) {
+			// when
+			resp := request("/api/v1/tokens/dataplane", remoteAddr, nil)
+
+			// then the request reaches the handler, which rejects the malformed body
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		},
+		Entry("IPv4", "127.0.0.1:41234"),
+		Entry("IPv6", "[::1]:41234"),
+	)
+})
@@ -0,0 +1,49 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"strconv"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
+)
+
+// pageRange returns the bounds of the requested page within total items, curPage starts from 1.
+// All the items are returned when no page size is given.
+func pageRange(total int, req model.PageReq) (int, int) {
+	if req.PageSize <= 0 {
+		return 0, total
+	}
+	curPage := req.CurPage
+	if curPage < 1 {
+		curPage = 1
+	}
+	start := min((curPage-1)*req.PageSize, total)
+	end := min(start+req.PageSize, total)
+	return start, end
+}
+
+// pageOffset returns the store offset of the first item of the requested page, curPage starts from 1.
+func pageOffset(req model.PageReq) string {
+	if req.PageSize <= 0 || req.CurPage <= 1 {
+		return ""
+	}
+	return strconv.Itoa((req.CurPage - 1) * req.PageSize)
+}
@@ -18,8 +18,6 @@
 package service
 
 import (
-	"strconv"
-
 	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
 	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
@@ -31,7 +29,7 @@ func SearchInstances(rt core_runtime.Runtime, req *model.SearchInstanceReq) ([]*
 	manager := rt.ResourceManager()
 	dataplaneList := &mesh.DataplaneResourceList{}
 
-	if err := manager.List(rt.AppContext(), dataplaneList, store.ListByNameContains(req.AppName), store.ListByPage(req.PageSize, strconv.Itoa(req.CurPage))); err != nil {
+	if err := manager.List(rt.AppContext(), dataplaneList, store.ListByNameContains(req.AppName), store.ListByPage(req.PageSize, pageOffset(req.PageReq))); err != nil {
 		return nil, nil, err
 	}
 
@@ -0,0 +1,66 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"sync"
+)
+
+import (
+	. "github.com/onsi/gomega"
+)
+
+import (
+	dubbo_cp "github.com/apache/dubbo-kubernetes/pkg/config/app/dubbo-cp"
+	store_config "github.com/apache/dubbo-kubernetes/pkg/config/core/resources/store"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_manager "github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
+	resources_memory "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	test_runtime "github.com/apache/dubbo-kubernetes/pkg/test/runtime"
+)
+
+var (
+	testBuilderOnce sync.Once
+	testBuilder     *core_runtime.Builder
+	testConfig      dubbo_cp.Config
+)
+
+// newTestRuntime returns a runtime with an empty memory store. The builder is shared,
+// because the dp-server it creates can only register its metrics once.
+func newTestRuntime(storeType store_config.StoreType) core_runtime.Runtime {
+	testBuilderOnce.Do(func() {
+		testConfig = dubbo_cp.DefaultConfig()
+		builder, err := test_runtime.BuilderFor(context.Background(), testConfig)
+		Expect(err).ToNot(HaveOccurred())
+		testBuilder = builder
+	})
+	testConfig.Store.Type = storeType
+	rm := core_manager.NewResourceManager(store.NewPaginationStore(resources_memory.NewStore()))
+	customizable := core_manager.NewCustomizableResourceManager(rm, nil)
+	rt, err := testBuilder.
+		WithResourceManager(customizable).
+		WithReadOnlyResourceManager(customizable).
+		Build()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(rt.ResourceManager().Create(context.Background(), core_mesh.NewMeshResource(),
+		store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+	return rt
+}
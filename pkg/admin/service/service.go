@@ -0,0 +1,289 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+var ErrServiceNotFound = errors.New("service not found")
+
+func SearchServices(rt core_runtime.Runtime, req *model.ServiceSearchReq) ([]*model.ServiceSearchResp, int, error) {
+	records, err := listServiceRecords(rt)
+	if err != nil {
+		return nil, 0, err
+	}
+	mappings, err := listServiceMappings(rt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	details := make(map[string]*model.ServiceDetail)
+	for _, record := range records {
+		name := record.Info.GetName()
+		if !strings.Contains(name, req.ServiceName) || !record.Matches(req.Version, req.Group) {
+			continue
+		}
+		serviceDetail(details, name).Merge(record)
+	}
+	// services only known from mappings have no version or group
+	if req.Version == "" && req.Group == "" {
+		for name := range mappings {
+			if strings.Contains(name, req.ServiceName) {
+				serviceDetail(details, name)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(details))
+	for name := range details {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	start, end := pageRange(len(names), req.PageReq)
+	res := make([]*model.ServiceSearchResp, 0, end-start)
+	for _, name := range names[start:end] {
+		res = append(res, &model.ServiceSearchResp{
+			ServiceName:  name,
+			VersionGroup: details[name].VersionGroups(),
+		})
+	}
+	return res, len(names), nil
+}
+
+func ListServices(rt core_runtime.Runtime, req *model.ServiceListReq) ([]string, error) {
+	if err := validateSide(req.Side); err != nil {
+		return nil, err
+	}
+	records, err := listServiceRecords(rt)
+	if err != nil {
+		return nil, err
+	}
+
+	services := model.NewSet()
+	for _, record := range records {
+		if req.AppName != "" && record.App != req.AppName {
+			continue
+		}
+		if req.Side != "" && record.Side() != req.Side {
+			continue
+		}
+		services.Add(record.Info.GetName())
+	}
+	if req.Side != consts.ConsumerSide {
+		mappings, err := listServiceMappings(rt)
+		if err != nil {
+			return nil, err
+		}
+		for name, apps := range mappings {
+			if req.AppName == "" || apps.Contains(req.AppName) {
+				services.Add(name)
+			}
+		}
+	}
+
+	res := services.Values()
+	sort.Strings(res)
+	return res, nil
+}
+
+func GetServiceDetail(rt core_runtime.Runtime, req *model.ServiceDetailReq) (*model.ServiceDetailResp, error) {
+	records, err := listServiceRecords(rt)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := listServiceMappings(rt)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := model.NewServiceDetail()
+	found := false
+	for _, record := range records {
+		if record.Info.GetName() != req.ServiceName || !record.Matches(req.Version, req.Group) {
+			continue
+		}
+		detail.Merge(record)
+		found = true
+	}
+	if apps, ok := mappings[req.ServiceName]; ok && req.Version == "" && req.Group == "" {
+		detail.Providers.Add(apps.Values()...)
+		found = true
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrServiceNotFound, req.ServiceName)
+	}
+
+	resp := &model.ServiceDetailResp{
+		ServiceName: req.ServiceName,
+	}
+	return resp.FromServiceDetail(detail), nil
+}
+
+func GetServiceDistribution(rt core_runtime.Runtime, req *model.ServiceDistributionReq) ([]*model.ServiceDistributionResp, int, error) {
+	if err := validateSide(req.Side); err != nil {
+		return nil, 0, err
+	}
+	side := req.Side
+	if side == "" {
+		side = consts.ProviderSide
+	}
+
+	records, err := listServiceRecords(rt)
+	if err != nil {
+		return nil, 0, err
+	}
+	dataplanes, err := listDataplanesByApp(rt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the same service is reported by every revision of an application
+	seen := make(map[string]struct{})
+	var matched []*model.ServiceRecord
+	for _, record := range records {
+		if record.Info.GetName() != req.ServiceName || record.Side() != side || !record.Matches(req.Version, req.Group) {
+			continue
+		}
+		key := strings.Join([]string{record.App, record.Info.GetVersion(), record.Info.GetGroup()}, consts.Colon)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		matched = append(matched, record)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if a.App != b.App {
+			return a.App < b.App
+		}
+		if a.Info.GetVersion() != b.Info.GetVersion() {
+			return a.Info.GetVersion() < b.Info.GetVersion()
+		}
+		return a.Info.GetGroup() < b.Info.GetGroup()
+	})
+
+	var res []*model.ServiceDistributionResp
+	for _, record := range matched {
+		instances := dataplanes[record.App]
+		for _, dataplane := range instances {
+			item := &model.ServiceDistributionResp{}
+			res = append(res, item.FromServiceRecord(record, dataplane, len(instances)))
+		}
+	}
+
+	start, end := pageRange(len(res), req.PageReq)
+	return res[start:end], len(res), nil
+}
+
+func validateSide(side string) error {
+	var verr validators.ValidationError
+	switch side {
+	case "", consts.ProviderSide, consts.ConsumerSide:
+	default:
+		verr.AddViolation("side", fmt.Sprintf("must be either %q or %q", consts.ProviderSide, consts.ConsumerSide))
+	}
+	return verr.OrNil()
+}
+
+func serviceDetail(details map[string]*model.ServiceDetail, name string) *model.ServiceDetail {
+	detail, ok := details[name]
+	if !ok {
+		detail = model.NewServiceDetail()
+		details[name] = detail
+	}
+	return detail
+}
+
+// listServiceRecords collects the services of every application from metadata.
+func listServiceRecords(rt core_runtime.Runtime) ([]*model.ServiceRecord, error) {
+	metadataList := &mesh.MetaDataResourceList{}
+	if err := rt.ResourceManager().List(rt.AppContext(), metadataList); err != nil {
+		return nil, err
+	}
+
+	var records []*model.ServiceRecord
+	for _, metadata := range metadataList.Items {
+		keys := make([]string, 0, len(metadata.Spec.GetServices()))
+		for key := range metadata.Spec.GetServices() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			records = append(records, &model.ServiceRecord{
+				App:  metadata.Spec.GetApp(),
+				Zone: metadata.Spec.GetZone(),
+				Info: metadata.Spec.GetServices()[key],
+			})
+		}
+	}
+	return records, nil
+}
+
+// listServiceMappings returns the applications providing each interface.
+func listServiceMappings(rt core_runtime.Runtime) (map[string]model.Set, error) {
+	mappingList := &mesh.MappingResourceList{}
+	if err := rt.ResourceManager().List(rt.AppContext(), mappingList); err != nil {
+		return nil, err
+	}
+
+	mappings := make(map[string]model.Set)
+	for _, mapping := range mappingList.Items {
+		name := mapping.Spec.GetInterfaceName()
+		if name == "" {
+			continue
+		}
+		apps, ok := mappings[name]
+		if !ok {
+			apps = model.NewSet()
+			mappings[name] = apps
+		}
+		apps.Add(mapping.Spec.GetApplicationNames()...)
+	}
+	return mappings, nil
+}
+
+func listDataplanesByApp(rt core_runtime.Runtime) (map[string][]*mesh.DataplaneResource, error) {
+	dataplaneList := &mesh.DataplaneResourceList{}
+	if err := rt.ResourceManager().List(rt.AppContext(), dataplaneList); err != nil {
+		return nil, err
+	}
+
+	dataplanes := make(map[string][]*mesh.DataplaneResource)
+	for _, dataplane := range dataplaneList.Items {
+		appName := dataplane.Meta.GetLabels()[mesh_proto.AppTag]
+		dataplanes[appName] = append(dataplanes[appName], dataplane)
+	}
+	for _, items := range dataplanes {
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].Meta.GetName() < items[j].Meta.GetName()
+		})
+	}
+	return dataplanes, nil
+}
@@ -0,0 +1,222 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
+	store_config "github.com/apache/dubbo-kubernetes/pkg/config/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+var _ = Describe("Services", func() {
+	var rt core_runtime.Runtime
+
+	createMetadata := func(name, app string, services ...*mesh_proto.ServiceInfo) {
+		spec := &mesh_proto.MetaData{
+			App:      app,
+			Revision: name,
+			Services: map[string]*mesh_proto.ServiceInfo{},
+		}
+		for _, service := range services {
+			spec.Services[service.Group+"/"+service.Name+":"+service.Version+":"+service.Protocol] = service
+		}
+		Expect(rt.ResourceManager().Create(context.Background(), &core_mesh.MetaDataResource{Spec: spec},
+			store.CreateByKey(name, core_model.DefaultMesh))).To(Succeed())
+	}
+
+	createMapping := func(name, interfaceName string, apps ...string) {
+		Expect(rt.ResourceManager().Create(context.Background(), &core_mesh.MappingResource{
+			Spec: &mesh_proto.Mapping{InterfaceName: interfaceName, ApplicationNames: apps},
+		}, store.CreateByKey(name, core_model.DefaultMesh))).To(Succeed())
+	}
+
+	provided := func(name, version, group string) *mesh_proto.ServiceInfo {
+		return &mesh_proto.ServiceInfo{
+			Name:     name,
+			Version:  version,
+			Group:    group,
+			Protocol: "tri",
+			Port:     50052,
+			Params:   map[string]string{"methods": "greet,sayHello", consts.TimeoutKey: "3000"},
+		}
+	}
+
+	consumed := func(name string) *mesh_proto.ServiceInfo {
+		return &mesh_proto.ServiceInfo{
+			Name:   name,
+			Params: map[string]string{consts.Side: consts.ConsumerSide},
+		}
+	}
+
+	names := func(resp []*model.ServiceSearchResp) []string {
+		var res []string
+		for _, item := range resp {
+			res = append(res, item.ServiceName)
+		}
+		return res
+	}
+
+	BeforeEach(func() {
+		rt = newTestRuntime(store_config.MemoryStore)
+		createMetadata("greet-v1", "greet",
+			provided("org.apache.dubbo.GreetService", "1.0.0", ""),
+			provided("org.apache.dubbo.GreetService", "2.0.0", "gray"),
+		)
+		createMetadata("shop-v1", "shop",
+			provided("org.apache.dubbo.ShopService", "", ""),
+			consumed("org.apache.dubbo.GreetService"),
+		)
+		createMapping("org-apache-dubbo-detailservice", "org.apache.dubbo.DetailService", "detail")
+	})
+
+	Describe("SearchServices", func() {
+		It("should list all services sorted by name", func() {
+			// when
+			res, total, err := SearchServices(rt, &model.ServiceSearchReq{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(total).To(Equal(3))
+			Expect(names(res)).To(Equal([]string{
+				"org.apache.dubbo.DetailService",
+				"org.apache.dubbo.GreetService",
+				"org.apache.dubbo.ShopService",
+			}))
+			Expect(res[1].VersionGroup).To(Equal([]*model.VersionGroup{
+				{Version: "1.0.0"},
+				{Version: "2.0.0", Group: "gray"},
+			}))
+		})
+
+		It("should filter by name, version and group", func() {
+			// when
+			res, total, err := SearchServices(rt, &model.ServiceSearchReq{
+				ServiceName: "Greet",
+				Version:     "2.0.0",
+				Group:       "gray",
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(total).To(Equal(1))
+			Expect(names(res)).To(Equal([]string{"org.apache.dubbo.GreetService"}))
+			Expect(res[0].VersionGroup).To(Equal([]*model.VersionGroup{{Version: "2.0.0", Group: "gray"}}))
+		})
+
+		It("should skip services only known from mappings when filtering by version", func() {
+			// when
+			res, _, err := SearchServices(rt, &model.ServiceSearchReq{Version: "1.0.0"})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(res)).To(Equal([]string{"org.apache.dubbo.GreetService"}))
+		})
+
+		DescribeTable("should paginate",
+			func(page model.PageReq, expected []string) {
+				// when
+				res, total, err := SearchServices(rt, &model.ServiceSearchReq{PageReq: page})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(total).To(Equal(3))
+				Expect(names(res)).To(Equal(expected))
+			},
+			Entry("first page", model.PageReq{CurPage: 1, PageSize: 2},
+				[]string{"org.apache.dubbo.DetailService", "org.apache.dubbo.GreetService"}),
+			Entry("last page", model.PageReq{CurPage: 2, PageSize: 2},
+				[]string{"org.apache.dubbo.ShopService"}),
+			Entry("page after the last one", model.PageReq{CurPage: 3, PageSize: 2}, nil),
+		)
+	})
+
+	Describe("ListServices", func() {
+		It("should list the services an application provides", func() {
+			// when
+			res, err := ListServices(rt, &model.ServiceListReq{AppName: "shop", Side: consts.ProviderSide})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]string{"org.apache.dubbo.ShopService"}))
+		})
+
+		It("should list the services an application consumes", func() {
+			// when
+			res, err := ListServices(rt, &model.ServiceListReq{AppName: "shop", Side: consts.ConsumerSide})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]string{"org.apache.dubbo.GreetService"}))
+		})
+
+		It("should reject an unknown side", func() {
+			// when
+			_, err := ListServices(rt, &model.ServiceListReq{Side: "both"})
+
+			// then
+			Expect(validators.IsValidationError(err)).To(BeTrue())
+		})
+	})
+
+	Describe("GetServiceDetail", func() {
+		It("should aggregate providers, consumers and methods", func() {
+			// when
+			res, err := GetServiceDetail(rt, &model.ServiceDetailReq{ServiceName: "org.apache.dubbo.GreetService"})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Providers).To(Equal([]string{"greet"}))
+			Expect(res.Consumers).To(Equal([]string{"shop"}))
+			Expect(res.Protocols).To(Equal([]string{"tri"}))
+			Expect(res.Methods).To(Equal([]string{"greet", "sayHello"}))
+			Expect(res.Timeout).To(Equal("3000"))
+		})
+
+		It("should return the providers of a service only known from mappings", func() {
+			// when
+			res, err := GetServiceDetail(rt, &model.ServiceDetailReq{ServiceName: "org.apache.dubbo.DetailService"})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Providers).To(Equal([]string{"detail"}))
+		})
+
+		It("should fail for an unknown service", func() {
+			// when
+			_, err := GetServiceDetail(rt, &model.ServiceDetailReq{ServiceName: "org.apache.dubbo.Unknown"})
+
+			// then
+			Expect(err).To(MatchError(ErrServiceNotFound))
+		})
+	})
+})
@@ -0,0 +1,112 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/core/route"
+	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+// SimulateRoute evaluates the traffic rules against the current providers of
+// the requested service and returns the providers the request would reach.
+func SimulateRoute(rt core_runtime.Runtime, req *route.Request) (*route.Result, error) {
+	var verr validators.ValidationError
+	if req.Service == "" {
+		verr.AddViolation("service", validators.MustBeDefined)
+	}
+	if err := verr.OrNil(); err != nil {
+		return nil, err
+	}
+
+	endpoints, err := listServiceEndpoints(rt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	conditionRoutes := &mesh.ConditionRouteResourceList{}
+	if err := rt.ResourceManager().List(rt.AppContext(), conditionRoutes, store.ListByMesh(core_model.DefaultMesh)); err != nil {
+		return nil, err
+	}
+	tagRoutes := &mesh.TagRouteResourceList{}
+	if err := rt.ResourceManager().List(rt.AppContext(), tagRoutes, store.ListByMesh(core_model.DefaultMesh)); err != nil {
+		return nil, err
+	}
+	dynamicConfigs := &mesh.DynamicConfigResourceList{}
+	if err := rt.ResourceManager().List(rt.AppContext(), dynamicConfigs, store.ListByMesh(core_model.DefaultMesh)); err != nil {
+		return nil, err
+	}
+
+	return route.Simulate(req, endpoints, route.Rules{
+		ConditionRoutes: conditionRoutes.Items,
+		TagRoutes:       tagRoutes.Items,
+		DynamicConfigs:  dynamicConfigs.Items,
+	}), nil
+}
+
+// listServiceEndpoints returns an endpoint for every instance of the applications
+// providing the requested service.
+func listServiceEndpoints(rt core_runtime.Runtime, req *route.Request) ([]*route.Endpoint, error) {
+	records, err := listServiceRecords(rt)
+	if err != nil {
+		return nil, err
+	}
+	dataplanes, err := listDataplanesByApp(rt)
+	if err != nil {
+		return nil, err
+	}
+
+	// the same service is reported by every revision of an application
+	seen := make(map[string]struct{})
+	var endpoints []*route.Endpoint
+	for _, record := range records {
+		if record.Info.GetName() != req.Service || record.Side() != consts.ProviderSide ||
+			record.Info.GetVersion() != req.Version || record.Info.GetGroup() != req.Group {
+			continue
+		}
+		if _, ok := seen[record.App]; ok {
+			continue
+		}
+		seen[record.App] = struct{}{}
+
+		for _, dataplane := range dataplanes[record.App] {
+			params := make(map[string]string)
+			for key, value := range dataplane.GetMeta().GetLabels() {
+				params[key] = value
+			}
+			for key, value := range record.Info.GetParams() {
+				params[key] = value
+			}
+			params["version"] = record.Info.GetVersion()
+			params["group"] = record.Info.GetGroup()
+			params["protocol"] = record.Info.GetProtocol()
+			endpoints = append(endpoints, &route.Endpoint{
+				Name:        dataplane.GetMeta().GetName(),
+				Application: record.App,
+				Address:     dataplane.GetIP(),
+				Port:        record.Info.GetPort(),
+				Params:      params,
+			})
+		}
+	}
+	return endpoints, nil
+}
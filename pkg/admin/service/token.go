@@ -0,0 +1,102 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/dataplane"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/zone"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+// ErrIssuerDisabled is returned when tokens are requested while the issuer is disabled,
+// in which case tokens have to be signed offline.
+var ErrIssuerDisabled = errors.New("token issuer is disabled, sign the tokens offline with 'dubboctl generate' and '--signing-key-path'")
+
+// GenerateDataplaneToken issues a dataplane token signed with the latest signing key of the mesh.
+func GenerateDataplaneToken(rt core_runtime.Runtime, req *dataplane.TokenRequest) (string, error) {
+	if !rt.Config().DpServer.Authn.DpProxy.DpToken.EnableIssuer {
+		return "", ErrIssuerDisabled
+	}
+	var verr validators.ValidationError
+	if req.Mesh == "" {
+		verr.AddViolation("mesh", validators.MustBeDefined)
+	}
+	switch mesh_proto.ProxyType(req.Type) {
+	case "", mesh_proto.DataplaneProxyType:
+	default:
+		verr.AddViolation("type", fmt.Sprintf("must be empty or %q", mesh_proto.DataplaneProxyType))
+	}
+	validFor := validateValidFor(&verr, req.ValidFor)
+	if err := verr.OrNil(); err != nil {
+		return "", err
+	}
+
+	if err := rt.ResourceManager().Get(rt.AppContext(), mesh.NewMeshResource(), store.GetByKey(req.Mesh, core_model.NoMesh)); err != nil {
+		return "", err
+	}
+	return dataplane.NewIssuer(rt.ResourceManager()).Generate(rt.AppContext(), req.Identity(), validFor)
+}
+
+// GenerateZoneToken issues a zone token signed with the latest zone token signing key.
+func GenerateZoneToken(rt core_runtime.Runtime, req *zone.TokenRequest) (string, error) {
+	if !rt.Config().DpServer.Authn.ZoneProxy.ZoneToken.EnableIssuer {
+		return "", ErrIssuerDisabled
+	}
+	var verr validators.ValidationError
+	if req.Zone == "" {
+		verr.AddViolation("zone", validators.MustBeDefined)
+	}
+	if len(req.Scope) == 0 {
+		verr.AddViolation("scope", validators.MustNotBeEmpty)
+	}
+	for i, scope := range req.Scope {
+		if scope != zone.IngressScope && scope != zone.EgressScope {
+			verr.AddViolationAt(validators.RootedAt("scope").Index(i), fmt.Sprintf("must be one of %q", zone.FullScope))
+		}
+	}
+	validFor := validateValidFor(&verr, req.ValidFor)
+	if err := verr.OrNil(); err != nil {
+		return "", err
+	}
+	return zone.NewIssuer(rt.ResourceManager()).Generate(rt.AppContext(), req.Identity(), validFor)
+}
+
+func validateValidFor(verr *validators.ValidationError, validFor string) time.Duration {
+	if validFor == "" {
+		verr.AddViolation("validFor", validators.MustBeDefined)
+		return 0
+	}
+	duration, err := time.ParseDuration(validFor)
+	if err != nil {
+		verr.AddViolation("validFor", "must be a valid duration, e.g. 24h")
+		return 0
+	}
+	if duration <= 0 {
+		verr.AddViolation("validFor", validators.HasToBeGreaterThanZero)
+	}
+	return duration
+}
@@ -0,0 +1,427 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
+	store_config "github.com/apache/dubbo-kubernetes/pkg/config/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+// ruleTarget is what a traffic rule is bound to. The rule name is the rule key
+// followed by the suffix of the rule type, and the key is either an application
+// name or a service key in the format of "${interface}:${version}:${group}".
+type ruleTarget struct {
+	key         string
+	application string
+	service     string
+	version     string
+	group       string
+}
+
+func parseRuleName(ruleName string, suffix string) (*ruleTarget, error) {
+	var verr validators.ValidationError
+	key := strings.TrimSuffix(ruleName, suffix)
+	if key == ruleName {
+		verr.AddViolation("ruleName", "must end with "+suffix)
+		return nil, verr.OrNil()
+	}
+	if key == "" {
+		verr.AddViolation("ruleName", "key must not be empty")
+		return nil, verr.OrNil()
+	}
+
+	target := &ruleTarget{key: key}
+	if !strings.Contains(key, consts.Colon) {
+		target.application = key
+		return target, nil
+	}
+	parts := strings.SplitN(key, consts.Colon, 3)
+	target.service = parts[0]
+	if len(parts) > 1 {
+		target.version = parts[1]
+	}
+	if len(parts) > 2 {
+		target.group = parts[2]
+	}
+	if target.service == "" {
+		verr.AddViolation("ruleName", "service must not be empty")
+		return nil, verr.OrNil()
+	}
+	return target, nil
+}
+
+func (t *ruleTarget) scope() string {
+	if t.application != "" {
+		return consts.Application
+	}
+	return consts.Service
+}
+
+// check makes sure that the key and scope in the spec match the rule name.
+func (t *ruleTarget) check(key, scope string) error {
+	var verr validators.ValidationError
+	if key != t.key {
+		verr.AddViolation("key", "must match the rule name, expected "+t.key)
+	}
+	if scope != t.scope() {
+		verr.AddViolation("scope", "must match the rule name, expected "+t.scope())
+	}
+	return verr.OrNil()
+}
+
+// labels are used by the traditional store to locate the rule in the config center.
+func (t *ruleTarget) labels() map[string]string {
+	if t.application != "" {
+		return map[string]string{
+			mesh_proto.Application: t.application,
+		}
+	}
+	return map[string]string{
+		mesh_proto.Service:        t.service,
+		mesh_proto.ServiceVersion: t.version,
+		mesh_proto.ServiceGroup:   t.group,
+	}
+}
+
+func (t *ruleTarget) getOptions(ruleName string) []store.GetOptionsFunc {
+	return []store.GetOptionsFunc{
+		store.GetByKey(ruleName, core_model.DefaultMesh),
+		store.GetByApplication(t.application),
+		store.GetByService(t.service),
+		store.GetByServiceVersion(t.version),
+		store.GetByServiceGroup(t.group),
+	}
+}
+
+func (t *ruleTarget) deleteOptions(ruleName string) []store.DeleteOptionsFunc {
+	return []store.DeleteOptionsFunc{
+		store.DeleteByKey(ruleName, core_model.DefaultMesh),
+		store.DeleteByApplication(t.application),
+		store.DeleteByService(t.service),
+		store.DeleteByServiceVersion(t.version),
+		store.DeleteByServiceGroup(t.group),
+	}
+}
+
+func SearchConditionRoutes(rt core_runtime.Runtime, req *model.SearchTrafficRuleReq) ([]*model.TrafficRuleResp, int, error) {
+	return searchTrafficRules(rt, &mesh.ConditionRouteResourceList{}, req, func(r core_model.Resource) *model.TrafficRuleResp {
+		return (&model.TrafficRuleResp{}).FromConditionRouteResource(r.(*mesh.ConditionRouteResource))
+	})
+}
+
+func GetConditionRoute(rt core_runtime.Runtime, ruleName string) (*mesh_proto.ConditionRoute, error) {
+	target, err := parseRuleName(ruleName, consts.ConditionRuleSuffix)
+	if err != nil {
+		return nil, err
+	}
+	res := mesh.NewConditionRouteResource()
+	if err := getTrafficRule(rt, ruleName, target, res); err != nil {
+		return nil, err
+	}
+	return res.Spec, nil
+}
+
+func CreateConditionRoute(rt core_runtime.Runtime, ruleName string, spec *mesh_proto.ConditionRoute) error {
+	target, err := parseRuleName(ruleName, consts.ConditionRuleSuffix)
+	if err != nil {
+		return err
+	}
+	fillKeyAndScope(target, &spec.Key, &spec.Scope)
+	if err := target.check(spec.Key, spec.Scope); err != nil {
+		return err
+	}
+	res := mesh.NewConditionRouteResource()
+	res.Spec = spec
+	return createTrafficRule(rt, ruleName, target, res)
+}
+
+func UpdateConditionRoute(rt core_runtime.Runtime, ruleName string, spec *mesh_proto.ConditionRoute) error {
+	target, err := parseRuleName(ruleName, consts.ConditionRuleSuffix)
+	if err != nil {
+		return err
+	}
+	fillKeyAndScope(target, &spec.Key, &spec.Scope)
+	if err := target.check(spec.Key, spec.Scope); err != nil {
+		return err
+	}
+	return updateTrafficRule(rt, ruleName, target, mesh.NewConditionRouteResource(), spec)
+}
+
+func DeleteConditionRoute(rt core_runtime.Runtime, ruleName string) error {
+	target, err := parseRuleName(ruleName, consts.ConditionRuleSuffix)
+	if err != nil {
+		return err
+	}
+	return deleteTrafficRule(rt, ruleName, target, mesh.NewConditionRouteResource())
+}
+
+func SearchTagRoutes(rt core_runtime.Runtime, req *model.SearchTrafficRuleReq) ([]*model.TrafficRuleResp, int, error) {
+	return searchTrafficRules(rt, &mesh.TagRouteResourceList{}, req, func(r core_model.Resource) *model.TrafficRuleResp {
+		return (&model.TrafficRuleResp{}).FromTagRouteResource(r.(*mesh.TagRouteResource))
+	})
+}
+
+func GetTagRoute(rt core_runtime.Runtime, ruleName string) (*mesh_proto.TagRoute, error) {
+	target, err := parseTagRuleName(ruleName)
+	if err != nil {
+		return nil, err
+	}
+	res := mesh.NewTagRouteResource()
+	if err := getTrafficRule(rt, ruleName, target, res); err != nil {
+		return nil, err
+	}
+	return res.Spec, nil
+}
+
+func CreateTagRoute(rt core_runtime.Runtime, ruleName string, spec *mesh_proto.TagRoute) error {
+	target, err := parseTagRuleName(ruleName)
+	if err != nil {
+		return err
+	}
+	if spec.Key == "" {
+		spec.Key = target.key
+	}
+	if err := target.check(spec.Key, consts.Application); err != nil {
+		return err
+	}
+	res := mesh.NewTagRouteResource()
+	res.Spec = spec
+	return createTrafficRule(rt, ruleName, target, res)
+}
+
+func UpdateTagRoute(rt core_runtime.Runtime, ruleName string, spec *mesh_proto.TagRoute) error {
+	target, err := parseTagRuleName(ruleName)
+	if err != nil {
+		return err
+	}
+	if spec.Key == "" {
+		spec.Key = target.key
+	}
+	if err := target.check(spec.Key, consts.Application); err != nil {
+		return err
+	}
+	return updateTrafficRule(rt, ruleName, target, mesh.NewTagRouteResource(), spec)
+}
+
+func DeleteTagRoute(rt core_runtime.Runtime, ruleName string) error {
+	target, err := parseTagRuleName(ruleName)
+	if err != nil {
+		return err
+	}
+	return deleteTrafficRule(rt, ruleName, target, mesh.NewTagRouteResource())
+}
+
+// parseTagRuleName parses the name of a tag route, which can only be bound to an application.
+func parseTagRuleName(ruleName string) (*ruleTarget, error) {
+	target, err := parseRuleName(ruleName, consts.TagRuleSuffix)
+	if err != nil {
+		return nil, err
+	}
+	if target.scope() != consts.Application {
+		var verr validators.ValidationError
+		verr.AddViolation("ruleName", "tag route can only be bound to an application")
+		return nil, verr.OrNil()
+	}
+	return target, nil
+}
+
+func SearchDynamicConfigs(rt core_runtime.Runtime, req *model.SearchTrafficRuleReq) ([]*model.TrafficRuleResp, int, error) {
+	return searchTrafficRules(rt, &mesh.DynamicConfigResourceList{}, req, func(r core_model.Resource) *model.TrafficRuleResp {
+		return (&model.TrafficRuleResp{}).FromDynamicConfigResource(r.(*mesh.DynamicConfigResource))
+	})
+}
+
+func GetDynamicConfig(rt core_runtime.Runtime, ruleName string) (*mesh_proto.DynamicConfig, error) {
+	target, err := parseRuleName(ruleName, consts.ConfiguratorRuleSuffix)
+	if err != nil {
+		return nil, err
+	}
+	res := mesh.NewDynamicConfigResource()
+	if err := getTrafficRule(rt, ruleName, target, res); err != nil {
+		return nil, err
+	}
+	return res.Spec, nil
+}
+
+func CreateDynamicConfig(rt core_runtime.Runtime, ruleName string, spec *mesh_proto.DynamicConfig) error {
+	target, err := parseRuleName(ruleName, consts.ConfiguratorRuleSuffix)
+	if err != nil {
+		return err
+	}
+	fillKeyAndScope(target, &spec.Key, &spec.Scope)
+	if err := target.check(spec.Key, spec.Scope); err != nil {
+		return err
+	}
+	res := mesh.NewDynamicConfigResource()
+	res.Spec = spec
+	return createTrafficRule(rt, ruleName, target, res)
+}
+
+func UpdateDynamicConfig(rt core_runtime.Runtime, ruleName string, spec *mesh_proto.DynamicConfig) error {
+	target, err := parseRuleName(ruleName, consts.ConfiguratorRuleSuffix)
+	if err != nil {
+		return err
+	}
+	fillKeyAndScope(target, &spec.Key, &spec.Scope)
+	if err := target.check(spec.Key, spec.Scope); err != nil {
+		return err
+	}
+	return updateTrafficRule(rt, ruleName, target, mesh.NewDynamicConfigResource(), spec)
+}
+
+func DeleteDynamicConfig(rt core_runtime.Runtime, ruleName string) error {
+	target, err := parseRuleName(ruleName, consts.ConfiguratorRuleSuffix)
+	if err != nil {
+		return err
+	}
+	return deleteTrafficRule(rt, ruleName, target, mesh.NewDynamicConfigResource())
+}
+
+// fillKeyAndScope defaults the key and scope of a rule to the ones derived from the rule name.
+func fillKeyAndScope(target *ruleTarget, key *string, scope *string) {
+	if *key == "" {
+		*key = target.key
+	}
+	if *scope == "" {
+		*scope = target.scope()
+	}
+}
+
+func searchTrafficRules(
+	rt core_runtime.Runtime,
+	list core_model.ResourceList,
+	req *model.SearchTrafficRuleReq,
+	toResp func(core_model.Resource) *model.TrafficRuleResp,
+) ([]*model.TrafficRuleResp, int, error) {
+	if err := rt.ResourceManager().List(rt.AppContext(), list,
+		store.ListByMesh(core_model.DefaultMesh),
+		store.ListByFilterFunc(func(r core_model.Resource) bool {
+			return strings.Contains(toResp(r).RuleName, req.Keywords)
+		}),
+		store.ListByPage(req.PageSize, pageOffset(req.PageReq)),
+	); err != nil {
+		return nil, 0, err
+	}
+
+	res := make([]*model.TrafficRuleResp, 0, len(list.GetItems()))
+	for _, item := range list.GetItems() {
+		res = append(res, toResp(item))
+	}
+	return res, int(list.GetPagination().GetTotal()), nil
+}
+
+// storeName returns the name the rule is stored under. Rule names of services contain ':'
+// which kubernetes does not allow in object names, so they are rewritten for the kubernetes store.
+func storeName(rt core_runtime.Runtime, ruleName string) string {
+	if rt.Config().Store.Type != store_config.KubernetesStore {
+		return ruleName
+	}
+	return k8sRuleName(ruleName)
+}
+
+// k8sRuleName turns a rule name into a valid name of a cluster scoped kubernetes object.
+// Invalid characters are replaced and a hash of the original key is appended, so that
+// keys which only differ in the replaced characters do not collide.
+func k8sRuleName(ruleName string) string {
+	if len(validation.IsDNS1123Subdomain(ruleName)) == 0 {
+		return ruleName
+	}
+	key, suffix := ruleName, ""
+	if idx := strings.LastIndex(ruleName, "."); idx != -1 {
+		key, suffix = ruleName[:idx], ruleName[idx:]
+	}
+	sanitized := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return '-'
+		}
+	}, key)
+	// every dot separated label has to start and end with an alphanumeric character
+	var labels []string
+	for _, label := range strings.Split(sanitized, ".") {
+		if label = strings.Trim(label, "-"); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	sanitized = strings.Join(labels, ".")
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(key))
+	hashSuffix := fmt.Sprintf("-%08x%s", hash.Sum32(), suffix)
+	if maxLen := validation.DNS1123SubdomainMaxLength - len(hashSuffix); len(sanitized) > maxLen {
+		sanitized = strings.TrimRight(sanitized[:maxLen], ".-")
+	}
+	return strings.TrimLeft(sanitized+hashSuffix, "-")
+}
+
+func getTrafficRule(rt core_runtime.Runtime, ruleName string, target *ruleTarget, res core_model.Resource) error {
+	return rt.ResourceManager().Get(rt.AppContext(), res, target.getOptions(storeName(rt, ruleName))...)
+}
+
+// createTrafficRule rejects a rule which exists already, because not every store reports a conflict on create:
+// the kubernetes store ignores an existing object and the config center overwrites it.
+func createTrafficRule(rt core_runtime.Runtime, ruleName string, target *ruleTarget, res core_model.Resource) error {
+	err := getTrafficRule(rt, ruleName, target, res.Descriptor().NewObject())
+	if err == nil {
+		return store.ErrorResourceAlreadyExists(res.Descriptor().Name, ruleName, core_model.DefaultMesh)
+	}
+	if !store.IsResourceNotFound(err) {
+		return err
+	}
+	return rt.ResourceManager().Create(rt.AppContext(), res,
+		store.CreateByKey(storeName(rt, ruleName), core_model.DefaultMesh),
+		store.CreateWithLabels(target.labels()),
+	)
+}
+
+func updateTrafficRule(rt core_runtime.Runtime, ruleName string, target *ruleTarget, res core_model.Resource, spec core_model.ResourceSpec) error {
+	if err := getTrafficRule(rt, ruleName, target, res); err != nil {
+		return err
+	}
+	if err := res.SetSpec(spec); err != nil {
+		return err
+	}
+	return rt.ResourceManager().Update(rt.AppContext(), res,
+		store.UpdateByKey(storeName(rt, ruleName), core_model.DefaultMesh),
+		store.UpdateWithLabels(target.labels()),
+	)
+}
+
+func deleteTrafficRule(rt core_runtime.Runtime, ruleName string, target *ruleTarget, res core_model.Resource) error {
+	if err := getTrafficRule(rt, ruleName, target, res); err != nil {
+		return err
+	}
+	return rt.ResourceManager().Delete(rt.AppContext(), res, target.deleteOptions(storeName(rt, ruleName))...)
+}
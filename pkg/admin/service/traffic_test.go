@@ -0,0 +1,178 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"strings"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/admin/model"
+	store_config "github.com/apache/dubbo-kubernetes/pkg/config/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+)
+
+var _ = Describe("k8sRuleName", func() {
+	DescribeTable("should return a valid kubernetes name",
+		func(ruleName string, expected string) {
+			name := k8sRuleName(ruleName)
+			Expect(validation.IsDNS1123Subdomain(name)).To(BeEmpty())
+			Expect(name).To(Equal(expected))
+		},
+		Entry("application rule is kept", "shop-detail.condition-router", "shop-detail.condition-router"),
+		Entry("service rule", "org.apache.dubbo.GreetService:1.0.0:group.condition-router",
+			"org.apache.dubbo.greetservice-1.0.0-group-d4ffce75.condition-router"),
+		Entry("service rule without version and group", "org.apache.dubbo.GreetService::.configurators",
+			"org.apache.dubbo.greetservice-1be0882d.configurators"),
+	)
+
+	It("should not collide for keys which only differ in replaced characters", func() {
+		Expect(k8sRuleName("org.apache.Greet:1.0.0:.condition-router")).
+			ToNot(Equal(k8sRuleName("org.apache.Greet:1.0.0-.condition-router")))
+	})
+
+	It("should shorten long names", func() {
+		// given
+		ruleName := strings.Repeat("org.apache.dubbo.", 20) + "GreetService:1.0.0:group.condition-router"
+
+		// when
+		name := k8sRuleName(ruleName)
+
+		// then
+		Expect(validation.IsDNS1123Subdomain(name)).To(BeEmpty())
+		Expect(name).To(HaveSuffix(".condition-router"))
+	})
+})
+
+var _ = Describe("Traffic rules", func() {
+	conditionRoute := func() *mesh_proto.ConditionRoute {
+		return &mesh_proto.ConditionRoute{
+			Enabled:    true,
+			Conditions: []string{"method=getDetail => detailVersion=v1"},
+		}
+	}
+
+	It("should reject creating a rule which already exists", func() {
+		// given
+		rt := newTestRuntime(store_config.MemoryStore)
+		Expect(CreateConditionRoute(rt, "shop-detail.condition-router", conditionRoute())).To(Succeed())
+
+		// when
+		err := CreateConditionRoute(rt, "shop-detail.condition-router", conditionRoute())
+
+		// then
+		Expect(errors.Is(err, &store.ResourceConflictError{})).To(BeTrue())
+	})
+
+	It("should store service rules under a kubernetes name and find them by the rule name", func() {
+		// given
+		rt := newTestRuntime(store_config.KubernetesStore)
+		ruleName := "org.apache.dubbo.GreetService:1.0.0:group.condition-router"
+
+		// when
+		Expect(CreateConditionRoute(rt, ruleName, conditionRoute())).To(Succeed())
+
+		// then
+		spec, err := GetConditionRoute(rt, ruleName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.GetKey()).To(Equal("org.apache.dubbo.GreetService:1.0.0:group"))
+		Expect(spec.GetScope()).To(Equal("service"))
+
+		rules, total, err := SearchConditionRoutes(rt, &model.SearchTrafficRuleReq{Keywords: "GreetService"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(total).To(Equal(1))
+		Expect(rules[0].RuleName).To(Equal(ruleName))
+		Expect(rules[0].RuleGranularity).To(BeTrue())
+
+		// when
+		Expect(DeleteConditionRoute(rt, ruleName)).To(Succeed())
+
+		// then
+		_, err = GetConditionRoute(rt, ruleName)
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should report application rules with the application granularity", func() {
+		// given
+		rt := newTestRuntime(store_config.MemoryStore)
+		Expect(CreateDynamicConfig(rt, "shop-detail.configurators", &mesh_proto.DynamicConfig{
+			Enabled: true,
+			Configs: []*mesh_proto.OverrideConfig{{Side: "consumer", Parameters: map[string]string{"timeout": "5000"}}},
+		})).To(Succeed())
+		Expect(CreateTagRoute(rt, "shop-detail.tag-router", &mesh_proto.TagRoute{
+			Enabled: true,
+			Tags:    []*mesh_proto.Tag{{Name: "gray"}},
+		})).To(Succeed())
+
+		// when
+		configs, _, err := SearchDynamicConfigs(rt, &model.SearchTrafficRuleReq{})
+		Expect(err).ToNot(HaveOccurred())
+		tags, _, err := SearchTagRoutes(rt, &model.SearchTrafficRuleReq{})
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(configs).To(HaveLen(1))
+		Expect(configs[0].RuleName).To(Equal("shop-detail.configurators"))
+		Expect(configs[0].RuleGranularity).To(BeFalse())
+		Expect(tags).To(HaveLen(1))
+		Expect(tags[0].RuleGranularity).To(BeFalse())
+	})
+
+	It("should page the rules matching the keywords", func() {
+		// given
+		rt := newTestRuntime(store_config.MemoryStore)
+		for _, app := range []string{"shop-cart", "shop-detail", "shop-order", "user"} {
+			Expect(CreateConditionRoute(rt, app+".condition-router", conditionRoute())).To(Succeed())
+		}
+
+		// when
+		rules, total, err := SearchConditionRoutes(rt, &model.SearchTrafficRuleReq{
+			Keywords: "shop",
+			PageReq:  model.PageReq{CurPage: 2, PageSize: 2},
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(total).To(Equal(3))
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].RuleName).To(Equal("shop-order.condition-router"))
+	})
+
+	It("should reject a rule whose key does not match the rule name", func() {
+		// given
+		rt := newTestRuntime(store_config.MemoryStore)
+		spec := conditionRoute()
+		spec.Key = "other-app"
+
+		// when
+		err := CreateConditionRoute(rt, "shop-detail.condition-router", spec)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("must match the rule name")))
+	})
+})
@@ -34,6 +34,7 @@ import (
 	"github.com/apache/dubbo-kubernetes/pkg/config/core"
 	"github.com/apache/dubbo-kubernetes/pkg/config/core/resources/store"
 	"github.com/apache/dubbo-kubernetes/pkg/config/diagnostics"
+	dns_server "github.com/apache/dubbo-kubernetes/pkg/config/dns-server"
 	dp_server "github.com/apache/dubbo-kubernetes/pkg/config/dp-server"
 	"github.com/apache/dubbo-kubernetes/pkg/config/dubbo"
 	"github.com/apache/dubbo-kubernetes/pkg/config/eventbus"
@@ -99,6 +100,8 @@ type Config struct {
 	Defaults *Defaults `json:"defaults,omitempty"`
 	// Diagnostics configuration
 	Diagnostics *diagnostics.DiagnosticsConfig `json:"diagnostics,omitempty"`
+	// DNSServer holds configuration of the builtin DNS of the mesh
+	DNSServer *dns_server.Config `json:"dnsServer,omitempty"`
 	// Proxy holds configuration for proxies
 	Proxy xds.Proxy `json:"proxy"`
 	// Dataplane Server configuration
@@ -167,6 +170,7 @@ var DefaultConfig = func() Config {
 		Defaults:              DefaultDefaultsConfig(),
 		Multizone:             multizone.DefaultMultizoneConfig(),
 		Diagnostics:           diagnostics.DefaultDiagnosticsConfig(),
+		DNSServer:             dns_server.DefaultDNSServerConfig(),
 		DpServer:              dp_server.DefaultDpServerConfig(),
 		Admin:                 admin.DefaultAdminConfig(),
 		DubboConfig:           dubbo.DefaultServiceNameMappingConfig(),
@@ -213,6 +217,9 @@ func (c *Config) Validate() error {
 	if err := c.Diagnostics.Validate(); err != nil {
 		return errors.Wrap(err, "Diagnostics validation failed")
 	}
+	if err := c.DNSServer.Validate(); err != nil {
+		return errors.Wrap(err, "DNSServer validation failed")
+	}
 
 	return nil
 }
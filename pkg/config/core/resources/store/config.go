@@ -53,7 +53,7 @@ const (
 
 // StoreConfig defines Resource Store configuration
 type StoreConfig struct {
-	// Type of Store used in the Control Plane. Can be either "kubernetes", "postgres" or "memory"
+	// Type of Store used in the Control Plane. Can be either "kubernetes", "traditional", "mysql" or "memory"
 	Type StoreType `json:"type" envconfig:"dubbo_store_type"`
 	// Kubernetes Store configuration
 	Kubernetes *k8s.KubernetesStoreConfig `json:"kubernetes"`
@@ -103,9 +103,12 @@ func (s *StoreConfig) Validate() error {
 	case MemoryStore:
 		return nil
 	case MyStore:
-
+		if s.Mysql == nil {
+			return errors.New("Mysql configuration is required when the store type is mysql")
+		}
+	case Traditional:
 	default:
-		return errors.Errorf("Type should be either %s or %s", KubernetesStore, MemoryStore)
+		return errors.Errorf("Type should be one of %s, %s, %s or %s", KubernetesStore, MemoryStore, MyStore, Traditional)
 	}
 	if err := s.Cache.Validate(); err != nil {
 		return errors.Wrap(err, "Cache validation failed")
@@ -139,7 +142,11 @@ func DefaultUpsertConfig() UpsertConfig {
 
 func DefaultMysqlConfig() *mysql.MysqlStoreConfig {
 	return &mysql.MysqlStoreConfig{
-		MysqlDsn: "127.0.0.1:6379",
+		MysqlDsn:           "root@tcp(127.0.0.1:3306)/dubbo?charset=utf8mb4&parseTime=true&loc=Local",
+		MaxOpenConnections: 50,
+		MaxIdleConnections: 10,
+		MaxLifeTime:        time.Hour,
+		MaxIdleTime:        10 * time.Minute,
 	}
 }
 
@@ -0,0 +1,83 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dns_server
+
+import (
+	"net"
+	"time"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"go.uber.org/multierr"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/config"
+	config_types "github.com/apache/dubbo-kubernetes/pkg/config/types"
+)
+
+var _ config.Config = &Config{}
+
+// Config defines the configuration of the builtin DNS of the mesh. The control plane allocates a virtual IP
+// to every service and application and sends the DNS name table to the data plane proxies, so the services can be reached
+// as <service>.<domain> or <application>.<domain> without an external DNS server.
+type Config struct {
+	config.BaseConfig
+
+	// The domain that the DNS of the proxies resolves the services and the applications for
+	Domain string `json:"domain" envconfig:"dubbo_dns_server_domain"`
+	// CIDR used to allocate the virtual IPs of the services and the applications. The default loopback range lets the proxies
+	// bind the outbound listeners on the virtual IPs directly.
+	CIDR string `json:"CIDR" envconfig:"dubbo_dns_server_cidr"`
+	// If true then the virtual IPs are allocated and the outbound listeners on them are generated
+	ServiceVipEnabled bool `json:"serviceVipEnabled" envconfig:"dubbo_dns_server_service_vip_enabled"`
+	// How often the virtual IPs are recomputed
+	VIPRefreshInterval config_types.Duration `json:"vipRefreshInterval" envconfig:"dubbo_dns_server_vip_refresh_interval"`
+	// How long the virtual IP of a service or an application without data plane proxies stays reserved for it
+	// before it can be allocated to another one. It should exceed the time the clients cache the DNS answers.
+	VIPReleaseGracePeriod config_types.Duration `json:"vipReleaseGracePeriod" envconfig:"dubbo_dns_server_vip_release_grace_period"`
+}
+
+func (c *Config) Validate() error {
+	var errs error
+	if c.Domain == "" {
+		errs = multierr.Append(errs, errors.New(".Domain cannot be empty"))
+	}
+	if _, _, err := net.ParseCIDR(c.CIDR); err != nil {
+		errs = multierr.Append(errs, errors.Wrap(err, ".CIDR is not valid"))
+	}
+	if c.VIPRefreshInterval.Duration <= 0 {
+		errs = multierr.Append(errs, errors.New(".VIPRefreshInterval must be positive"))
+	}
+	if c.VIPReleaseGracePeriod.Duration < 0 {
+		errs = multierr.Append(errs, errors.New(".VIPReleaseGracePeriod cannot be negative"))
+	}
+	return errs
+}
+
+func DefaultDNSServerConfig() *Config {
+	return &Config{
+		Domain:                "mesh",
+		CIDR:                  "127.1.0.0/16",
+		ServiceVipEnabled:     true,
+		VIPRefreshInterval:    config_types.Duration{Duration: 5 * time.Second},
+		VIPReleaseGracePeriod: config_types.Duration{Duration: time.Hour},
+	}
+}
@@ -18,6 +18,7 @@
 package dp_server
 
 import (
+	"strings"
 	"time"
 )
 
@@ -69,6 +70,9 @@ const (
 )
 
 type DpServerAuthnConfig struct {
+	// If true then the clients of the DP Server have to present a token. Authentication is disabled by default,
+	// so enabling it is a migration step: issue the tokens to the proxies first, then turn it on.
+	Enabled bool `json:"enabled" envconfig:"dubbo_dp_server_authn_enabled"`
 	// Configuration for data plane proxy authentication.
 	DpProxy DpProxyAuthnConfig `json:"dpProxy"`
 	// Configuration for zone proxy authentication.
@@ -76,28 +80,52 @@ type DpServerAuthnConfig struct {
 	// If true then Envoy uses Google gRPC instead of Envoy gRPC which lets a proxy reload the auth data (service account token, dp token etc.) from path without proxy restart.
 	EnableReloadableTokens bool `json:"enableReloadableTokens" envconfig:"dubbo_dp_server_authn_enable_reloadable_tokens"`
 }
+
+func (d DpServerAuthnConfig) Validate() error {
+	var errs error
+	switch d.DpProxy.Type {
+	case "", DpServerAuthServiceAccountToken, DpServerAuthDpToken, DpServerAuthNone:
+	default:
+		errs = multierr.Append(errs, errors.Errorf(".DpProxy.Type has invalid value %q. Available values: %q, %q, %q", d.DpProxy.Type, DpServerAuthServiceAccountToken, DpServerAuthDpToken, DpServerAuthNone))
+	}
+	switch d.ZoneProxy.Type {
+	case "", DpServerAuthServiceAccountToken, DpServerAuthZoneToken, DpServerAuthNone:
+	default:
+		errs = multierr.Append(errs, errors.Errorf(".ZoneProxy.Type has invalid value %q. Available values: %q, %q, %q", d.ZoneProxy.Type, DpServerAuthServiceAccountToken, DpServerAuthZoneToken, DpServerAuthNone))
+	}
+	if err := d.DpProxy.DpToken.Validator.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrap(err, ".DpProxy.DpToken.Validator is not valid"))
+	}
+	if err := d.ZoneProxy.ZoneToken.Validator.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrap(err, ".ZoneProxy.ZoneToken.Validator is not valid"))
+	}
+	return errs
+}
+
 type DpProxyAuthnConfig struct {
 	// Type of authentication. Available values: "serviceAccountToken", "dpToken", "none".
-	// If empty, autoconfigured based on the environment - "serviceAccountToken" on Kubernetes, "dpToken" on Universal.
+	// If empty and the authentication is enabled, autoconfigured based on the environment - "serviceAccountToken" on Kubernetes, "dpToken" on Universal.
 	Type string `json:"type" envconfig:"dubbo_dp_server_authn_dp_proxy_type"`
 	// Configuration of dpToken authentication method
 	DpToken DpTokenAuthnConfig `json:"dpToken"`
 }
 type ZoneProxyAuthnConfig struct {
 	// Type of authentication. Available values: "serviceAccountToken", "zoneToken", "none".
-	// If empty, autoconfigured based on the environment - "serviceAccountToken" on Kubernetes, "zoneToken" on Universal.
+	// If empty and the authentication is enabled, autoconfigured based on the environment - "serviceAccountToken" on Kubernetes, "zoneToken" on Universal.
 	Type string `json:"type" envconfig:"dubbo_dp_server_authn_zone_proxy_type"`
 	// Configuration for zoneToken authentication method.
 	ZoneToken ZoneTokenAuthnConfig `json:"zoneToken"`
 }
 type DpTokenAuthnConfig struct {
-	// If true the control plane token issuer is enabled. It's recommended to set it to false when all the tokens are issued offline.
+	// If true the control plane token issuer is enabled. It's disabled by default, so the tokens are issued offline
+	// unless enabled. The issuer is served only to the requests from localhost.
 	EnableIssuer bool `json:"enableIssuer" envconfig:"dubbo_dp_server_authn_dp_proxy_dp_token_enable_issuer"`
 	// DP Token validator configuration
 	Validator DpTokenValidatorConfig `json:"validator"`
 }
 type ZoneTokenAuthnConfig struct {
-	// If true the control plane token issuer is enabled. It's recommended to set it to false when all the tokens are issued offline.
+	// If true the control plane token issuer is enabled. It's disabled by default, so the tokens are issued offline
+	// unless enabled. The issuer is served only to the requests from localhost.
 	EnableIssuer bool `json:"enableIssuer" envconfig:"dubbo_dp_server_authn_zone_proxy_zone_token_enable_issuer"`
 	// Zone Token validator configuration
 	Validator ZoneTokenValidatorConfig `json:"validator"`
@@ -125,6 +153,15 @@ type ZoneTokenValidatorConfig struct {
 	PublicKeys []config_types.PublicKey `json:"publicKeys"`
 }
 
+func (z ZoneTokenValidatorConfig) Validate() error {
+	for i, key := range z.PublicKeys {
+		if err := key.Validate(); err != nil {
+			return errors.Wrapf(err, ".PublicKeys[%d] is not valid", i)
+		}
+	}
+	return nil
+}
+
 func (a *DpServerConfig) PostProcess() error {
 	return nil
 }
@@ -134,17 +171,42 @@ func (a *DpServerConfig) Validate() error {
 	if a.Port < 0 {
 		errs = multierr.Append(errs, errors.New(".Port cannot be negative"))
 	}
+	if err := a.Authn.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrap(err, ".Authn is not valid"))
+	}
 	return errs
 }
 
 func DefaultDpServerConfig() *DpServerConfig {
 	return &DpServerConfig{
 		Port:              5678,
+		Authn:             DefaultDpServerAuthnConfig(),
 		Hds:               DefaultHdsConfig(),
 		ReadHeaderTimeout: config_types.Duration{Duration: 5 * time.Second},
 	}
 }
 
+func DefaultDpServerAuthnConfig() DpServerAuthnConfig {
+	return DpServerAuthnConfig{
+		DpProxy: DpProxyAuthnConfig{
+			DpToken: DpTokenAuthnConfig{
+				EnableIssuer: false,
+				Validator: DpTokenValidatorConfig{
+					UseSecrets: true,
+				},
+			},
+		},
+		ZoneProxy: ZoneProxyAuthnConfig{
+			ZoneToken: ZoneTokenAuthnConfig{
+				EnableIssuer: false,
+				Validator: ZoneTokenValidatorConfig{
+					UseSecrets: true,
+				},
+			},
+		},
+	}
+}
+
 func DefaultHdsConfig() *HdsConfig {
 	return &HdsConfig{
 		Enabled:         true,
@@ -156,6 +218,7 @@ func DefaultHdsConfig() *HdsConfig {
 			NoTrafficInterval:  config_types.Duration{Duration: 1 * time.Second},
 			HealthyThreshold:   1,
 			UnhealthyThreshold: 1,
+			HttpPath:           "/health",
 		},
 	}
 }
@@ -205,6 +268,10 @@ type HdsCheck struct {
 	// UnhealthyThreshold is a number of unhealthy health checks required before a host is marked
 	// unhealthy.
 	UnhealthyThreshold uint32 `json:"unhealthyThreshold" envconfig:"dubbo_dp_server_hds_check_unhealthy_threshold"`
+	// HttpPath is a path requested by the health checks of the inbounds with the "http" protocol.
+	// Any response status other than 200 is considered a failure. When empty, the inbounds
+	// with the "http" protocol are checked with TCP connections.
+	HttpPath string `json:"httpPath" envconfig:"dubbo_dp_server_hds_check_http_path"`
 }
 
 func (h *HdsCheck) Validate() error {
@@ -217,5 +284,8 @@ func (h *HdsCheck) Validate() error {
 	if h.NoTrafficInterval.Duration <= 0 {
 		return errors.New("NoTrafficInterval must be greater than 0s")
 	}
+	if h.HttpPath != "" && !strings.HasPrefix(h.HttpPath, "/") {
+		return errors.New("HttpPath must start with /")
+	}
 	return nil
 }
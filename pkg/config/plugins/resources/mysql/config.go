@@ -22,9 +22,15 @@ import (
 )
 
 type MysqlStoreConfig struct {
-	MysqlDsn           string        `json:"mysql_dsn"`
-	MaxOpenConnections int           `json:"max_open_connections"`
-	MaxIdleConnections int           `json:"max_idle_connections"`
-	MaxLifeTime        time.Duration `json:"max_life_time"`
-	MaxIdleTime        time.Duration `json:"max_idle_time"`
+	// MysqlDsn is the data source name of the database, e.g. "user:password@tcp(127.0.0.1:3306)/dubbo?parseTime=true".
+	// ":memory:" selects an in-memory SQLite database, which is only meant for tests and local development.
+	MysqlDsn string `json:"mysql_dsn" envconfig:"dubbo_store_mysql_dsn"`
+	// MaxOpenConnections is the maximum number of open connections to the database, 0 means unlimited.
+	MaxOpenConnections int `json:"max_open_connections" envconfig:"dubbo_store_mysql_max_open_connections"`
+	// MaxIdleConnections is the maximum number of idle connections kept in the pool.
+	MaxIdleConnections int `json:"max_idle_connections" envconfig:"dubbo_store_mysql_max_idle_connections"`
+	// MaxLifeTime is the maximum amount of time a connection may be reused, 0 means forever.
+	MaxLifeTime time.Duration `json:"max_life_time" envconfig:"dubbo_store_mysql_max_life_time"`
+	// MaxIdleTime is the maximum amount of time a connection may be idle, 0 means forever.
+	MaxIdleTime time.Duration `json:"max_idle_time" envconfig:"dubbo_store_mysql_max_idle_time"`
 }
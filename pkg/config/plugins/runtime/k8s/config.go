@@ -25,6 +25,8 @@ import (
 	"github.com/pkg/errors"
 
 	"go.uber.org/multierr"
+
+	kube_api "k8s.io/apimachinery/pkg/api/resource"
 )
 
 import (
@@ -55,6 +57,55 @@ func DefaultKubernetesRuntimeConfig() *KubernetesRuntimeConfig {
 			LeaseDuration: config_types.Duration{Duration: 15 * time.Second},
 			RenewDeadline: config_types.Duration{Duration: 10 * time.Second},
 		},
+		ControlPlaneServiceName: "dubbo-control-plane",
+		Injector: Injector{
+			SidecarContainer: DataplaneContainer{
+				Image:     "docker.io/apache/dubbo-dp:latest",
+				UID:       5678,
+				GID:       5678,
+				AdminPort: 9901,
+				DrainTime: config_types.Duration{Duration: 30 * time.Second},
+				ReadinessProbe: SidecarReadinessProbe{
+					InitialDelaySeconds: 1,
+					TimeoutSeconds:      3,
+					PeriodSeconds:       5,
+					SuccessThreshold:    1,
+					FailureThreshold:    12,
+				},
+				LivenessProbe: SidecarLivenessProbe{
+					InitialDelaySeconds: 60,
+					TimeoutSeconds:      3,
+					PeriodSeconds:       5,
+					FailureThreshold:    12,
+				},
+				Resources: SidecarResources{
+					Requests: SidecarResourceRequests{
+						CPU:    "50m",
+						Memory: "64Mi",
+					},
+					Limits: SidecarResourceLimits{
+						CPU:    "1000m",
+						Memory: "512Mi",
+					},
+				},
+				EnvVars: map[string]string{},
+			},
+			InitContainer: InitContainer{
+				Image: "docker.io/apache/dubbo-init:latest",
+			},
+			SidecarTraffic: SidecarTraffic{
+				RedirectPortInbound:  15006,
+				RedirectPortOutbound: 15001,
+				ExcludeInboundPorts:  []uint32{},
+				ExcludeOutboundPorts: []uint32{},
+			},
+			VirtualProbesEnabled: true,
+			VirtualProbesPort:    9000,
+			BuiltinDNS: BuiltinDNS{
+				Enabled: true,
+				Port:    15054,
+			},
+		},
 	}
 }
 
@@ -74,6 +125,57 @@ type KubernetesRuntimeConfig struct {
 	ClientConfig ClientConfig `json:"clientConfig"`
 	// Kubernetes leader election configuration
 	LeaderElection LeaderElection `json:"leaderElection"`
+	// Name of the Service that exposes the Control Plane to the injected sidecars
+	ControlPlaneServiceName string `json:"controlPlaneServiceName,omitempty" envconfig:"dubbo_runtime_kubernetes_control_plane_service_name"`
+	// Injector defines configuration of the sidecar injector.
+	Injector Injector `json:"injector"`
+}
+
+// Injector defines configuration of a Dubbo Sidecar Injector.
+type Injector struct {
+	// SidecarContainer defines configuration of the Dubbo sidecar container.
+	SidecarContainer DataplaneContainer `json:"sidecarContainer"`
+	// InitContainer defines configuration of the Dubbo init container.
+	InitContainer InitContainer `json:"initContainer"`
+	// CaCertFile is CA certificate which will be used to verify a connection to the control plane.
+	CaCertFile string `json:"caCertFile" envconfig:"dubbo_runtime_kubernetes_injector_ca_cert_file"`
+	// SidecarTraffic is a configuration for traffic that is intercepted by the sidecar.
+	SidecarTraffic SidecarTraffic `json:"sidecarTraffic"`
+	// VirtualProbesEnabled enables automatic converting HttpGet probes to virtual probes.
+	// Proxy will expose a virtual probes port and application probes are rewritten to go through it.
+	VirtualProbesEnabled bool `json:"virtualProbesEnabled" envconfig:"dubbo_runtime_kubernetes_injector_virtual_probes_enabled"`
+	// VirtualProbesPort is a port for exposing virtual probes which are not secured by mTLS.
+	VirtualProbesPort uint32 `json:"virtualProbesPort" envconfig:"dubbo_runtime_kubernetes_injector_virtual_probes_port"`
+	// BuiltinDNS defines the configuration of the builtin DNS of the sidecar.
+	BuiltinDNS BuiltinDNS `json:"builtinDNS"`
+}
+
+// BuiltinDNS defines the configuration of the builtin DNS of the sidecar.
+type BuiltinDNS struct {
+	// Use the builtin DNS of the sidecar, which resolves the domains of the services to their virtual IPs.
+	Enabled bool `json:"enabled" envconfig:"dubbo_runtime_kubernetes_injector_builtin_dns_enabled"`
+	// Port on which the sidecar serves DNS, the DNS traffic of the Pod is redirected to it.
+	Port uint32 `json:"port" envconfig:"dubbo_runtime_kubernetes_injector_builtin_dns_port"`
+}
+
+// InitContainer defines configuration of the Dubbo init container.
+type InitContainer struct {
+	// Image name.
+	Image string `json:"image" envconfig:"dubbo_runtime_kubernetes_injector_init_container_image"`
+}
+
+// SidecarTraffic defines configuration of the traffic that is intercepted by the sidecar.
+type SidecarTraffic struct {
+	// Port of the inbound interface that will forward requests to the service.
+	RedirectPortInbound uint32 `json:"redirectPortInbound" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_traffic_redirect_port_inbound"`
+	// Port of the outbound interface that will forward requests to other services.
+	RedirectPortOutbound uint32 `json:"redirectPortOutbound" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_traffic_redirect_port_outbound"`
+	// List of inbound ports that will be excluded from interception.
+	// This setting is applied on every pod unless traffic.dubbo.io/exclude-inbound-ports annotation is specified on Pod.
+	ExcludeInboundPorts []uint32 `json:"excludeInboundPorts" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_traffic_exclude_inbound_ports"`
+	// List of outbound ports that will be excluded from interception.
+	// This setting is applied on every pod unless traffic.dubbo.io/exclude-outbound-ports annotation is specified on Pod.
+	ExcludeOutboundPorts []uint32 `json:"excludeOutboundPorts" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_traffic_exclude_outbound_ports"`
 }
 
 type ControllersConcurrency struct {
@@ -133,6 +235,38 @@ func (c *KubernetesRuntimeConfig) Validate() error {
 	if c.MarshalingCacheExpirationTime.Duration < 0 {
 		errs = multierr.Append(errs, errors.Errorf(".MarshalingCacheExpirationTime must be positive or equal to 0"))
 	}
+	if err := c.Injector.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".Injector is not valid"))
+	}
+	return errs
+}
+
+func (i *Injector) Validate() error {
+	var errs error
+	if err := i.SidecarContainer.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".SidecarContainer is not valid"))
+	}
+	if i.InitContainer.Image == "" {
+		errs = multierr.Append(errs, errors.Errorf(".InitContainer.Image must be non-empty"))
+	}
+	if i.VirtualProbesEnabled && (i.VirtualProbesPort == 0 || 65535 < i.VirtualProbesPort) {
+		errs = multierr.Append(errs, errors.Errorf(".VirtualProbesPort must be in the range [1, 65535]"))
+	}
+	if i.BuiltinDNS.Enabled && (i.BuiltinDNS.Port == 0 || 65535 < i.BuiltinDNS.Port) {
+		errs = multierr.Append(errs, errors.Errorf(".BuiltinDNS.Port must be in the range [1, 65535]"))
+	}
+	for _, port := range i.SidecarTraffic.ExcludeInboundPorts {
+		if 65535 < port {
+			errs = multierr.Append(errs, errors.Errorf(".SidecarTraffic.ExcludeInboundPorts must contain ports in the range [0, 65535]"))
+			break
+		}
+	}
+	for _, port := range i.SidecarTraffic.ExcludeOutboundPorts {
+		if 65535 < port {
+			errs = multierr.Append(errs, errors.Errorf(".SidecarTraffic.ExcludeOutboundPorts must contain ports in the range [0, 65535]"))
+			break
+		}
+	}
 	return errs
 }
 
@@ -151,6 +285,12 @@ func (c *AdmissionServerConfig) Validate() error {
 
 // DataplaneContainer defines the configuration of a Dubbo dataplane proxy container.
 type DataplaneContainer struct {
+	// Image name.
+	Image string `json:"image,omitempty" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_container_image"`
+	// User ID.
+	UID int64 `json:"uid,omitempty" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_container_uid"`
+	// Group ID.
+	GID int64 `json:"gid,omitempty" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_container_gid"`
 	// Deprecated: Use DUBBO_BOOTSTRAP_SERVER_PARAMS_ADMIN_PORT instead.
 	AdminPort uint32 `json:"adminPort,omitempty" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_container_admin_port"`
 	// Drain time for listeners.
@@ -159,10 +299,68 @@ type DataplaneContainer struct {
 	ReadinessProbe SidecarReadinessProbe `json:"readinessProbe,omitempty"`
 	// Liveness probe.
 	LivenessProbe SidecarLivenessProbe `json:"livenessProbe,omitempty"`
+	// Compute resource requirements.
+	Resources SidecarResources `json:"resources,omitempty"`
 	// EnvVars are additional environment variables that can be placed on Dubbo DP sidecar
 	EnvVars map[string]string `json:"envVars" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_container_env_vars"`
 }
 
+func (c *DataplaneContainer) Validate() error {
+	var errs error
+	if c.Image == "" {
+		errs = multierr.Append(errs, errors.Errorf(".Image must be non-empty"))
+	}
+	if c.DrainTime.Duration < 0 {
+		errs = multierr.Append(errs, errors.Errorf(".DrainTime must be positive or equal to 0"))
+	}
+	if err := c.Resources.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".Resources is not valid"))
+	}
+	return errs
+}
+
+// SidecarResources defines compute resource requirements.
+type SidecarResources struct {
+	// Minimum amount of compute resources required.
+	Requests SidecarResourceRequests `json:"requests,omitempty"`
+	// Maximum amount of compute resources allowed.
+	Limits SidecarResourceLimits `json:"limits,omitempty"`
+}
+
+func (c *SidecarResources) Validate() error {
+	var errs error
+	for name, quantity := range map[string]string{
+		".Requests.CPU":    c.Requests.CPU,
+		".Requests.Memory": c.Requests.Memory,
+		".Limits.CPU":      c.Limits.CPU,
+		".Limits.Memory":   c.Limits.Memory,
+	} {
+		if quantity == "" {
+			continue
+		}
+		if _, err := kube_api.ParseQuantity(quantity); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "%s is not valid", name))
+		}
+	}
+	return errs
+}
+
+// SidecarResourceRequests defines the minimum amount of compute resources required.
+type SidecarResourceRequests struct {
+	// CPU, in cores. (500m = .5 cores)
+	CPU string `json:"cpu,omitempty" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_container_resources_requests_cpu"`
+	// Memory, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
+	Memory string `json:"memory,omitempty" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_container_resources_requests_memory"`
+}
+
+// SidecarResourceLimits defines the maximum amount of compute resources allowed.
+type SidecarResourceLimits struct {
+	// CPU, in cores. (500m = .5 cores)
+	CPU string `json:"cpu,omitempty" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_container_resources_limits_cpu"`
+	// Memory, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
+	Memory string `json:"memory,omitempty" envconfig:"dubbo_runtime_kubernetes_injector_sidecar_container_resources_limits_memory"`
+}
+
 // SidecarReadinessProbe defines periodic probe of container service readiness.
 type SidecarReadinessProbe struct {
 	config.BaseConfig
@@ -22,14 +22,52 @@ import (
 	"path"
 )
 
+import (
+	"github.com/pkg/errors"
+)
+
 import (
 	dubbo_cp "github.com/apache/dubbo-kubernetes/pkg/config/app/dubbo-cp"
+	config_core "github.com/apache/dubbo-kubernetes/pkg/config/core"
+	dp_server "github.com/apache/dubbo-kubernetes/pkg/config/dp-server"
 	"github.com/apache/dubbo-kubernetes/pkg/core"
 )
 
 var autoconfigureLog = core.Log.WithName("bootstrap").WithName("auto-configure")
 
 func autoconfigure(cfg *dubbo_cp.Config) error {
+	if err := autoconfigureDpServerAuthn(cfg); err != nil {
+		return errors.Wrap(err, "could not autoconfigure DP Server authentication")
+	}
+	return nil
+}
+
+func autoconfigureDpServerAuthn(cfg *dubbo_cp.Config) error {
+	authn := &cfg.DpServer.Authn
+	if !authn.Enabled {
+		autoconfigureLog.Info("DP Server authentication is disabled")
+		return nil
+	}
+	if authn.DpProxy.Type == "" {
+		if cfg.DeployMode == config_core.KubernetesMode {
+			authn.DpProxy.Type = dp_server.DpServerAuthServiceAccountToken
+		} else {
+			authn.DpProxy.Type = dp_server.DpServerAuthDpToken
+		}
+		autoconfigureLog.Info("DP Server authentication of dataplane proxies is autoconfigured", "type", authn.DpProxy.Type)
+	}
+	if authn.ZoneProxy.Type == "" {
+		if cfg.DeployMode == config_core.KubernetesMode {
+			authn.ZoneProxy.Type = dp_server.DpServerAuthServiceAccountToken
+		} else {
+			authn.ZoneProxy.Type = dp_server.DpServerAuthZoneToken
+		}
+		autoconfigureLog.Info("DP Server authentication of zone proxies is autoconfigured", "type", authn.ZoneProxy.Type)
+	}
+	if cfg.DeployMode != config_core.KubernetesMode &&
+		(authn.DpProxy.Type == dp_server.DpServerAuthServiceAccountToken || authn.ZoneProxy.Type == dp_server.DpServerAuthServiceAccountToken) {
+		return errors.Errorf("%q authentication is available only on Kubernetes", dp_server.DpServerAuthServiceAccountToken)
+	}
 	return nil
 }
 
@@ -68,6 +68,8 @@ import (
 	"github.com/apache/dubbo-kubernetes/pkg/dp-server/server"
 	"github.com/apache/dubbo-kubernetes/pkg/events"
 	k8s_extensions "github.com/apache/dubbo-kubernetes/pkg/plugins/extensions/k8s"
+	xds_auth "github.com/apache/dubbo-kubernetes/pkg/xds/auth"
+	xds_auth_components "github.com/apache/dubbo-kubernetes/pkg/xds/auth/components"
 	mesh_cache "github.com/apache/dubbo-kubernetes/pkg/xds/cache/mesh"
 	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
 	xds_server "github.com/apache/dubbo-kubernetes/pkg/xds/server"
@@ -90,8 +92,13 @@ func buildRuntime(appCtx context.Context, cfg dubbo_cp.Config) (core_runtime.Run
 	}
 	// 定义store的状态
 	if cfg.DeployMode == config_core.UniversalMode || cfg.DeployMode == config_core.HalfHostMode {
-		cfg.Store.Type = store.Traditional
+		if cfg.Store.Type != store.MyStore {
+			cfg.Store.Type = store.Traditional
+		}
 	} else {
+		if cfg.Store.Type == store.MyStore {
+			return nil, errors.Errorf("the %s store is not supported in the %s deploy mode, the resources are stored in kubernetes", store.MyStore, cfg.DeployMode)
+		}
 		cfg.Store.Type = store.KubernetesStore
 	}
 	// 初始化cache
@@ -103,23 +110,33 @@ func buildRuntime(appCtx context.Context, cfg dubbo_cp.Config) (core_runtime.Run
 	if err := initializeResourceStore(cfg, builder); err != nil {
 		return nil, err
 	}
-
-	// 隐蔽了configStore, 后期再补全
+	if err := initializeConfigStore(cfg, builder); err != nil {
+		return nil, err
+	}
 
 	builder.WithResourceValidators(core_runtime.ResourceValidators{})
 
 	if err := initializeResourceManager(cfg, builder); err != nil { //nolint:contextcheck
 		return nil, err
 	}
+	initializeConfigManager(builder)
 
 	builder.WithDataSourceLoader(datasource.NewDataSourceLoader(builder.ReadOnlyResourceManager()))
 
+	if err := initializeCaManagers(builder); err != nil {
+		return nil, err
+	}
+
 	leaderInfoComponent := &component.LeaderInfoComponent{}
 	builder.WithLeaderInfo(leaderInfoComponent)
 
+	authenticator, err := xds_auth_components.NewAuthenticator(cfg, builder.ReadOnlyResourceManager(), builder.Extensions())
+	if err != nil {
+		return nil, err
+	}
 	builder.WithDpServer(server.NewDpServer(*cfg.DpServer, func(writer http.ResponseWriter, request *http.Request) bool {
 		return true
-	}))
+	}, authenticator, xds_auth.NewProxyResolver(builder.ReadOnlyResourceManager())))
 
 	resourceManager := builder.ResourceManager()
 	ddsContext := dds_context.DefaultContext(appCtx, resourceManager, cfg)
@@ -305,6 +322,9 @@ func initializeResourceStore(cfg dubbo_cp.Config, builder *core_runtime.Builder)
 	case store.MemoryStore:
 		pluginName = core_plugins.Memory
 		pluginConfig = nil
+	case store.MyStore:
+		pluginName = core_plugins.MySQL
+		pluginConfig = nil
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
 	}
@@ -317,7 +337,7 @@ func initializeResourceStore(cfg dubbo_cp.Config, builder *core_runtime.Builder)
 	if err != nil {
 		return err
 	}
-	builder.WithResourceStore(core_store.NewCustomizableResourceStore(rs))
+	builder.WithResourceStore(core_store.NewCustomizableResourceStore(core_store.NewPaginationStore(rs)))
 	builder.WithTransactions(transactions)
 	eventBus, err := events.NewEventBus(cfg.EventBus.BufferSize)
 	if err != nil {
@@ -340,6 +360,8 @@ func initializeConfigStore(cfg dubbo_cp.Config, builder *core_runtime.Builder) e
 		pluginName = core_plugins.Universal
 	case store.Traditional:
 		pluginName = core_plugins.Universal
+	case store.MyStore:
+		pluginName = core_plugins.Universal
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
 	}
@@ -424,6 +446,7 @@ func initializeResourceManager(cfg dubbo_cp.Config, builder *core_runtime.Builde
 		mesh_managers.NewMeshManager(
 			builder.ResourceStore(),
 			customizableManager,
+			builder.CaManagers(),
 			registry.Global(),
 			builder.ResourceValidators().Mesh,
 			builder.Extensions(),
@@ -461,12 +484,27 @@ func initializeConfigManager(builder *core_runtime.Builder) {
 	builder.WithConfigManager(config_manager.NewConfigManager(builder.ConfigStore()))
 }
 
+func initializeCaManagers(builder *core_runtime.Builder) error {
+	for pluginName, caPlugin := range core_plugins.Plugins().CaPlugins() {
+		caManager, err := caPlugin.NewCaManager(builder, nil)
+		if err != nil {
+			return errors.Wrapf(err, "could not create CA manager for plugin %q", pluginName)
+		}
+		builder.WithCaManager(string(pluginName), caManager)
+	}
+	return nil
+}
+
 func initializeMeshCache(builder *core_runtime.Builder) error {
 	meshContextBuilder := xds_context.NewMeshContextBuilder(
 		builder.ReadOnlyResourceManager(),
 		xds_server.MeshResourceTypes(),
 		builder.LookupIP(),
-		builder.Config().Multizone.Zone.Name)
+		builder.Config().Multizone.Zone.Name,
+		builder.Config().IsFederatedZoneCP(),
+		builder.DataSourceLoader(),
+		builder.Config().DNSServer,
+		builder.ConfigManager())
 
 	meshSnapshotCache, err := mesh_cache.NewCache(
 		builder.Config().Store.Cache.ExpirationTime.Duration,
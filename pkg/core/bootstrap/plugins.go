@@ -34,11 +34,14 @@ import (
 	_ "github.com/apache/dubbo-kubernetes/pkg/core/reg_client/zookeeper"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/bootstrap/k8s"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/bootstrap/universal"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/ca/builtin"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/ca/provided"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/config/k8s"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/config/universal"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/mysql"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/traditional"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s"
 	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/universal"
@@ -91,7 +91,7 @@ func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, p
 	}
 	for _, tag := range tags.Keys() {
 		for _, value := range tags.UniqueValues(tag) {
-			uri := fmt.Sprintf("dubbo://%s/%s", tag, value)
+			uri := mesh_proto.ExtendURI(tag, value)
 			u, err := url.Parse(uri)
 			if err != nil {
 				return nil, errors.Wrap(err, "invalid Dubbo URI")
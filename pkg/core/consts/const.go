@@ -28,54 +28,56 @@ const (
 )
 
 const (
-	AnyValue               = "*"
-	AnyHostValue           = "0.0.0.0"
-	InterfaceKey           = "interface"
-	GroupKey               = "group"
-	VersionKey             = "version"
-	ClassifierKey          = "classifier"
-	CategoryKey            = "category"
-	ProvidersCategory      = "providers"
-	ConsumersCategory      = "consumers"
-	RoutersCategory        = "routers"
-	ConfiguratorsCategory  = "configurators"
-	ConfiguratorRuleSuffix = ".configurators"
-	EnabledKey             = "enabled"
-	CheckKey               = "check"
-	AdminProtocol          = "admin"
-	Side                   = "side"
-	ConsumerSide           = "consumer"
-	ProviderSide           = "provider"
-	ConsumerProtocol       = "consumer"
-	EmptyProtocol          = "empty"
-	OverrideProtocol       = "override"
-	DefaultGroup           = "dubbo"
-	ApplicationKey         = "application"
-	DynamicKey             = "dynamic"
-	SerializationKey       = "serialization"
-	TimeoutKey             = "timeout"
-	DefaultTimeout         = 1000
-	WeightKey              = "weight"
-	BalancingKey           = "balancing"
-	DefaultWeight          = 100
-	OwnerKey               = "owner"
-	Application            = "application"
-	Service                = "service"
-	Colon                  = ":"
-	InterrogationPoint     = "?"
-	IP                     = "ip"
-	PlusSigns              = "+"
-	PunctuationPoint       = "."
-	ConditionRoute         = "condition_route"
-	TagRoute               = "tag_route"
-	ConditionRuleSuffix    = ".condition-router"
-	TagRuleSuffix          = ".tag-router"
-	ConfigFileEnvKey       = "conf" // config file path
-	RegistryAll            = "ALL"
-	RegistryInterface      = "INTERFACE"
-	RegistryInstance       = "INSTANCE"
-	RegistryType           = "TYPE"
-	NamespaceKey           = "namespace"
+	AnyValue                   = "*"
+	AnyHostValue               = "0.0.0.0"
+	InterfaceKey               = "interface"
+	GroupKey                   = "group"
+	VersionKey                 = "version"
+	ClassifierKey              = "classifier"
+	CategoryKey                = "category"
+	ProvidersCategory          = "providers"
+	ConsumersCategory          = "consumers"
+	RoutersCategory            = "routers"
+	ConfiguratorsCategory      = "configurators"
+	ConfiguratorRuleSuffix     = ".configurators"
+	EnabledKey                 = "enabled"
+	CheckKey                   = "check"
+	AdminProtocol              = "admin"
+	Side                       = "side"
+	ConsumerSide               = "consumer"
+	ProviderSide               = "provider"
+	ConsumerProtocol           = "consumer"
+	EmptyProtocol              = "empty"
+	OverrideProtocol           = "override"
+	DefaultGroup               = "dubbo"
+	ApplicationKey             = "application"
+	DynamicKey                 = "dynamic"
+	SerializationKey           = "serialization"
+	TimeoutKey                 = "timeout"
+	DefaultTimeout             = 1000
+	WeightKey                  = "weight"
+	BalancingKey               = "balancing"
+	DefaultWeight              = 100
+	OwnerKey                   = "owner"
+	Application                = "application"
+	Service                    = "service"
+	Colon                      = ":"
+	InterrogationPoint         = "?"
+	IP                         = "ip"
+	PlusSigns                  = "+"
+	PunctuationPoint           = "."
+	ConditionRoute             = "condition_route"
+	TagRoute                   = "tag_route"
+	ConditionRuleSuffix        = ".condition-router"
+	TagRuleSuffix              = ".tag-router"
+	AuthenticationPolicySuffix = ".authentication"
+	AuthorizationPolicySuffix  = ".authorization"
+	ConfigFileEnvKey           = "conf" // config file path
+	RegistryAll                = "ALL"
+	RegistryInterface          = "INTERFACE"
+	RegistryInstance           = "INSTANCE"
+	RegistryType               = "TYPE"
+	NamespaceKey               = "namespace"
 )
 
 var Configs = set.NewSet(WeightKey, BalancingKey)
@@ -0,0 +1,90 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vips
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	config_manager "github.com/apache/dubbo-kubernetes/pkg/core/config/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/system"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+)
+
+// ConfigKey returns the name of the Config that stores the virtual IPs of the mesh.
+func ConfigKey(mesh string) string {
+	return fmt.Sprintf("dubbo-%s-dns-vips", mesh)
+}
+
+// Persistence stores the virtual IPs of every mesh in a Config, so they are
+// stable across restarts of the control plane.
+type Persistence struct {
+	configManager config_manager.ConfigManager
+}
+
+func NewPersistence(configManager config_manager.ConfigManager) *Persistence {
+	return &Persistence{
+		configManager: configManager,
+	}
+}
+
+// Get returns the virtual IPs of the mesh, the list is empty when none were allocated yet.
+func (p *Persistence) Get(ctx context.Context, mesh string) (List, error) {
+	config := system.NewConfigResource()
+	if err := p.configManager.Get(ctx, config, core_store.GetByKey(ConfigKey(mesh), model.NoMesh)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return List{}, nil
+		}
+		return nil, errors.Wrapf(err, "could not get virtual IPs of mesh %q", mesh)
+	}
+	list := List{}
+	if config.Spec.GetConfig() == "" {
+		return list, nil
+	}
+	if err := json.Unmarshal([]byte(config.Spec.GetConfig()), &list); err != nil {
+		return nil, errors.Wrapf(err, "could not parse virtual IPs of mesh %q", mesh)
+	}
+	return list, nil
+}
+
+// Set stores the virtual IPs of the mesh.
+func (p *Persistence) Set(ctx context.Context, mesh string, list List) error {
+	bytes, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	key := core_store.GetByKey(ConfigKey(mesh), model.NoMesh)
+	config := system.NewConfigResource()
+	if err := p.configManager.Get(ctx, config, key); err != nil {
+		if !core_store.IsResourceNotFound(err) {
+			return errors.Wrapf(err, "could not get virtual IPs of mesh %q", mesh)
+		}
+		config.Spec.Config = string(bytes)
+		return p.configManager.Create(ctx, config, core_store.CreateByKey(ConfigKey(mesh), model.NoMesh))
+	}
+	config.Spec.Config = string(bytes)
+	return p.configManager.Update(ctx, config)
+}
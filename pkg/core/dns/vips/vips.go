@@ -0,0 +1,266 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vips
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+)
+
+// EntryType is the kind of the name a virtual IP is allocated for.
+type EntryType string
+
+const (
+	Service     EntryType = "service"
+	Application EntryType = "app"
+)
+
+// Entry is the name a virtual IP is allocated for, either a service or an application.
+type Entry struct {
+	Type EntryType
+	Name string
+}
+
+func NewServiceEntry(name string) Entry {
+	return Entry{Type: Service, Name: name}
+}
+
+func NewApplicationEntry(name string) Entry {
+	return Entry{Type: Application, Name: name}
+}
+
+func (e Entry) String() string {
+	return fmt.Sprintf("%s:%s", e.Type, e.Name)
+}
+
+// MarshalText lets the entries be the keys of the persisted JSON object.
+func (e Entry) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Entry) UnmarshalText(text []byte) error {
+	entryType, name, ok := strings.Cut(string(text), ":")
+	if !ok || name == "" {
+		return errors.Errorf("invalid entry %q, expected {type}:{name}", text)
+	}
+	switch EntryType(entryType) {
+	case Service, Application:
+	default:
+		return errors.Errorf("invalid entry %q, unknown type %q", text, entryType)
+	}
+	*e = Entry{Type: EntryType(entryType), Name: name}
+	return nil
+}
+
+// VIP is the virtual IP of an entry. When the entry has no data plane proxies left, the address is released,
+// but it stays reserved for the entry until the grace period passes. This way the address is not handed
+// to another entry while the clients may still have the name of the entry resolved to it.
+type VIP struct {
+	Address    string     `json:"address"`
+	ReleasedAt *time.Time `json:"releasedAt,omitempty"`
+}
+
+// List maps the entries to their virtual IPs.
+type List map[Entry]VIP
+
+// Entries returns the sorted entries of the list.
+func (l List) Entries() []Entry {
+	entries := make([]Entry, 0, len(l))
+	for entry := range l {
+		entries = append(entries, entry)
+	}
+	sortEntries(entries)
+	return entries
+}
+
+// Equal checks whether both lists assign the same virtual IPs to the same entries.
+func (l List) Equal(other List) bool {
+	if len(l) != len(other) {
+		return false
+	}
+	for entry, vip := range l {
+		otherVIP, ok := other[entry]
+		if !ok || otherVIP.Address != vip.Address {
+			return false
+		}
+		if (vip.ReleasedAt == nil) != (otherVIP.ReleasedAt == nil) ||
+			vip.ReleasedAt != nil && !vip.ReleasedAt.Equal(*otherVIP.ReleasedAt) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hostname returns the name under which the entry is resolved by the DNS of the proxies.
+func Hostname(name string, domain string) string {
+	return fmt.Sprintf("%s.%s", strings.ToLower(name), domain)
+}
+
+// Allocator assigns the virtual IPs of the entries from a CIDR.
+type Allocator struct {
+	prefix      netip.Prefix
+	gracePeriod time.Duration
+}
+
+func NewAllocator(cidr string, gracePeriod time.Duration) (*Allocator, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse CIDR %q", cidr)
+	}
+	return &Allocator{
+		prefix:      prefix.Masked(),
+		gracePeriod: gracePeriod,
+	}, nil
+}
+
+// Allocate returns a list that holds a virtual IP for every given entry.
+// Entries present in the current list keep their virtual IP, so the addresses are stable
+// as long as the entries exist. Entries that are gone are released and keep their addresses
+// reserved until the grace period passes, an entry which comes back in the meantime gets its address back.
+func (a *Allocator) Allocate(current List, entries []Entry, now time.Time) (List, error) {
+	list := List{}
+	used := map[netip.Addr]bool{}
+	active := map[Entry]bool{}
+	for _, entry := range entries {
+		active[entry] = true
+	}
+	keep := func(entry Entry, vip VIP) {
+		addr, err := netip.ParseAddr(vip.Address)
+		if err != nil || !a.usable(addr) || used[addr] {
+			return
+		}
+		list[entry] = vip
+		used[addr] = true
+	}
+	// the active entries go first, so they win an address which is also recorded for a released entry
+	for _, entry := range current.Entries() {
+		if active[entry] {
+			keep(entry, VIP{Address: current[entry].Address})
+		}
+	}
+	for _, entry := range current.Entries() {
+		if active[entry] {
+			continue
+		}
+		releasedAt := now
+		if vip := current[entry]; vip.ReleasedAt != nil {
+			releasedAt = *vip.ReleasedAt
+		}
+		if now.Sub(releasedAt) < a.gracePeriod {
+			keep(entry, VIP{Address: current[entry].Address, ReleasedAt: &releasedAt})
+		}
+	}
+
+	var pending []Entry
+	for entry := range active {
+		if _, ok := list[entry]; !ok {
+			pending = append(pending, entry)
+		}
+	}
+	sortEntries(pending)
+	next := a.prefix.Addr()
+	for _, entry := range pending {
+		for {
+			next = next.Next()
+			if !a.prefix.Contains(next) {
+				return nil, errors.Errorf("could not allocate a virtual IP for %s %q, no free address left in %s", entry.Type, entry.Name, a.prefix)
+			}
+			if !used[next] && a.usable(next) {
+				break
+			}
+		}
+		list[entry] = VIP{Address: next.String()}
+		used[next] = true
+	}
+	return list, nil
+}
+
+// usable excludes the network and the broadcast address of the CIDR.
+func (a *Allocator) usable(addr netip.Addr) bool {
+	if !a.prefix.Contains(addr) || addr == a.prefix.Addr() {
+		return false
+	}
+	return a.prefix.Contains(addr.Next())
+}
+
+// Port is a port served on the virtual IP of an entry and the service the traffic sent to it goes to.
+type Port struct {
+	Port    uint32
+	Service string
+}
+
+// Ports returns the entries the Dataplanes need virtual IPs for, with the ports served on them sorted by number.
+// Every service exposed by the inbounds is an entry, and so is every application the Dataplanes are labeled with,
+// unless a service has the same name, because both would resolve to the same hostname.
+// A port of an application which exposes several services on it goes to the first of them by name.
+func Ports(dataplanes []*core_mesh.DataplaneResource) map[Entry][]Port {
+	services := map[Entry]map[uint32]string{}
+	add := func(entry Entry, port uint32, service string) {
+		if _, ok := services[entry]; !ok {
+			services[entry] = map[uint32]string{}
+		}
+		if current, ok := services[entry][port]; !ok || service < current {
+			services[entry][port] = service
+		}
+	}
+	for _, dp := range dataplanes {
+		app := dp.GetMeta().GetLabels()[mesh_proto.AppTag]
+		for _, inbound := range dp.Spec.GetNetworking().GetInbound() {
+			service := inbound.GetService()
+			if service == "" || inbound.GetPort() == 0 {
+				continue
+			}
+			add(NewServiceEntry(service), inbound.GetPort(), service)
+			if app != "" {
+				add(NewApplicationEntry(app), inbound.GetPort(), service)
+			}
+		}
+	}
+
+	ports := make(map[Entry][]Port, len(services))
+	for entry, byPort := range services {
+		if _, ok := services[NewServiceEntry(entry.Name)]; ok && entry.Type == Application {
+			continue
+		}
+		for port, service := range byPort {
+			ports[entry] = append(ports[entry], Port{Port: port, Service: service})
+		}
+		sort.Slice(ports[entry], func(i, j int) bool {
+			return ports[entry][i].Port < ports[entry][j].Port
+		})
+	}
+	return ports
+}
+
+func sortEntries(entries []Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].String() < entries[j].String()
+	})
+}
@@ -0,0 +1,252 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vips_test
+
+import (
+	"context"
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	config_manager "github.com/apache/dubbo-kubernetes/pkg/core/config/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/dns/vips"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	resources_memory "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+)
+
+var _ = Describe("Allocator", func() {
+	var allocator *vips.Allocator
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		var err error
+		allocator, err = vips.NewAllocator("127.1.0.0/16", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should allocate the addresses in order of the entries", func() {
+		// when
+		list, err := allocator.Allocate(vips.List{}, []vips.Entry{
+			vips.NewServiceEntry("provider"),
+			vips.NewServiceEntry("consumer"),
+			vips.NewApplicationEntry("shop"),
+		}, now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(vips.List{
+			vips.NewApplicationEntry("shop"): {Address: "127.1.0.1"},
+			vips.NewServiceEntry("consumer"): {Address: "127.1.0.2"},
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.3"},
+		}))
+	})
+
+	It("should keep the addresses of the existing entries", func() {
+		// given
+		current := vips.List{
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.7"},
+		}
+
+		// when
+		list, err := allocator.Allocate(current, []vips.Entry{vips.NewServiceEntry("provider"), vips.NewServiceEntry("consumer")}, now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(vips.List{
+			vips.NewServiceEntry("consumer"): {Address: "127.1.0.1"},
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.7"},
+		}))
+	})
+
+	It("should keep the released addresses reserved until the grace period passes", func() {
+		// given
+		releasedAt := now.Add(-30 * time.Minute)
+		current := vips.List{
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.2"},
+			vips.NewServiceEntry("removed"):  {Address: "127.1.0.1"},
+			vips.NewServiceEntry("released"): {Address: "127.1.0.3", ReleasedAt: &releasedAt},
+		}
+
+		// when
+		list, err := allocator.Allocate(current, []vips.Entry{vips.NewServiceEntry("provider"), vips.NewServiceEntry("consumer")}, now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(vips.List{
+			vips.NewServiceEntry("consumer"): {Address: "127.1.0.4"},
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.2"},
+			vips.NewServiceEntry("removed"):  {Address: "127.1.0.1", ReleasedAt: &now},
+			vips.NewServiceEntry("released"): {Address: "127.1.0.3", ReleasedAt: &releasedAt},
+		}))
+	})
+
+	It("should give the released address back to an entry that comes back", func() {
+		// given
+		releasedAt := now.Add(-30 * time.Minute)
+		current := vips.List{
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.5", ReleasedAt: &releasedAt},
+		}
+
+		// when
+		list, err := allocator.Allocate(current, []vips.Entry{vips.NewServiceEntry("provider")}, now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(vips.List{
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.5"},
+		}))
+	})
+
+	It("should reuse the released addresses after the grace period", func() {
+		// given
+		releasedAt := now.Add(-time.Hour)
+		current := vips.List{
+			vips.NewServiceEntry("removed"): {Address: "127.1.0.1", ReleasedAt: &releasedAt},
+		}
+
+		// when
+		list, err := allocator.Allocate(current, []vips.Entry{vips.NewServiceEntry("consumer")}, now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(vips.List{
+			vips.NewServiceEntry("consumer"): {Address: "127.1.0.1"},
+		}))
+	})
+
+	It("should reallocate the addresses outside of the CIDR", func() {
+		// given
+		current := vips.List{
+			vips.NewServiceEntry("provider"): {Address: "240.0.0.1"},
+		}
+
+		// when
+		list, err := allocator.Allocate(current, []vips.Entry{vips.NewServiceEntry("provider")}, now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(vips.List{
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.1"},
+		}))
+	})
+
+	It("should fail when there is no free address left", func() {
+		// given
+		allocator, err := vips.NewAllocator("127.1.0.0/30", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = allocator.Allocate(vips.List{}, []vips.Entry{
+			vips.NewServiceEntry("a"),
+			vips.NewServiceEntry("b"),
+			vips.NewServiceEntry("c"),
+		}, now)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`could not allocate a virtual IP for service "c"`)))
+	})
+
+	It("should build the hostname of an entry", func() {
+		Expect(vips.Hostname("Provider", "mesh")).To(Equal("provider.mesh"))
+	})
+})
+
+var _ = Describe("Ports", func() {
+	dataplane := func(app string, inbounds map[uint32]string) *core_mesh.DataplaneResource {
+		dp := core_mesh.NewDataplaneResource()
+		dp.Meta = &test_model.ResourceMeta{Labels: map[string]string{mesh_proto.AppTag: app}}
+		dp.Spec.Networking = &mesh_proto.Dataplane_Networking{Address: "192.168.0.1"}
+		for port, service := range inbounds {
+			dp.Spec.Networking.Inbound = append(dp.Spec.Networking.Inbound, &mesh_proto.Dataplane_Networking_Inbound{
+				Port: port,
+				Tags: map[string]string{mesh_proto.ServiceTag: service},
+			})
+		}
+		return dp
+	}
+
+	It("should return the ports of the services and the applications", func() {
+		// given
+		dataplanes := []*core_mesh.DataplaneResource{
+			dataplane("shop", map[uint32]string{20880: "org.apache.dubbo.OrderService", 8080: "web"}),
+			dataplane("shop", map[uint32]string{20880: "org.apache.dubbo.CartService"}),
+			dataplane("", map[uint32]string{9090: "backend"}),
+			dataplane("web", map[uint32]string{8080: "web"}),
+		}
+
+		// when
+		ports := vips.Ports(dataplanes)
+
+		// then
+		Expect(ports).To(Equal(map[vips.Entry][]vips.Port{
+			vips.NewServiceEntry("org.apache.dubbo.OrderService"): {{Port: 20880, Service: "org.apache.dubbo.OrderService"}},
+			vips.NewServiceEntry("org.apache.dubbo.CartService"):  {{Port: 20880, Service: "org.apache.dubbo.CartService"}},
+			vips.NewServiceEntry("web"):                           {{Port: 8080, Service: "web"}},
+			vips.NewServiceEntry("backend"):                       {{Port: 9090, Service: "backend"}},
+			vips.NewApplicationEntry("shop"): {
+				{Port: 8080, Service: "web"},
+				{Port: 20880, Service: "org.apache.dubbo.CartService"},
+			},
+		}))
+	})
+})
+
+var _ = Describe("Persistence", func() {
+	var persistence *vips.Persistence
+
+	BeforeEach(func() {
+		persistence = vips.NewPersistence(config_manager.NewConfigManager(resources_memory.NewStore()))
+	})
+
+	It("should return an empty list when nothing was persisted", func() {
+		// when
+		list, err := persistence.Get(context.Background(), "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(BeEmpty())
+	})
+
+	It("should persist the list of every mesh", func() {
+		// given
+		releasedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		expected := vips.List{
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.1"},
+			vips.NewApplicationEntry("shop"): {Address: "127.1.0.2"},
+			vips.NewServiceEntry("consumer"): {Address: "127.1.0.3", ReleasedAt: &releasedAt},
+		}
+		Expect(persistence.Set(context.Background(), "default", vips.List{vips.NewServiceEntry("provider"): {Address: "127.1.0.1"}})).To(Succeed())
+		Expect(persistence.Set(context.Background(), "default", expected)).To(Succeed())
+		Expect(persistence.Set(context.Background(), "demo", vips.List{vips.NewServiceEntry("backend"): {Address: "127.1.0.1"}})).To(Succeed())
+
+		// when
+		list, err := persistence.Get(context.Background(), "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(expected))
+	})
+})
@@ -0,0 +1,74 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh
+
+import (
+	"context"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+// ValidateMTLSBackends checks that every CA backend of the Mesh is handled by an installed CA plugin
+// and that the plugin accepts its configuration.
+func ValidateMTLSBackends(ctx context.Context, caManagers core_ca.Managers, name string, resource *core_mesh.MeshResource) validators.ValidationError {
+	path := validators.RootedAt("mtls").Field("backends")
+	verr := validators.ValidationError{}
+
+	for idx, backend := range resource.Spec.GetMtls().GetBackends() {
+		caManager, exist := caManagers[backend.GetType()]
+		if !exist {
+			verr.AddViolationAt(path.Index(idx).Field("type"), "could not find installed plugin for this type")
+			return verr
+		} else if err := caManager.ValidateBackend(ctx, name, backend); err != nil {
+			if configErr, ok := err.(*validators.ValidationError); ok {
+				verr.AddErrorAt(path.Index(idx).Field("conf"), *configErr)
+			} else {
+				verr.AddViolationAt(path, err.Error())
+			}
+		}
+	}
+	return verr
+}
+
+// EnsureCAs makes sure that all CA backends of the Mesh are ready to issue certificates,
+// e.g. the root certificate of the builtin CA is generated.
+func EnsureCAs(ctx context.Context, caManagers core_ca.Managers, mesh *core_mesh.MeshResource, meshName string) error {
+	backendsForType := map[string][]*mesh_proto.CertificateAuthorityBackend{}
+	for _, backend := range mesh.Spec.GetMtls().GetBackends() {
+		backendsForType[backend.GetType()] = append(backendsForType[backend.GetType()], backend)
+	}
+	for typ, backends := range backendsForType {
+		caManager, exist := caManagers[typ]
+		if !exist { // this should be caught by validator earlier
+			return errors.Errorf("CA manager for type %s does not exist", typ)
+		}
+		if err := caManager.EnsureBackends(ctx, meshName, backends); err != nil {
+			return errors.Wrapf(err, "could not ensure CA backends of type %s", typ)
+		}
+	}
+	return nil
+}
@@ -32,6 +32,7 @@ import (
 	dubbo_cp "github.com/apache/dubbo-kubernetes/pkg/config/app/dubbo-cp"
 	config_core "github.com/apache/dubbo-kubernetes/pkg/config/core"
 	config_store "github.com/apache/dubbo-kubernetes/pkg/config/core/resources/store"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
 	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	core_manager "github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
 	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
@@ -42,6 +43,7 @@ import (
 func NewMeshManager(
 	store core_store.ResourceStore,
 	otherManagers core_manager.ResourceManager,
+	caManagers core_ca.Managers,
 	registry core_registry.TypeRegistry,
 	validator MeshValidator,
 	extensions context.Context,
@@ -52,6 +54,7 @@ func NewMeshManager(
 	meshManager := &meshManager{
 		store:         store,
 		otherManagers: otherManagers,
+		caManagers:    caManagers,
 		registry:      registry,
 		meshValidator: validator,
 		unsafeDelete:  config.Store.UnsafeDelete,
@@ -69,6 +72,7 @@ func NewMeshManager(
 type meshManager struct {
 	store           core_store.ResourceStore
 	otherManagers   core_manager.ResourceManager
+	caManagers      core_ca.Managers
 	registry        core_registry.TypeRegistry
 	meshValidator   MeshValidator
 	unsafeDelete    bool
@@ -106,6 +110,14 @@ func (m *meshManager) Create(ctx context.Context, resource core_model.Resource,
 	//if err := m.meshValidator.ValidateCreate(ctx, opts.Name, mesh); err != nil {
 	//	return err
 	//}
+	opts := core_store.NewCreateOptions(fs...)
+	if verr := ValidateMTLSBackends(ctx, m.caManagers, opts.Name, mesh); verr.HasViolations() {
+		return verr.OrNil()
+	}
+	// ensure CAs before persisting Mesh, so Dataplanes never see a Mesh without its CA ready
+	if err := EnsureCAs(ctx, m.caManagers, mesh, opts.Name); err != nil {
+		return err
+	}
 	// persist Mesh
 	if err := m.store.Create(ctx, mesh, append(fs, core_store.CreatedAt(time.Now()))...); err != nil {
 		return err
@@ -160,6 +172,12 @@ func (m *meshManager) Update(ctx context.Context, resource core_model.Resource,
 	//if err := m.meshValidator.ValidateUpdate(ctx, currentMesh, mesh); err != nil {
 	//	return err
 	//}
+	if verr := ValidateMTLSBackends(ctx, m.caManagers, mesh.GetMeta().GetName(), mesh); verr.HasViolations() {
+		return verr.OrNil()
+	}
+	if err := EnsureCAs(ctx, m.caManagers, mesh, mesh.GetMeta().GetName()); err != nil {
+		return err
+	}
 	return m.store.Update(ctx, mesh, append(fs, core_store.ModifiedAt(time.Now()))...)
 }
 
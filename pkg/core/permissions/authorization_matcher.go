@@ -0,0 +1,168 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package permissions
+
+import (
+	"net"
+	"sort"
+	"strings"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+)
+
+// MatchAuthorizationPolicies picks the authorization policies that apply to each inbound of the dataplane.
+// The destination side of a rule (`to`) is resolved here against the inbound, so the returned policies
+// only hold the rules that target the inbound. A policy with no rules applies to every inbound.
+func MatchAuthorizationPolicies(
+	dataplane *core_mesh.DataplaneResource,
+	policies []*core_mesh.AuthorizationPolicyResource,
+) core_xds.AuthorizationPolicyMap {
+	sorted := make([]*core_mesh.AuthorizationPolicyResource, len(policies))
+	copy(sorted, policies)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetMeta().GetName() < sorted[j].GetMeta().GetName()
+	})
+
+	result := core_xds.AuthorizationPolicyMap{}
+	networking := dataplane.Spec.GetNetworking()
+	for i, iface := range networking.GetInboundInterfaces() {
+		inbound := networking.GetInbound()[i]
+		if iface.IsServiceLess() {
+			continue
+		}
+		target := inboundTarget{
+			identity: mesh_proto.SpiffeID(dataplane.GetMeta().GetMesh(), inbound.GetService()),
+			ip:       net.ParseIP(iface.DataplaneIP),
+			tags:     inbound.GetTags(),
+		}
+		var matched []*core_mesh.AuthorizationPolicyResource
+		for _, policy := range sorted {
+			if policy := target.match(policy); policy != nil {
+				matched = append(matched, policy)
+			}
+		}
+		if len(matched) > 0 {
+			result[iface] = matched
+		}
+	}
+	return result
+}
+
+type inboundTarget struct {
+	identity string
+	ip       net.IP
+	tags     map[string]string
+}
+
+// match returns the policy narrowed down to the rules that target the inbound
+// or nil when the policy doesn't apply to the inbound at all.
+func (t inboundTarget) match(policy *core_mesh.AuthorizationPolicyResource) *core_mesh.AuthorizationPolicyResource {
+	rules := policy.Spec.GetRules()
+	if len(rules) == 0 {
+		return policy
+	}
+	var matched []*mesh_proto.AuthorizationPolicyRule
+	for _, rule := range rules {
+		if t.matchRule(rule.GetTo()) {
+			matched = append(matched, rule)
+		}
+	}
+	switch {
+	case len(matched) == 0:
+		return nil
+	case len(matched) == len(rules):
+		return policy
+	case policy.Spec.GetMatchType() == mesh_proto.AuthorizationMatchTypeAll:
+		// every rule has to match, so the policy can never match on this inbound
+		return nil
+	}
+	return &core_mesh.AuthorizationPolicyResource{
+		Meta: policy.GetMeta(),
+		Spec: &mesh_proto.AuthorizationPolicy{
+			Action:    policy.Spec.GetAction(),
+			Rules:     matched,
+			Samples:   policy.Spec.GetSamples(),
+			MatchType: policy.Spec.GetMatchType(),
+		},
+	}
+}
+
+func (t inboundTarget) matchRule(to *mesh_proto.AuthorizationPolicyTarget) bool {
+	if to == nil {
+		return true
+	}
+	if len(to.GetPrincipals()) > 0 && !t.matchPrincipals(to.GetPrincipals()) {
+		return false
+	}
+	if t.matchPrincipals(to.GetNotPrincipals()) {
+		return false
+	}
+	if len(to.GetIpBlocks()) > 0 && !t.matchIPBlocks(to.GetIpBlocks()) {
+		return false
+	}
+	if t.matchIPBlocks(to.GetNotIpBlocks()) {
+		return false
+	}
+	if len(to.GetExtends()) > 0 && !t.matchExtends(to.GetExtends()) {
+		return false
+	}
+	if t.matchExtends(to.GetNotExtends()) {
+		return false
+	}
+	return true
+}
+
+func (t inboundTarget) matchPrincipals(principals []string) bool {
+	for _, principal := range principals {
+		if mesh_proto.PrincipalURI(principal) == t.identity {
+			return true
+		}
+	}
+	return false
+}
+
+func (t inboundTarget) matchIPBlocks(blocks []string) bool {
+	if t.ip == nil {
+		return false
+	}
+	for _, block := range blocks {
+		if !strings.Contains(block, "/") {
+			if ip := net.ParseIP(block); ip != nil && ip.Equal(t.ip) {
+				return true
+			}
+			continue
+		}
+		if _, ipNet, err := net.ParseCIDR(block); err == nil && ipNet.Contains(t.ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t inboundTarget) matchExtends(extends []*mesh_proto.AuthorizationPolicyExtend) bool {
+	for _, extend := range extends {
+		if value, ok := t.tags[extend.GetKey()]; ok && value == extend.GetValue() {
+			return true
+		}
+	}
+	return false
+}
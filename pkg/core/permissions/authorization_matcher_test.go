@@ -0,0 +1,153 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package permissions_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/permissions"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+)
+
+var _ = Describe("MatchAuthorizationPolicies()", func() {
+	dataplane := &core_mesh.DataplaneResource{
+		Meta: &test_model.ResourceMeta{Name: "dp-1", Mesh: core_model.DefaultMesh},
+		Spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+					{
+						Port: 20880,
+						Tags: map[string]string{mesh_proto.ServiceTag: "greeter", "version": "v1"},
+					},
+					{
+						Port: 8080,
+						Tags: map[string]string{mesh_proto.ServiceTag: "web", "version": "v2"},
+					},
+				},
+			},
+		},
+	}
+	greeter := dataplane.Spec.Networking.ToInboundInterface(dataplane.Spec.Networking.Inbound[0])
+	web := dataplane.Spec.Networking.ToInboundInterface(dataplane.Spec.Networking.Inbound[1])
+
+	policy := func(name, matchType string, targets ...*mesh_proto.AuthorizationPolicyTarget) *core_mesh.AuthorizationPolicyResource {
+		spec := &mesh_proto.AuthorizationPolicy{
+			Action:    mesh_proto.AuthorizationActionAllow,
+			MatchType: matchType,
+		}
+		for _, target := range targets {
+			spec.Rules = append(spec.Rules, &mesh_proto.AuthorizationPolicyRule{To: target})
+		}
+		return &core_mesh.AuthorizationPolicyResource{
+			Meta: &test_model.ResourceMeta{Name: name, Mesh: core_model.DefaultMesh},
+			Spec: spec,
+		}
+	}
+
+	type testCase struct {
+		policy   *core_mesh.AuthorizationPolicyResource
+		expected map[mesh_proto.InboundInterface]int
+	}
+
+	DescribeTable("should resolve the destination of the rules",
+		func(given testCase) {
+			// when
+			matched := permissions.MatchAuthorizationPolicies(dataplane, []*core_mesh.AuthorizationPolicyResource{given.policy})
+
+			// then
+			Expect(matched).To(HaveLen(len(given.expected)))
+			for iface, rules := range given.expected {
+				Expect(matched[iface]).To(HaveLen(1))
+				Expect(matched[iface][0].Spec.GetRules()).To(HaveLen(rules))
+			}
+		},
+		Entry("policy without rules applies to every inbound", testCase{
+			policy:   policy("all", ""),
+			expected: map[mesh_proto.InboundInterface]int{greeter: 0, web: 0},
+		}),
+		Entry("rule without target applies to every inbound", testCase{
+			policy:   policy("all", "", nil),
+			expected: map[mesh_proto.InboundInterface]int{greeter: 1, web: 1},
+		}),
+		Entry("by principal", testCase{
+			policy: policy("by-principal", "", &mesh_proto.AuthorizationPolicyTarget{
+				Principals: []string{"default/greeter"},
+			}),
+			expected: map[mesh_proto.InboundInterface]int{greeter: 1},
+		}),
+		Entry("by excluded principal", testCase{
+			policy: policy("by-not-principal", "", &mesh_proto.AuthorizationPolicyTarget{
+				NotPrincipals: []string{"spiffe://default/greeter"},
+			}),
+			expected: map[mesh_proto.InboundInterface]int{web: 1},
+		}),
+		Entry("by ip block", testCase{
+			policy: policy("by-ip", "", &mesh_proto.AuthorizationPolicyTarget{
+				IpBlocks: []string{"192.168.0.0/24"},
+			}),
+			expected: map[mesh_proto.InboundInterface]int{greeter: 1, web: 1},
+		}),
+		Entry("by excluded ip", testCase{
+			policy: policy("by-not-ip", "", &mesh_proto.AuthorizationPolicyTarget{
+				NotIpBlocks: []string{"192.168.0.1"},
+			}),
+			expected: map[mesh_proto.InboundInterface]int{},
+		}),
+		Entry("by extends", testCase{
+			policy: policy("by-extends", "", &mesh_proto.AuthorizationPolicyTarget{
+				Extends: []*mesh_proto.AuthorizationPolicyExtend{{Key: "version", Value: "v2"}},
+			}),
+			expected: map[mesh_proto.InboundInterface]int{web: 1},
+		}),
+		Entry("keeps only the rules targeting the inbound", testCase{
+			policy: policy("any-match", mesh_proto.AuthorizationMatchTypeAny,
+				&mesh_proto.AuthorizationPolicyTarget{Principals: []string{"default/greeter"}},
+				&mesh_proto.AuthorizationPolicyTarget{IpBlocks: []string{"192.168.0.1"}},
+			),
+			expected: map[mesh_proto.InboundInterface]int{greeter: 2, web: 1},
+		}),
+		Entry("all rules have to target the inbound on allMatch", testCase{
+			policy: policy("all-match", mesh_proto.AuthorizationMatchTypeAll,
+				&mesh_proto.AuthorizationPolicyTarget{Principals: []string{"default/greeter"}},
+				&mesh_proto.AuthorizationPolicyTarget{IpBlocks: []string{"192.168.0.1"}},
+			),
+			expected: map[mesh_proto.InboundInterface]int{greeter: 2},
+		}),
+	)
+
+	It("should sort the policies by name", func() {
+		// when
+		matched := permissions.MatchAuthorizationPolicies(dataplane, []*core_mesh.AuthorizationPolicyResource{
+			policy("b", ""),
+			policy("a", ""),
+		})
+
+		// then
+		Expect(matched[greeter]).To(HaveLen(2))
+		Expect(matched[greeter][0].GetMeta().GetName()).To(Equal("a"))
+		Expect(matched[greeter][1].GetMeta().GetName()).To(Equal("b"))
+	})
+})
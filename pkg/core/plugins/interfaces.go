@@ -22,6 +22,7 @@ import (
 )
 
 import (
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
 	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
 	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
@@ -94,7 +95,8 @@ type PolicyPlugin interface {
 	Apply(rs *core_xds.ResourceSet, ctx xds_context.Context, proxy *core_xds.Proxy) error
 }
 
+// CaPlugin is responsible for providing Certificate Authority Manager
 type CaPlugin interface {
 	Plugin
-	NewCaManager(PluginContext, PluginConfig)
+	NewCaManager(PluginContext, PluginConfig) (core_ca.Manager, error)
 }
@@ -48,7 +48,8 @@ const (
 	Nacos       PluginName = "nacos"
 	MySQL       PluginName = "mysql"
 
-	CaBuiltin PluginName = "builtin"
+	CaBuiltin  PluginName = "builtin"
+	CaProvided PluginName = "provided"
 )
 
 type RegisteredPolicyPlugin struct {
@@ -62,6 +63,7 @@ type Registry interface {
 	ConfigStore(name PluginName) (ConfigStorePlugin, error)
 	RuntimePlugins() map[PluginName]RuntimePlugin
 	PolicyPlugins([]PluginName) []RegisteredPolicyPlugin
+	CaPlugins() map[PluginName]CaPlugin
 }
 
 type RegistryMutator interface {
@@ -80,6 +82,7 @@ func NewRegistry() MutableRegistry {
 		configStore:        make(map[PluginName]ConfigStorePlugin),
 		runtime:            make(map[PluginName]RuntimePlugin),
 		registeredPolicies: make(map[PluginName]PolicyPlugin),
+		ca:                 make(map[PluginName]CaPlugin),
 	}
 }
 
@@ -91,6 +94,7 @@ type registry struct {
 	configStore        map[PluginName]ConfigStorePlugin
 	runtime            map[PluginName]RuntimePlugin
 	registeredPolicies map[PluginName]PolicyPlugin
+	ca                 map[PluginName]CaPlugin
 }
 
 func (r *registry) ResourceStore(name PluginName) (ResourceStorePlugin, error) {
@@ -113,6 +117,10 @@ func (r *registry) RuntimePlugins() map[PluginName]RuntimePlugin {
 	return r.runtime
 }
 
+func (r *registry) CaPlugins() map[PluginName]CaPlugin {
+	return r.ca
+}
+
 func (r *registry) PolicyPlugins(ordered []PluginName) []RegisteredPolicyPlugin {
 	var plugins []RegisteredPolicyPlugin
 	for _, policy := range ordered {
@@ -175,6 +183,12 @@ func (r *registry) Register(name PluginName, plugin Plugin) error {
 		}
 		r.registeredPolicies[name] = policy
 	}
+	if cp, ok := plugin.(CaPlugin); ok {
+		if old, exists := r.ca[name]; exists {
+			return pluginAlreadyRegisteredError(caPlugin, name, old, cp)
+		}
+		r.ca[name] = cp
+	}
 	return nil
 }
 
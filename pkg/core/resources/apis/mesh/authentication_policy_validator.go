@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh
+
+import (
+	"fmt"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+var authenticationActions = []string{
+	mesh_proto.AuthenticationActionNone,
+	mesh_proto.AuthenticationActionDisabled,
+	mesh_proto.AuthenticationActionPermissive,
+	mesh_proto.AuthenticationActionStrict,
+}
+
+func (r *AuthenticationPolicyResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(validateAuthenticationAction(validators.RootedAt("action"), r.Spec.GetAction()))
+	for i, selector := range r.Spec.GetSelector() {
+		err.Add(r.validateSelector(validators.RootedAt("selector").Index(i), selector))
+	}
+	for i, portLevel := range r.Spec.GetPortLevel() {
+		path := validators.RootedAt("portLevel").Index(i)
+		if portLevel.GetPort() < 0 || portLevel.GetPort() > 65535 {
+			err.AddViolationAt(path.Field("port"), fmt.Sprintf(validators.HasToBeInRangeFormat, 0, 65535))
+		}
+		err.Add(validateAuthenticationAction(path.Field("action"), portLevel.GetAction()))
+	}
+	return err.OrNil()
+}
+
+func (r *AuthenticationPolicyResource) validateSelector(path validators.PathBuilder, selector *mesh_proto.AuthenticationPolicySelector) validators.ValidationError {
+	var err validators.ValidationError
+	err.Add(validateIPBlocks(path.Field("ipBlocks"), selector.GetIpBlocks()))
+	err.Add(validateIPBlocks(path.Field("notIpBlocks"), selector.GetNotIpBlocks()))
+	err.Add(validateExtendKeys(path.Field("extends"), selector.GetExtends()))
+	err.Add(validateExtendKeys(path.Field("notExtends"), selector.GetNotExtends()))
+	return err
+}
+
+func validateAuthenticationAction(path validators.PathBuilder, action string) validators.ValidationError {
+	var err validators.ValidationError
+	switch action {
+	case mesh_proto.AuthenticationActionNone, mesh_proto.AuthenticationActionDisabled,
+		mesh_proto.AuthenticationActionPermissive, mesh_proto.AuthenticationActionStrict:
+	case "":
+		err.AddViolationAt(path, validators.MustBeDefined)
+	default:
+		err.AddViolationAt(path, fmt.Sprintf("unknown action %q. %s", action, AllowedValuesHint(authenticationActions...)))
+	}
+	return err
+}
@@ -0,0 +1,91 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mesh_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	. "github.com/apache/dubbo-kubernetes/pkg/test/resources"
+)
+
+var _ = Describe("AuthenticationPolicy", func() {
+	DescribeValidCases(
+		core_mesh.NewAuthenticationPolicyResource,
+		Entry("strict with selectors and port levels", `
+action: STRICT
+selector:
+- namespaces:
+  - dubbo-demo
+  ipBlocks:
+  - 10.0.0.0/8
+  notIpBlocks:
+  - 10.0.0.1
+  extends:
+  - key: app
+    value: shop
+portLevel:
+- port: 20880
+  action: PERMISSIVE
+`),
+		Entry("disabled", `
+action: DISABLED
+`),
+	)
+
+	DescribeErrorCases(
+		core_mesh.NewAuthenticationPolicyResource,
+		ErrorCase("empty spec", validators.Violation{
+			Field:   "action",
+			Message: "must be defined",
+		}, `{}`),
+		ErrorCase("unknown action", validators.Violation{
+			Field:   "action",
+			Message: `unknown action "OPTIONAL". Allowed values: NONE, DISABLED, PERMISSIVE, STRICT`,
+		}, `
+action: OPTIONAL
+`),
+		ErrorCases("invalid selector", []validators.Violation{
+			{Field: "selector[0].ipBlocks[0]", Message: `"10.0.0.0/33" must be a valid IP address or CIDR`},
+			{Field: "selector[0].notIpBlocks[0]", Message: `"localhost" must be a valid IP address or CIDR`},
+			{Field: "selector[0].extends[0].key", Message: "must be defined"},
+			{Field: "selector[0].notExtends[0].key", Message: "must be defined"},
+		}, `
+action: STRICT
+selector:
+- ipBlocks:
+  - 10.0.0.0/33
+  notIpBlocks:
+  - localhost
+  extends:
+  - value: shop
+  notExtends:
+  - value: cart
+`),
+		ErrorCases("invalid port level", []validators.Violation{
+			{Field: "portLevel[0].port", Message: "must be in inclusive range [0, 65535]"},
+			{Field: "portLevel[0].action", Message: "must be defined"},
+		}, `
+action: STRICT
+portLevel:
+- port: 70000
+`),
+	)
+})
@@ -0,0 +1,94 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh
+
+import (
+	"fmt"
+	"regexp"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+func (r *AuthorizationPolicyResource) Validate() error {
+	var err validators.ValidationError
+	switch action := r.Spec.GetAction(); action {
+	case mesh_proto.AuthorizationActionAllow, mesh_proto.AuthorizationActionDeny, mesh_proto.AuthorizationActionAudit:
+	case "":
+		err.AddViolation("action", validators.MustBeDefined)
+	default:
+		err.AddViolation("action", fmt.Sprintf("unknown action %q. %s", action, AllowedValuesHint(
+			mesh_proto.AuthorizationActionAllow, mesh_proto.AuthorizationActionDeny, mesh_proto.AuthorizationActionAudit)))
+	}
+	switch matchType := r.Spec.GetMatchType(); matchType {
+	case "", mesh_proto.AuthorizationMatchTypeAny, mesh_proto.AuthorizationMatchTypeAll:
+	default:
+		err.AddViolation("matchType", fmt.Sprintf("unknown match type %q. %s", matchType, AllowedValuesHint(
+			mesh_proto.AuthorizationMatchTypeAny, mesh_proto.AuthorizationMatchTypeAll)))
+	}
+	if samples := r.Spec.GetSamples(); samples < 0 || samples > 100 {
+		err.AddViolation("samples", validators.HasToBeInPercentageRange)
+	}
+	for i, rule := range r.Spec.GetRules() {
+		err.Add(r.validateRule(validators.RootedAt("rules").Index(i), rule))
+	}
+	return err.OrNil()
+}
+
+func (r *AuthorizationPolicyResource) validateRule(path validators.PathBuilder, rule *mesh_proto.AuthorizationPolicyRule) validators.ValidationError {
+	var err validators.ValidationError
+	if from := rule.GetFrom(); from != nil {
+		err.Add(validateIPBlocks(path.Field("from").Field("ipBlocks"), from.GetIpBlocks()))
+		err.Add(validateIPBlocks(path.Field("from").Field("notIpBlocks"), from.GetNotIpBlocks()))
+		err.Add(validateExtendKeys(path.Field("from").Field("extends"), from.GetExtends()))
+		err.Add(validateExtendKeys(path.Field("from").Field("notExtends"), from.GetNotExtends()))
+	}
+	if to := rule.GetTo(); to != nil {
+		err.Add(validateIPBlocks(path.Field("to").Field("ipBlocks"), to.GetIpBlocks()))
+		err.Add(validateIPBlocks(path.Field("to").Field("notIpBlocks"), to.GetNotIpBlocks()))
+		err.Add(validateExtendKeys(path.Field("to").Field("extends"), to.GetExtends()))
+		err.Add(validateExtendKeys(path.Field("to").Field("notExtends"), to.GetNotExtends()))
+	}
+	if when := rule.GetWhen(); when != nil {
+		if len(when.GetValues()) > 0 || len(when.GetNotValues()) > 0 {
+			err.Add(validators.ValidateStringDefined(path.Field("when").Field("key"), when.GetKey()))
+		}
+		err.Add(validateAuthorizationMatches(path.Field("when").Field("values"), when.GetValues()))
+		err.Add(validateAuthorizationMatches(path.Field("when").Field("notValues"), when.GetNotValues()))
+	}
+	return err
+}
+
+func validateAuthorizationMatches(path validators.PathBuilder, matches []*mesh_proto.AuthorizationPolicyMatch) validators.ValidationError {
+	var err validators.ValidationError
+	for i, match := range matches {
+		switch match.GetType() {
+		case "", mesh_proto.AuthorizationMatchEquals, mesh_proto.AuthorizationMatchOgnl:
+		case mesh_proto.AuthorizationMatchRegex:
+			if _, reErr := regexp.Compile(match.GetValue()); reErr != nil {
+				err.AddViolationAt(path.Index(i).Field("value"), fmt.Sprintf("must be a valid regex: %s", reErr))
+			}
+		default:
+			err.AddViolationAt(path.Index(i).Field("type"), fmt.Sprintf("unknown type %q. %s", match.GetType(), AllowedValuesHint(
+				mesh_proto.AuthorizationMatchEquals, mesh_proto.AuthorizationMatchRegex, mesh_proto.AuthorizationMatchOgnl)))
+		}
+	}
+	return err
+}
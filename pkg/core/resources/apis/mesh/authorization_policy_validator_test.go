@@ -0,0 +1,101 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mesh_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	. "github.com/apache/dubbo-kubernetes/pkg/test/resources"
+)
+
+var _ = Describe("AuthorizationPolicy", func() {
+	DescribeValidCases(
+		core_mesh.NewAuthorizationPolicyResource,
+		Entry("allow with rules", `
+action: ALLOW
+matchType: allMatch
+samples: 50
+rules:
+- from:
+    namespaces:
+    - dubbo-demo
+    ipBlocks:
+    - 192.168.0.0/16
+    principals:
+    - shop
+  to:
+    extends:
+    - key: app
+      value: detail
+  when:
+    key: method
+    values:
+    - type: regex
+      value: get.*
+    notValues:
+    - type: equals
+      value: delete
+`),
+		Entry("deny without rules", `
+action: DENY
+`),
+	)
+
+	DescribeErrorCases(
+		core_mesh.NewAuthorizationPolicyResource,
+		ErrorCase("empty spec", validators.Violation{
+			Field:   "action",
+			Message: "must be defined",
+		}, `{}`),
+		ErrorCases("unknown action, match type and samples out of range", []validators.Violation{
+			{Field: "action", Message: `unknown action "REJECT". Allowed values: ALLOW, DENY, AUDIT`},
+			{Field: "matchType", Message: `unknown match type "oneMatch". Allowed values: anyMatch, allMatch`},
+			{Field: "samples", Message: "must be in inclusive range [0.0, 100.0]"},
+		}, `
+action: REJECT
+matchType: oneMatch
+samples: 101
+`),
+		ErrorCases("invalid rules", []validators.Violation{
+			{Field: "rules[0].from.ipBlocks[0]", Message: `"shop" must be a valid IP address or CIDR`},
+			{Field: "rules[0].to.notExtends[0].key", Message: "must be defined"},
+			{Field: "rules[0].when.key", Message: "must be defined"},
+			{Field: "rules[0].when.values[0].value", Message: "must be a valid regex: error parsing regexp: missing closing ): `(get`"},
+			{Field: "rules[0].when.notValues[0].type", Message: `unknown type "prefix". Allowed values: equals, regex, ognl`},
+		}, `
+action: ALLOW
+rules:
+- from:
+    ipBlocks:
+    - shop
+  to:
+    notExtends:
+    - value: detail
+  when:
+    values:
+    - type: regex
+      value: (get
+    notValues:
+    - type: prefix
+      value: delete
+`),
+	)
+})
@@ -0,0 +1,43 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	route_condition "github.com/apache/dubbo-kubernetes/pkg/core/route/condition"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+func (r *ConditionRouteResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(validators.ValidateStringDefined(validators.RootedAt("key"), r.Spec.GetKey()))
+	err.Add(validateRuleScope(validators.RootedAt("scope"), r.Spec.GetScope()))
+	err.Add(r.validateConditions(validators.RootedAt("conditions"), r.Spec))
+	return err.OrNil()
+}
+
+func (r *ConditionRouteResource) validateConditions(path validators.PathBuilder, spec *mesh_proto.ConditionRoute) validators.ValidationError {
+	var err validators.ValidationError
+	if len(spec.GetConditions()) == 0 {
+		err.AddViolationAt(path, validators.MustNotBeEmpty)
+	}
+	for i, condition := range spec.GetConditions() {
+		err.Add(route_condition.Validate(path.Index(i), condition))
+	}
+	return err
+}
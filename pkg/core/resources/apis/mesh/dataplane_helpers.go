@@ -39,6 +39,10 @@ const (
 	ProtocolGRPC    = "grpc"
 	ProtocolKafka   = "kafka"
 	ProtocolTriple  = "triple"
+	ProtocolDubbo   = "dubbo"
+
+	// protocolTri is the name Dubbo registers Triple services with
+	protocolTri = "tri"
 )
 
 func ParseProtocol(tag string) Protocol {
@@ -53,8 +57,10 @@ func ParseProtocol(tag string) Protocol {
 		return ProtocolGRPC
 	case ProtocolKafka:
 		return ProtocolKafka
-	case ProtocolTriple:
+	case ProtocolTriple, protocolTri:
 		return ProtocolTriple
+	case ProtocolDubbo:
+		return ProtocolDubbo
 	default:
 		return ProtocolUnknown
 	}
@@ -73,11 +79,43 @@ func (l ProtocolList) Strings() []string {
 
 // SupportedProtocols is a list of supported protocols that will be communicated to a user.
 var SupportedProtocols = ProtocolList{
+	ProtocolDubbo,
 	ProtocolGRPC,
 	ProtocolHTTP,
 	ProtocolHTTP2,
 	ProtocolKafka,
 	ProtocolTCP,
+	ProtocolTriple,
+}
+
+// GetCommonProtocol returns a common protocol between given two.
+//
+// E.g.,
+// a common protocol between HTTP and HTTP2 is HTTP2,
+// a common protocol between HTTP and gRPC is HTTP2,
+// a common protocol between gRPC and Triple is gRPC,
+// a common protocol between HTTP and TCP is TCP,
+// a common protocol between Dubbo and any other protocol is TCP,
+// a common protocol between TCP and unknown is unknown.
+func GetCommonProtocol(one, another Protocol) Protocol {
+	switch {
+	case one == another:
+		return one
+	case one == ProtocolUnknown || another == ProtocolUnknown:
+		return ProtocolUnknown
+	case one == ProtocolTCP || another == ProtocolTCP:
+		return ProtocolTCP
+	case one == ProtocolKafka || another == ProtocolKafka:
+		return ProtocolTCP
+	case one == ProtocolDubbo || another == ProtocolDubbo:
+		return ProtocolTCP
+	case (one == ProtocolGRPC && another == ProtocolTriple) || (one == ProtocolTriple && another == ProtocolGRPC):
+		return ProtocolGRPC
+	case one == ProtocolHTTP || another == ProtocolHTTP:
+		return ProtocolHTTP2
+	default:
+		return ProtocolHTTP2
+	}
 }
 
 // Service that indicates L4 pass through cluster
@@ -0,0 +1,66 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+)
+
+var _ = Describe("ParseProtocol()", func() {
+	type testCase struct {
+		tag      string
+		expected core_mesh.Protocol
+	}
+
+	DescribeTable("should parse the protocol tag",
+		func(given testCase) {
+			Expect(core_mesh.ParseProtocol(given.tag)).To(Equal(given.expected))
+		},
+		Entry("dubbo", testCase{tag: "dubbo", expected: core_mesh.ProtocolDubbo}),
+		Entry("Dubbo", testCase{tag: "Dubbo", expected: core_mesh.ProtocolDubbo}),
+		Entry("tri", testCase{tag: "tri", expected: core_mesh.ProtocolTriple}),
+		Entry("thrift", testCase{tag: "thrift", expected: core_mesh.ProtocolUnknown}),
+	)
+})
+
+var _ = Describe("GetCommonProtocol()", func() {
+	type testCase struct {
+		one      core_mesh.Protocol
+		another  core_mesh.Protocol
+		expected core_mesh.Protocol
+	}
+
+	DescribeTable("should fall back to TCP when only one of the protocols is Dubbo",
+		func(given testCase) {
+			Expect(core_mesh.GetCommonProtocol(given.one, given.another)).To(Equal(given.expected))
+			Expect(core_mesh.GetCommonProtocol(given.another, given.one)).To(Equal(given.expected))
+		},
+		Entry("dubbo and dubbo", testCase{one: core_mesh.ProtocolDubbo, another: core_mesh.ProtocolDubbo, expected: core_mesh.ProtocolDubbo}),
+		Entry("dubbo and triple", testCase{one: core_mesh.ProtocolDubbo, another: core_mesh.ProtocolTriple, expected: core_mesh.ProtocolTCP}),
+		Entry("dubbo and grpc", testCase{one: core_mesh.ProtocolDubbo, another: core_mesh.ProtocolGRPC, expected: core_mesh.ProtocolTCP}),
+		Entry("dubbo and http", testCase{one: core_mesh.ProtocolDubbo, another: core_mesh.ProtocolHTTP, expected: core_mesh.ProtocolTCP}),
+		Entry("dubbo and tcp", testCase{one: core_mesh.ProtocolDubbo, another: core_mesh.ProtocolTCP, expected: core_mesh.ProtocolTCP}),
+		Entry("dubbo and unknown", testCase{one: core_mesh.ProtocolDubbo, another: core_mesh.ProtocolUnknown, expected: core_mesh.ProtocolUnknown}),
+	)
+})
@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	. "github.com/apache/dubbo-kubernetes/pkg/test/resources"
+)
+
+var _ = Describe("Dataplane", func() {
+	DescribeValidCases(
+		core_mesh.NewDataplaneResource,
+		Entry("inbound with the dubbo protocol", `
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: 20880
+    tags:
+      dubbo.io/service: backend
+      dubbo.io/protocol: dubbo
+`),
+		Entry("inbound with the tri protocol", `
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: 50051
+    tags:
+      dubbo.io/service: backend
+      dubbo.io/protocol: tri
+`),
+	)
+
+	DescribeErrorCases(
+		core_mesh.NewDataplaneResource,
+		ErrorCases("inbound with an unknown protocol", []validators.Violation{
+			{
+				Field:   `networking.inbound[0].tags["dubbo.io/protocol"]`,
+				Message: `tag "dubbo.io/protocol" has an invalid value "thrift". Allowed values: dubbo, grpc, http, http2, kafka, tcp, triple`,
+			},
+		}, `
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: 20880
+    tags:
+      dubbo.io/service: backend
+      dubbo.io/protocol: thrift
+`),
+	)
+})
@@ -0,0 +1,51 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh
+
+import (
+	"fmt"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+func (r *DynamicConfigResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(validators.ValidateStringDefined(validators.RootedAt("key"), r.Spec.GetKey()))
+	err.Add(validateRuleScope(validators.RootedAt("scope"), r.Spec.GetScope()))
+	err.Add(r.validateConfigs(validators.RootedAt("configs"), r.Spec.GetConfigs()))
+	return err.OrNil()
+}
+
+func (r *DynamicConfigResource) validateConfigs(path validators.PathBuilder, configs []*mesh_proto.OverrideConfig) validators.ValidationError {
+	var err validators.ValidationError
+	if len(configs) == 0 {
+		err.AddViolationAt(path, validators.MustNotBeEmpty)
+	}
+	for i, config := range configs {
+		switch config.GetSide() {
+		case "", consts.ProviderSide, consts.ConsumerSide:
+		default:
+			err.AddViolationAt(path.Index(i).Field("side"), fmt.Sprintf("unknown side %q. %s", config.GetSide(), AllowedValuesHint(consts.ProviderSide, consts.ConsumerSide)))
+		}
+	}
+	return err
+}
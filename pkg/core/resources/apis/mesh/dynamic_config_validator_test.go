@@ -0,0 +1,67 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	. "github.com/apache/dubbo-kubernetes/pkg/test/resources"
+)
+
+var _ = Describe("DynamicConfig", func() {
+	DescribeValidCases(
+		core_mesh.NewDynamicConfigResource,
+		Entry("configs for both sides", `
+key: org.apache.dubbo.samples.DetailService
+scope: service
+configs:
+- side: provider
+  parameters:
+    timeout: "3000"
+- side: consumer
+  addresses:
+  - 0.0.0.0
+  parameters:
+    retries: "2"
+- parameters:
+    weight: "200"
+`),
+	)
+
+	DescribeErrorCases(
+		core_mesh.NewDynamicConfigResource,
+		ErrorCases("empty spec", []validators.Violation{
+			{Field: "key", Message: "must be defined"},
+			{Field: "scope", Message: "must be defined"},
+			{Field: "configs", Message: "must not be empty"},
+		}, `{}`),
+		ErrorCase("unknown side", validators.Violation{
+			Field:   "configs[0].side",
+			Message: `unknown side "server". Allowed values: provider, consumer`,
+		}, `
+key: shop-detail
+scope: application
+configs:
+- side: server
+`),
+	)
+})
@@ -0,0 +1,55 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh
+
+import (
+	"net"
+	"strconv"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+)
+
+func (es *ExternalServiceResource) IsReachableFromZone(zone string) bool {
+	return es.Spec.GetTags()[mesh_proto.ZoneTag] == "" || es.Spec.GetTags()[mesh_proto.ZoneTag] == zone
+}
+
+func (es *ExternalServiceResource) GetHost() string {
+	host, _, err := net.SplitHostPort(es.Spec.GetNetworking().GetAddress())
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+func (es *ExternalServiceResource) GetPort() string {
+	_, port, err := net.SplitHostPort(es.Spec.GetNetworking().GetAddress())
+	if err != nil {
+		return ""
+	}
+	return port
+}
+
+func (es *ExternalServiceResource) GetPortUInt32() uint32 {
+	port, err := strconv.ParseUint(es.GetPort(), 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint32(port)
+}
@@ -0,0 +1,136 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	system_proto "github.com/apache/dubbo-kubernetes/api/system/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+func (es *ExternalServiceResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(validateExternalServiceNetworking(validators.RootedAt("networking"), es.Spec.GetNetworking()))
+	err.Add(ValidateTags(validators.RootedAt("tags"), es.Spec.GetTags(), ValidateTagsOpts{
+		RequireService: true,
+		ExtraTagsValidators: []TagsValidatorFunc{
+			validateExternalServiceProtocol,
+		},
+	}))
+	return err.OrNil()
+}
+
+func validateExternalServiceNetworking(path validators.PathBuilder, networking *mesh_proto.ExternalService_Networking) validators.ValidationError {
+	var err validators.ValidationError
+	if networking == nil {
+		err.AddViolationAt(path, "cannot be empty")
+		return err
+	}
+
+	host, port, splitErr := net.SplitHostPort(networking.GetAddress())
+	if splitErr != nil {
+		err.AddViolationAt(path.Field("address"), "address has to be in the host:port format")
+	} else {
+		if host == "" {
+			err.AddViolationAt(path.Field("address"), "host cannot be empty")
+		}
+		portNumber, parseErr := strconv.ParseUint(port, 10, 32)
+		if parseErr != nil {
+			err.AddViolationAt(path.Field("address"), "port has to be a number")
+		} else {
+			err.Add(ValidatePort(path.Field("address"), uint32(portNumber)))
+		}
+	}
+
+	err.Add(validateExternalServiceTLS(path.Field("tls"), networking.GetTls()))
+	return err
+}
+
+func validateExternalServiceTLS(path validators.PathBuilder, tls *mesh_proto.ExternalService_Networking_TLS) validators.ValidationError {
+	var err validators.ValidationError
+	if tls == nil {
+		return err
+	}
+
+	if !tls.GetEnabled() {
+		if tls.GetCaCert() != nil || tls.GetClientCert() != nil || tls.GetClientKey() != nil || tls.GetServerName() != nil {
+			err.AddViolationAt(path.Field("enabled"), "has to be true when the TLS settings are defined")
+		}
+		return err
+	}
+
+	if tls.GetCaCert() != nil {
+		err.Add(validateDataSource(path.Field("caCert"), tls.GetCaCert()))
+	}
+	if tls.GetClientCert() != nil {
+		err.Add(validateDataSource(path.Field("clientCert"), tls.GetClientCert()))
+	}
+	if tls.GetClientKey() != nil {
+		err.Add(validateDataSource(path.Field("clientKey"), tls.GetClientKey()))
+	}
+	if (tls.GetClientCert() == nil) != (tls.GetClientKey() == nil) {
+		err.AddViolationAt(path, "clientCert and clientKey have to be defined together")
+	}
+	if tls.GetServerName() != nil && tls.GetServerName().GetValue() == "" {
+		err.AddViolationAt(path.Field("serverName"), "cannot be empty")
+	}
+	return err
+}
+
+func validateExternalServiceProtocol(path validators.PathBuilder, tags map[string]string) validators.ValidationError {
+	var err validators.ValidationError
+	protocol, defined := tags[mesh_proto.ProtocolTag]
+	if !defined {
+		return err
+	}
+	if ParseProtocol(protocol) == ProtocolUnknown {
+		err.AddViolationAt(path.Key(mesh_proto.ProtocolTag), fmt.Sprintf("tag %q has an invalid value %q. %s", mesh_proto.ProtocolTag, protocol, AllowedValuesHint(SupportedProtocols.Strings()...)))
+	}
+	return err
+}
+
+func validateDataSource(path validators.PathBuilder, source *system_proto.DataSource) validators.ValidationError {
+	var err validators.ValidationError
+	switch source.GetType().(type) {
+	case *system_proto.DataSource_Secret:
+		if source.GetSecret() == "" {
+			err.AddViolationAt(path.Field("secret"), "cannot be empty")
+		}
+	case *system_proto.DataSource_Inline:
+		if len(source.GetInline().GetValue()) == 0 {
+			err.AddViolationAt(path.Field("inline"), "cannot be empty")
+		}
+	case *system_proto.DataSource_InlineString:
+		if source.GetInlineString() == "" {
+			err.AddViolationAt(path.Field("inlineString"), "cannot be empty")
+		}
+	case *system_proto.DataSource_File:
+		if source.GetFile() == "" {
+			err.AddViolationAt(path.Field("file"), "cannot be empty")
+		}
+	default:
+		err.AddViolationAt(path, "data source has to be chosen. Available sources: secret, file, inline, inlineString")
+	}
+	return err
+}
@@ -0,0 +1,174 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh
+
+import (
+	"net"
+	net_url "net/url"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+func (m *MeshResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(validateMtls(validators.RootedAt("mtls"), m.Spec.GetMtls()))
+	err.Add(validateRouting(validators.RootedAt("routing"), m.Spec))
+	err.Add(validateTracing(validators.RootedAt("tracing"), m.Spec.GetTracing()))
+	err.Add(validateLogging(validators.RootedAt("logging"), m.Spec.GetLogging()))
+	return err.OrNil()
+}
+
+func validateRouting(path validators.PathBuilder, mesh *mesh_proto.Mesh) validators.ValidationError {
+	var verr validators.ValidationError
+	if mesh.GetRouting().GetZoneEgress() && mesh.GetMtls().GetEnabledBackend() == "" {
+		verr.AddViolationAt(path.Field("zoneEgress"), "mTLS has to be enabled to route traffic through ZoneEgress")
+	}
+	return verr
+}
+
+func validateMtls(path validators.PathBuilder, mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
+	var verr validators.ValidationError
+	if mtls == nil {
+		return verr
+	}
+	usedNames := map[string]bool{}
+	for i, backend := range mtls.GetBackends() {
+		if usedNames[backend.GetName()] {
+			verr.AddViolationAt(path.Field("backends").Index(i).Field("name"), `"name" must be unique`)
+		}
+		usedNames[backend.GetName()] = true
+		verr.Add(validateCaBackend(path.Field("backends").Index(i), backend))
+	}
+	if mtls.GetEnabledBackend() != "" && !usedNames[mtls.GetEnabledBackend()] {
+		verr.AddViolationAt(path.Field("enabledBackend"), "has to be set to one of the backends in the mesh")
+	}
+	return verr
+}
+
+func validateCaBackend(path validators.PathBuilder, backend *mesh_proto.CertificateAuthorityBackend) validators.ValidationError {
+	var verr validators.ValidationError
+	if backend.GetName() == "" {
+		verr.AddViolationAt(path.Field("name"), "cannot be empty")
+	}
+	if backend.GetType() == "" {
+		verr.AddViolationAt(path.Field("type"), "cannot be empty")
+	}
+	if expiration := backend.GetDpCert().GetRotation().GetExpiration(); expiration != "" {
+		if _, err := ParseDuration(expiration); err != nil {
+			verr.AddViolationAt(path.Field("dpCert").Field("rotation").Field("expiration"), "has to be a valid format")
+		}
+	}
+	return verr
+}
+
+func validateTracing(path validators.PathBuilder, tracing *mesh_proto.Tracing) validators.ValidationError {
+	var verr validators.ValidationError
+	if tracing == nil {
+		return verr
+	}
+	usedNames := map[string]bool{}
+	for i, backend := range tracing.GetBackends() {
+		if usedNames[backend.GetName()] {
+			verr.AddViolationAt(path.Field("backends").Index(i).Field("name"), `"name" must be unique`)
+		}
+		usedNames[backend.GetName()] = true
+		verr.Add(validateTracingBackend(path.Field("backends").Index(i), backend))
+	}
+	if tracing.GetDefaultBackend() != "" && !usedNames[tracing.GetDefaultBackend()] {
+		verr.AddViolationAt(path.Field("defaultBackend"), "has to be set to one of the backends in the mesh")
+	}
+	return verr
+}
+
+func validateTracingBackend(path validators.PathBuilder, backend *mesh_proto.TracingBackend) validators.ValidationError {
+	var verr validators.ValidationError
+	if backend.GetName() == "" {
+		verr.AddViolationAt(path.Field("name"), "cannot be empty")
+	}
+	if sampling := backend.GetSampling(); sampling != nil && (sampling.GetValue() < 0 || sampling.GetValue() > 100) {
+		verr.AddViolationAt(path.Field("sampling"), validators.HasToBeInPercentageRange)
+	}
+	switch backend.GetType() {
+	case mesh_proto.TracingZipkinType:
+		cfg := &mesh_proto.ZipkinTracingBackendConfig{}
+		if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+			verr.AddViolationAt(path.Field("conf"), err.Error())
+			return verr
+		}
+		if url, err := net_url.ParseRequestURI(cfg.GetUrl()); err != nil || (url.Scheme != "http" && url.Scheme != "https") {
+			verr.AddViolationAt(path.Field("conf").Field("url"), "has to be a valid http or https url")
+		}
+		switch cfg.GetApiVersion() {
+		case "", "httpJson", "httpProto":
+		default:
+			verr.AddViolationAt(path.Field("conf").Field("apiVersion"), `has to be either "httpJson" or "httpProto"`)
+		}
+	default:
+		verr.AddViolationAt(path.Field("type"), `has to be "zipkin"`)
+	}
+	return verr
+}
+
+func validateLogging(path validators.PathBuilder, logging *mesh_proto.Logging) validators.ValidationError {
+	var verr validators.ValidationError
+	if logging == nil {
+		return verr
+	}
+	usedNames := map[string]bool{}
+	for i, backend := range logging.GetBackends() {
+		if usedNames[backend.GetName()] {
+			verr.AddViolationAt(path.Field("backends").Index(i).Field("name"), `"name" must be unique`)
+		}
+		usedNames[backend.GetName()] = true
+		verr.Add(validateLoggingBackend(path.Field("backends").Index(i), backend))
+	}
+	if logging.GetDefaultBackend() != "" && !usedNames[logging.GetDefaultBackend()] {
+		verr.AddViolationAt(path.Field("defaultBackend"), "has to be set to one of the backends in the mesh")
+	}
+	return verr
+}
+
+func validateLoggingBackend(path validators.PathBuilder, backend *mesh_proto.LoggingBackend) validators.ValidationError {
+	var verr validators.ValidationError
+	if backend.GetName() == "" {
+		verr.AddViolationAt(path.Field("name"), "cannot be empty")
+	}
+	switch backend.GetType() {
+	case mesh_proto.LoggingFileType:
+		cfg := &mesh_proto.FileLoggingBackendConfig{}
+		if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+			verr.AddViolationAt(path.Field("conf"), err.Error())
+		} else if cfg.GetPath() == "" {
+			verr.AddViolationAt(path.Field("conf").Field("path"), "cannot be empty")
+		}
+	case mesh_proto.LoggingTcpType:
+		cfg := &mesh_proto.TcpLoggingBackendConfig{}
+		if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+			verr.AddViolationAt(path.Field("conf"), err.Error())
+		} else if _, _, err := net.SplitHostPort(cfg.GetAddress()); err != nil {
+			verr.AddViolationAt(path.Field("conf").Field("address"), "has to be a valid address in the host:port format")
+		}
+	default:
+		verr.AddViolationAt(path.Field("type"), `has to be either "file" or "tcp"`)
+	}
+	return verr
+}
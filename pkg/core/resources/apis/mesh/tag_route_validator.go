@@ -0,0 +1,49 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+func (r *TagRouteResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(validators.ValidateStringDefined(validators.RootedAt("key"), r.Spec.GetKey()))
+	err.Add(r.validateTags(validators.RootedAt("tags"), r.Spec.GetTags()))
+	return err.OrNil()
+}
+
+func (r *TagRouteResource) validateTags(path validators.PathBuilder, tags []*mesh_proto.Tag) validators.ValidationError {
+	var err validators.ValidationError
+	if len(tags) == 0 {
+		err.AddViolationAt(path, validators.MustNotBeEmpty)
+	}
+	names := map[string]struct{}{}
+	for i, tag := range tags {
+		err.Add(validators.ValidateStringDefined(path.Index(i).Field("name"), tag.GetName()))
+		if _, ok := names[tag.GetName()]; ok && tag.GetName() != "" {
+			err.AddViolationAt(path.Index(i).Field("name"), "must be unique")
+		}
+		names[tag.GetName()] = struct{}{}
+		for j, match := range tag.GetMatch() {
+			err.Add(validators.ValidateStringDefined(path.Index(i).Field("match").Index(j).Field("key"), match.GetKey()))
+		}
+	}
+	return err
+}
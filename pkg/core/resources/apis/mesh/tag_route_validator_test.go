@@ -0,0 +1,73 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mesh_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	. "github.com/apache/dubbo-kubernetes/pkg/test/resources"
+)
+
+var _ = Describe("TagRoute", func() {
+	DescribeValidCases(
+		core_mesh.NewTagRouteResource,
+		Entry("tags with matches", `
+key: shop-detail
+enabled: true
+force: false
+tags:
+- name: gray
+  match:
+  - key: env
+    value:
+      exact: gray
+- name: stable
+  addresses:
+  - 10.0.0.1:20880
+`),
+	)
+
+	DescribeErrorCases(
+		core_mesh.NewTagRouteResource,
+		ErrorCases("empty spec", []validators.Violation{
+			{Field: "key", Message: "must be defined"},
+			{Field: "tags", Message: "must not be empty"},
+		}, `{}`),
+		ErrorCases("invalid tags", []validators.Violation{
+			{Field: "tags[0].name", Message: "must be defined"},
+			{Field: "tags[2].name", Message: "must be unique"},
+			{Field: "tags[2].match[0].key", Message: "must be defined"},
+		}, `
+key: shop-detail
+tags:
+- match:
+  - key: env
+    value:
+      exact: gray
+- name: gray
+- name: gray
+  match:
+  - value:
+      exact: gray
+`),
+	)
+})
@@ -19,6 +19,7 @@ package mesh
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"sort"
 	"strings"
@@ -34,6 +35,7 @@ import (
 
 import (
 	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
 	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
 	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
 )
@@ -339,3 +341,40 @@ func validateName(value string) validators.ValidationError {
 
 	return err
 }
+
+// validateRuleScope checks the scope of traffic rules which are bound either to
+// an application or to a service.
+func validateRuleScope(path validators.PathBuilder, scope string) validators.ValidationError {
+	var err validators.ValidationError
+	switch scope {
+	case consts.Application, consts.Service:
+	case "":
+		err.AddViolationAt(path, validators.MustBeDefined)
+	default:
+		err.AddViolationAt(path, fmt.Sprintf("unknown scope %q. %s", scope, AllowedValuesHint(consts.Application, consts.Service)))
+	}
+	return err
+}
+
+// validateIPBlocks validates a list of IP addresses or CIDRs.
+func validateIPBlocks(path validators.PathBuilder, blocks []string) validators.ValidationError {
+	var err validators.ValidationError
+	for i, block := range blocks {
+		if net.ParseIP(block) != nil {
+			continue
+		}
+		if _, _, cidrErr := net.ParseCIDR(block); cidrErr != nil {
+			err.AddViolationAt(path.Index(i), fmt.Sprintf("%q must be a valid IP address or CIDR", block))
+		}
+	}
+	return err
+}
+
+// validateExtendKeys validates the keys of the extended identities of a policy.
+func validateExtendKeys[T interface{ GetKey() string }](path validators.PathBuilder, extends []T) validators.ValidationError {
+	var err validators.ValidationError
+	for i, extend := range extends {
+		err.Add(validators.ValidateStringDefined(path.Index(i).Field("key"), extend.GetKey()))
+	}
+	return err
+}
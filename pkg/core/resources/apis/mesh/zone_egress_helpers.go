@@ -39,6 +39,13 @@ func (r *ZoneEgressResource) UsesInboundInterface(address net.IP, port uint32) b
 	return false
 }
 
+func (r *ZoneEgressResource) IsRemoteEgress(localZone string) bool {
+	if r.Spec.GetZone() == "" || r.Spec.GetZone() == localZone {
+		return false
+	}
+	return true
+}
+
 func (r *ZoneEgressResource) IsIPv6() bool {
 	if r == nil {
 		return false
@@ -14,6 +14,224 @@ import (
 	"github.com/apache/dubbo-kubernetes/pkg/core/resources/registry"
 )
 
+const (
+	AuthenticationPolicyType model.ResourceType = "AuthenticationPolicy"
+)
+
+var _ model.Resource = &AuthenticationPolicyResource{}
+
+type AuthenticationPolicyResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.AuthenticationPolicy
+}
+
+func NewAuthenticationPolicyResource() *AuthenticationPolicyResource {
+	return &AuthenticationPolicyResource{
+		Spec: &mesh_proto.AuthenticationPolicy{},
+	}
+}
+
+func (t *AuthenticationPolicyResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *AuthenticationPolicyResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *AuthenticationPolicyResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *AuthenticationPolicyResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.AuthenticationPolicy)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.AuthenticationPolicy{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *AuthenticationPolicyResource) Descriptor() model.ResourceTypeDescriptor {
+	return AuthenticationPolicyResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &AuthenticationPolicyResourceList{}
+
+type AuthenticationPolicyResourceList struct {
+	Items      []*AuthenticationPolicyResource
+	Pagination model.Pagination
+}
+
+func (l *AuthenticationPolicyResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *AuthenticationPolicyResourceList) GetItemType() model.ResourceType {
+	return AuthenticationPolicyType
+}
+
+func (l *AuthenticationPolicyResourceList) NewItem() model.Resource {
+	return NewAuthenticationPolicyResource()
+}
+
+func (l *AuthenticationPolicyResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*AuthenticationPolicyResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*AuthenticationPolicyResource)(nil), r)
+	}
+}
+
+func (l *AuthenticationPolicyResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *AuthenticationPolicyResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var AuthenticationPolicyResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                AuthenticationPolicyType,
+	Resource:            NewAuthenticationPolicyResource(),
+	ResourceList:        &AuthenticationPolicyResourceList{},
+	ReadOnly:            false,
+	AdminOnly:           false,
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag,
+	WsPath:              "authenticationpolicies",
+	DubboctlArg:         "authenticationpolicy",
+	DubboctlListArg:     "authenticationpolicies",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	SingularDisplayName: "Authentication Policy",
+	PluralDisplayName:   "Authentication Policies",
+	IsExperimental:      false,
+}
+
+func init() {
+	registry.RegisterType(AuthenticationPolicyResourceTypeDescriptor)
+}
+
+const (
+	AuthorizationPolicyType model.ResourceType = "AuthorizationPolicy"
+)
+
+var _ model.Resource = &AuthorizationPolicyResource{}
+
+type AuthorizationPolicyResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.AuthorizationPolicy
+}
+
+func NewAuthorizationPolicyResource() *AuthorizationPolicyResource {
+	return &AuthorizationPolicyResource{
+		Spec: &mesh_proto.AuthorizationPolicy{},
+	}
+}
+
+func (t *AuthorizationPolicyResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *AuthorizationPolicyResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *AuthorizationPolicyResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *AuthorizationPolicyResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.AuthorizationPolicy)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.AuthorizationPolicy{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *AuthorizationPolicyResource) Descriptor() model.ResourceTypeDescriptor {
+	return AuthorizationPolicyResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &AuthorizationPolicyResourceList{}
+
+type AuthorizationPolicyResourceList struct {
+	Items      []*AuthorizationPolicyResource
+	Pagination model.Pagination
+}
+
+func (l *AuthorizationPolicyResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *AuthorizationPolicyResourceList) GetItemType() model.ResourceType {
+	return AuthorizationPolicyType
+}
+
+func (l *AuthorizationPolicyResourceList) NewItem() model.Resource {
+	return NewAuthorizationPolicyResource()
+}
+
+func (l *AuthorizationPolicyResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*AuthorizationPolicyResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*AuthorizationPolicyResource)(nil), r)
+	}
+}
+
+func (l *AuthorizationPolicyResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *AuthorizationPolicyResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var AuthorizationPolicyResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                AuthorizationPolicyType,
+	Resource:            NewAuthorizationPolicyResource(),
+	ResourceList:        &AuthorizationPolicyResourceList{},
+	ReadOnly:            false,
+	AdminOnly:           false,
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag,
+	WsPath:              "authorizationpolicies",
+	DubboctlArg:         "authorizationpolicy",
+	DubboctlListArg:     "authorizationpolicies",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	SingularDisplayName: "Authorization Policy",
+	PluralDisplayName:   "Authorization Policies",
+	IsExperimental:      false,
+}
+
+func init() {
+	registry.RegisterType(AuthorizationPolicyResourceTypeDescriptor)
+}
+
 const (
 	ConditionRouteType model.ResourceType = "ConditionRoute"
 )
@@ -450,6 +668,115 @@ func init() {
 	registry.RegisterType(DynamicConfigResourceTypeDescriptor)
 }
 
+const (
+	ExternalServiceType model.ResourceType = "ExternalService"
+)
+
+var _ model.Resource = &ExternalServiceResource{}
+
+type ExternalServiceResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.ExternalService
+}
+
+func NewExternalServiceResource() *ExternalServiceResource {
+	return &ExternalServiceResource{
+		Spec: &mesh_proto.ExternalService{},
+	}
+}
+
+func (t *ExternalServiceResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *ExternalServiceResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *ExternalServiceResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *ExternalServiceResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.ExternalService)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.ExternalService{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *ExternalServiceResource) Descriptor() model.ResourceTypeDescriptor {
+	return ExternalServiceResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &ExternalServiceResourceList{}
+
+type ExternalServiceResourceList struct {
+	Items      []*ExternalServiceResource
+	Pagination model.Pagination
+}
+
+func (l *ExternalServiceResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *ExternalServiceResourceList) GetItemType() model.ResourceType {
+	return ExternalServiceType
+}
+
+func (l *ExternalServiceResourceList) NewItem() model.Resource {
+	return NewExternalServiceResource()
+}
+
+func (l *ExternalServiceResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*ExternalServiceResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*ExternalServiceResource)(nil), r)
+	}
+}
+
+func (l *ExternalServiceResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *ExternalServiceResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var ExternalServiceResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                ExternalServiceType,
+	Resource:            NewExternalServiceResource(),
+	ResourceList:        &ExternalServiceResourceList{},
+	ReadOnly:            false,
+	AdminOnly:           false,
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag,
+	WsPath:              "externalservices",
+	DubboctlArg:         "externalservice",
+	DubboctlListArg:     "externalservices",
+	AllowToInspect:      true,
+	IsPolicy:            false,
+	SingularDisplayName: "External Service",
+	PluralDisplayName:   "External Services",
+	IsExperimental:      false,
+}
+
+func init() {
+	registry.RegisterType(ExternalServiceResourceTypeDescriptor)
+}
+
 const (
 	MappingType model.ResourceType = "Mapping"
 )
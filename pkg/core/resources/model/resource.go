@@ -32,6 +32,7 @@ import (
 )
 
 import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
 	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
 	config_core "github.com/apache/dubbo-kubernetes/pkg/config/core"
 )
@@ -519,6 +520,7 @@ type ResourceWithAddress interface {
 }
 
 type PolicyItem interface {
+	GetTargetRef() common_api.TargetRef
 	GetDefault() interface{}
 }
 
@@ -528,6 +530,7 @@ type TransformDefaultAfterMerge interface {
 
 type Policy interface {
 	ResourceSpec
+	GetTargetRef() common_api.TargetRef
 }
 
 type PolicyWithToList interface {
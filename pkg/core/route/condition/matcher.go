@@ -0,0 +1,78 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package condition
+
+import (
+	"strings"
+)
+
+// Getter returns the value of a key and whether the key is present.
+type Getter func(key string) (string, bool)
+
+// MatchWhen reports whether the request described by get matches the "when" side.
+func (r *Rule) MatchWhen(get Getter) bool {
+	return matchAll(r.When, get)
+}
+
+// MatchThen reports whether the provider described by get matches the "then" side.
+// A rule with an empty "then" side does not select any provider.
+func (r *Rule) MatchThen(get Getter) bool {
+	if len(r.Then) == 0 {
+		return false
+	}
+	return matchAll(r.Then, get)
+}
+
+func matchAll(matchers []*Matcher, get Getter) bool {
+	for _, matcher := range matchers {
+		if !matcher.Match(get) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match reports whether the value of the matcher's key matches any of its values.
+// A missing key never matches a "=" matcher and always matches a "!=" matcher.
+func (m *Matcher) Match(get Getter) bool {
+	value, ok := get(m.Key)
+	if !ok {
+		return m.Negative
+	}
+	for _, pattern := range m.Values {
+		if MatchGlob(pattern, value) {
+			return !m.Negative
+		}
+	}
+	return m.Negative
+}
+
+// MatchGlob matches a value against a pattern containing at most one "*".
+func MatchGlob(pattern, value string) bool {
+	if pattern == "*" {
+		return true
+	}
+	idx := strings.Index(pattern, "*")
+	if idx < 0 {
+		return pattern == value
+	}
+	prefix, suffix := pattern[:idx], pattern[idx+1:]
+	return len(value) >= len(prefix)+len(suffix) &&
+		strings.HasPrefix(value, prefix) &&
+		strings.HasSuffix(value, suffix)
+}
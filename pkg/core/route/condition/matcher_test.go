@@ -0,0 +1,92 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package condition_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/route/condition"
+)
+
+var _ = Describe("Rule", func() {
+	getter := func(values map[string]string) condition.Getter {
+		return func(key string) (string, bool) {
+			value, ok := values[key]
+			return value, ok
+		}
+	}
+
+	DescribeTable("MatchWhen()",
+		func(given string, values map[string]string, expected bool) {
+			// given
+			rule, err := condition.Parse(given)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			matched := rule.MatchWhen(getter(values))
+
+			// then
+			Expect(matched).To(Equal(expected))
+		},
+		Entry("empty when side", "=> host=10.0.0.1", nil, true),
+		Entry("exact value", "method=get => host=a", map[string]string{"method": "get"}, true),
+		Entry("prefix pattern", "method=get* => host=a", map[string]string{"method": "getUser"}, true),
+		Entry("suffix pattern", "method=*User => host=a", map[string]string{"method": "getUser"}, true),
+		Entry("one of values", "method=list,get => host=a", map[string]string{"method": "get"}, true),
+		Entry("different value", "method=get => host=a", map[string]string{"method": "set"}, false),
+		Entry("missing key", "method=get => host=a", nil, false),
+		Entry("negative matcher", "method!=get => host=a", map[string]string{"method": "set"}, true),
+		Entry("negative matcher matching", "method!=get* => host=a", map[string]string{"method": "getUser"}, false),
+		Entry("negative matcher with missing key", "method!=get => host=a", nil, true),
+		Entry("all matchers", "method=get & arguments[0]=foo => host=a", map[string]string{"method": "get", "arguments[0]": "bar"}, false),
+	)
+
+	DescribeTable("MatchThen()",
+		func(given string, values map[string]string, expected bool) {
+			// given
+			rule, err := condition.Parse(given)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			matched := rule.MatchThen(getter(values))
+
+			// then
+			Expect(matched).To(Equal(expected))
+		},
+		Entry("matching provider", "=> host=10.0.0.*", map[string]string{"host": "10.0.0.1"}, true),
+		Entry("other provider", "=> host=10.0.0.*", map[string]string{"host": "10.0.1.1"}, false),
+		Entry("empty then side", "method=get =>", map[string]string{"host": "10.0.0.1"}, false),
+	)
+})
+
+var _ = DescribeTable("MatchGlob()",
+	func(pattern, value string, expected bool) {
+		Expect(condition.MatchGlob(pattern, value)).To(Equal(expected))
+	},
+	Entry("wildcard", "*", "anything", true),
+	Entry("exact", "foo", "foo", true),
+	Entry("exact mismatch", "foo", "foobar", false),
+	Entry("prefix", "foo*", "foobar", true),
+	Entry("suffix", "*bar", "foobar", true),
+	Entry("prefix and suffix", "f*r", "foobar", true),
+	Entry("overlapping prefix and suffix", "ab*ba", "aba", false),
+)
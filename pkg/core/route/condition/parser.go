@@ -0,0 +1,182 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package condition parses the conditions of a ConditionRoute, e.g.
+// "method=get* & arguments[0]=foo => host!=10.0.0.1,10.0.0.2".
+//
+// A condition consists of a "when" side matching the request and a "then" side
+// selecting the providers, separated by "=>". Each side is a list of matchers
+// joined by "&", and each matcher is "key=values" or "key!=values" where the
+// values are separated by ",". A condition without "=>" only has a "then" side.
+package condition
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+const (
+	arrow = "=>"
+	and   = "&"
+	comma = ","
+)
+
+var (
+	// plain keys are url parameters such as "method", "host" or "remote.application"
+	plainKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`)
+	argumentsKey   = regexp.MustCompile(`^arguments\[\d+\]$`)
+	attachmentsKey = regexp.MustCompile(`^attachments\[[a-zA-Z0-9_.\-]+\]$`)
+	invalidValue   = regexp.MustCompile(`[\s=!&]`)
+)
+
+type Rule struct {
+	// When is empty if the rule applies to every request
+	When []*Matcher
+	// Then is empty if no provider should be selected
+	Then []*Matcher
+}
+
+type Matcher struct {
+	Key string
+	// Negative is true for "!=" matchers
+	Negative bool
+	Values   []string
+}
+
+// Parse parses a condition and returns a validators.ValidationError describing
+// every problem found.
+func Parse(condition string) (*Rule, error) {
+	rule, err := parse(validators.Root(), condition)
+	if err.HasViolations() {
+		return nil, err.OrNil()
+	}
+	return rule, nil
+}
+
+// Validate validates a condition, reporting violations under the given path.
+func Validate(path validators.PathBuilder, condition string) validators.ValidationError {
+	_, err := parse(path, condition)
+	return err
+}
+
+func parse(path validators.PathBuilder, condition string) (*Rule, validators.ValidationError) {
+	var err validators.ValidationError
+	condition = strings.TrimSpace(condition)
+	if condition == "" {
+		err.AddViolationAt(path, validators.MustNotBeEmpty)
+		return nil, err
+	}
+
+	var whenSide, thenSide string
+	switch strings.Count(condition, arrow) {
+	case 0:
+		thenSide = condition
+	case 1:
+		idx := strings.Index(condition, arrow)
+		whenSide = strings.TrimSpace(condition[:idx])
+		thenSide = strings.TrimSpace(condition[idx+len(arrow):])
+	default:
+		err.AddViolationAt(path, fmt.Sprintf("must contain at most one %q", arrow))
+		return nil, err
+	}
+	if whenSide == "" && thenSide == "" {
+		err.AddViolationAt(path, "when and then must not be both empty")
+		return nil, err
+	}
+
+	rule := &Rule{}
+	rule.When = parseSide(path.Field("when"), whenSide, &err)
+	rule.Then = parseSide(path.Field("then"), thenSide, &err)
+	return rule, err
+}
+
+func parseSide(path validators.PathBuilder, side string, err *validators.ValidationError) []*Matcher {
+	if side == "" {
+		return nil
+	}
+	var matchers []*Matcher
+	for i, expr := range strings.Split(side, and) {
+		if matcher := parseMatcher(path.Index(i), strings.TrimSpace(expr), err); matcher != nil {
+			matchers = append(matchers, matcher)
+		}
+	}
+	return matchers
+}
+
+func parseMatcher(path validators.PathBuilder, expr string, err *validators.ValidationError) *Matcher {
+	if expr == "" {
+		err.AddViolationAt(path, validators.MustNotBeEmpty)
+		return nil
+	}
+	idx := strings.Index(expr, "=")
+	if idx < 0 {
+		err.AddViolationAt(path, fmt.Sprintf("%q must be in the format of key=value or key!=value", expr))
+		return nil
+	}
+
+	matcher := &Matcher{}
+	key := expr[:idx]
+	if strings.HasSuffix(key, "!") {
+		matcher.Negative = true
+		key = strings.TrimSuffix(key, "!")
+	}
+	matcher.Key = strings.TrimSpace(key)
+	valid := true
+	switch {
+	case matcher.Key == "":
+		err.AddViolationAt(path.Field("key"), validators.MustBeDefined)
+		valid = false
+	case !isKnownKey(matcher.Key):
+		err.AddViolationAt(path.Field("key"), fmt.Sprintf("unknown key %q", matcher.Key))
+		valid = false
+	}
+
+	values := strings.TrimSpace(expr[idx+1:])
+	if values == "" {
+		err.AddViolationAt(path.Field("values"), validators.MustNotBeEmpty)
+		return nil
+	}
+	for j, value := range strings.Split(values, comma) {
+		value = strings.TrimSpace(value)
+		switch {
+		case value == "":
+			err.AddViolationAt(path.Field("values").Index(j), validators.MustNotBeEmpty)
+			valid = false
+		case invalidValue.MatchString(value):
+			err.AddViolationAt(path.Field("values").Index(j), fmt.Sprintf("invalid value %q", value))
+			valid = false
+		default:
+			matcher.Values = append(matcher.Values, value)
+		}
+	}
+	if !valid {
+		return nil
+	}
+	return matcher
+}
+
+func isKnownKey(key string) bool {
+	if strings.ContainsAny(key, "[]") {
+		return argumentsKey.MatchString(key) || attachmentsKey.MatchString(key)
+	}
+	return plainKeyRegexp.MatchString(key)
+}
@@ -0,0 +1,106 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package condition_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/route/condition"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+var _ = Describe("Parse()", func() {
+	DescribeTable("should parse valid conditions",
+		func(given string, expected *condition.Rule) {
+			// when
+			rule, err := condition.Parse(given)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rule).To(Equal(expected))
+		},
+		Entry("when and then", "method=get* => host!=10.0.0.1,10.0.0.2", &condition.Rule{
+			When: []*condition.Matcher{{Key: "method", Values: []string{"get*"}}},
+			Then: []*condition.Matcher{{Key: "host", Negative: true, Values: []string{"10.0.0.1", "10.0.0.2"}}},
+		}),
+		Entry("multiple matchers with spaces", " method = get & arguments[0] = foo => region = hangzhou ", &condition.Rule{
+			When: []*condition.Matcher{
+				{Key: "method", Values: []string{"get"}},
+				{Key: "arguments[0]", Values: []string{"foo"}},
+			},
+			Then: []*condition.Matcher{{Key: "region", Values: []string{"hangzhou"}}},
+		}),
+		Entry("empty when", "=> host=10.0.0.1", &condition.Rule{
+			Then: []*condition.Matcher{{Key: "host", Values: []string{"10.0.0.1"}}},
+		}),
+		Entry("empty then", "attachments[user]=tester =>", &condition.Rule{
+			When: []*condition.Matcher{{Key: "attachments[user]", Values: []string{"tester"}}},
+		}),
+		Entry("without arrow", "host=10.0.0.1", &condition.Rule{
+			Then: []*condition.Matcher{{Key: "host", Values: []string{"10.0.0.1"}}},
+		}),
+	)
+
+	DescribeTable("should report violations of invalid conditions",
+		func(given string, expected []validators.Violation) {
+			// when
+			err := condition.Validate(validators.RootedAt("conditions").Index(0), given)
+
+			// then
+			Expect(err.Violations).To(Equal(expected))
+		},
+		Entry("empty condition", " ", []validators.Violation{
+			{Field: "conditions[0]", Message: "must not be empty"},
+		}),
+		Entry("empty sides", "=>", []validators.Violation{
+			{Field: "conditions[0]", Message: "when and then must not be both empty"},
+		}),
+		Entry("multiple arrows", "a=b => c=d => e=f", []validators.Violation{
+			{Field: "conditions[0]", Message: `must contain at most one "=>"`},
+		}),
+		Entry("empty matcher", "method=get & => host=a", []validators.Violation{
+			{Field: "conditions[0].when[1]", Message: "must not be empty"},
+		}),
+		Entry("matcher without operator", "method => host=a", []validators.Violation{
+			{Field: "conditions[0].when[0]", Message: `"method" must be in the format of key=value or key!=value`},
+		}),
+		Entry("unknown keys", "arguments[x]=1 & foo[bar]=2 => =a", []validators.Violation{
+			{Field: "conditions[0].when[0].key", Message: `unknown key "arguments[x]"`},
+			{Field: "conditions[0].when[1].key", Message: `unknown key "foo[bar]"`},
+			{Field: "conditions[0].then[0].key", Message: "must be defined"},
+		}),
+		Entry("malformed values", "method= => host=a,,b c", []validators.Violation{
+			{Field: "conditions[0].when[0].values", Message: "must not be empty"},
+			{Field: "conditions[0].then[0].values[1]", Message: "must not be empty"},
+			{Field: "conditions[0].then[0].values[2]", Message: `invalid value "b c"`},
+		}),
+	)
+
+	It("should return a validation error", func() {
+		// when
+		_, err := condition.Parse("method==get")
+
+		// then
+		Expect(validators.IsValidationError(err)).To(BeTrue())
+		Expect(err).To(MatchError(`then[0].values[0]: invalid value "=get"`))
+	})
+})
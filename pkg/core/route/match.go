@@ -0,0 +1,120 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package route
+
+import (
+	"net"
+	"regexp"
+	"strings"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/route/condition"
+)
+
+// MatchString reports whether a value matches a StringMatch. Every non-empty
+// field of the StringMatch has to match; a StringMatch without any field set
+// matches everything.
+func MatchString(m *mesh_proto.StringMatch, value string) bool {
+	if m == nil {
+		return true
+	}
+	if m.GetExact() != "" && value != m.GetExact() {
+		return false
+	}
+	if m.GetPrefix() != "" && !strings.HasPrefix(value, m.GetPrefix()) {
+		return false
+	}
+	if m.GetRegex() != "" {
+		re, err := regexp.Compile(m.GetRegex())
+		if err != nil || !re.MatchString(value) {
+			return false
+		}
+	}
+	if m.GetNoempty() != "" && value == "" {
+		return false
+	}
+	if m.GetEmpty() != "" && value != "" {
+		return false
+	}
+	if m.GetWildcard() != "" && !condition.MatchGlob(m.GetWildcard(), value) {
+		return false
+	}
+	return true
+}
+
+// MatchListString reports whether a value matches any of the StringMatches.
+func MatchListString(m *mesh_proto.ListStringMatch, value string) bool {
+	if m == nil || len(m.GetOneof()) == 0 {
+		return true
+	}
+	for _, item := range m.GetOneof() {
+		if MatchString(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchParams reports whether the params returned by get satisfy all the ParamMatches.
+func MatchParams(matches []*mesh_proto.ParamMatch, get condition.Getter) bool {
+	for _, match := range matches {
+		value, _ := get(match.GetKey())
+		if !MatchString(match.GetValue(), value) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchAddress reports whether an address, either "ip" or "ip:port", matches an AddressMatch.
+func MatchAddress(m *mesh_proto.AddressMatch, address string) bool {
+	if m == nil {
+		return true
+	}
+	ip := address
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		ip = host
+	}
+	if m.GetExact() != "" && m.GetExact() != address && m.GetExact() != ip {
+		return false
+	}
+	if m.GetWildcard() != "" && !condition.MatchGlob(m.GetWildcard(), address) && !condition.MatchGlob(m.GetWildcard(), ip) {
+		return false
+	}
+	if m.GetCird() != "" {
+		_, cidr, err := net.ParseCIDR(m.GetCird())
+		if err != nil || !cidr.Contains(net.ParseIP(ip)) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchAddresses reports whether an address is in the list, where "0.0.0.0"
+// stands for any address and an entry without port matches every port.
+func matchAddresses(addresses []string, ip string, port string) bool {
+	for _, address := range addresses {
+		switch address {
+		case anyHost, ip, net.JoinHostPort(ip, port):
+			return true
+		}
+	}
+	return false
+}
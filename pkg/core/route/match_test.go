@@ -0,0 +1,82 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package route_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/route"
+)
+
+var _ = Describe("Match", func() {
+	DescribeTable("MatchString()",
+		func(given *mesh_proto.StringMatch, value string, expected bool) {
+			Expect(route.MatchString(given, value)).To(Equal(expected))
+		},
+		Entry("nil match", nil, "foo", true),
+		Entry("exact", &mesh_proto.StringMatch{Exact: "foo"}, "foo", true),
+		Entry("exact mismatch", &mesh_proto.StringMatch{Exact: "foo"}, "bar", false),
+		Entry("prefix", &mesh_proto.StringMatch{Prefix: "fo"}, "foo", true),
+		Entry("prefix mismatch", &mesh_proto.StringMatch{Prefix: "ba"}, "foo", false),
+		Entry("regex", &mesh_proto.StringMatch{Regex: "^v[0-9]+$"}, "v12", true),
+		Entry("regex mismatch", &mesh_proto.StringMatch{Regex: "^v[0-9]+$"}, "v1.2", false),
+		Entry("invalid regex", &mesh_proto.StringMatch{Regex: "("}, "(", false),
+		Entry("wildcard", &mesh_proto.StringMatch{Wildcard: "*"}, "", true),
+		Entry("wildcard pattern", &mesh_proto.StringMatch{Wildcard: "gray*"}, "gray-1", true),
+		Entry("empty", &mesh_proto.StringMatch{Empty: "true"}, "", true),
+		Entry("empty mismatch", &mesh_proto.StringMatch{Empty: "true"}, "foo", false),
+		Entry("noempty", &mesh_proto.StringMatch{Noempty: "true"}, "foo", true),
+		Entry("noempty mismatch", &mesh_proto.StringMatch{Noempty: "true"}, "", false),
+	)
+
+	DescribeTable("MatchAddress()",
+		func(given *mesh_proto.AddressMatch, address string, expected bool) {
+			Expect(route.MatchAddress(given, address)).To(Equal(expected))
+		},
+		Entry("exact ip", &mesh_proto.AddressMatch{Exact: "10.0.0.1"}, "10.0.0.1:20880", true),
+		Entry("exact address", &mesh_proto.AddressMatch{Exact: "10.0.0.1:20880"}, "10.0.0.1:20880", true),
+		Entry("exact mismatch", &mesh_proto.AddressMatch{Exact: "10.0.0.2"}, "10.0.0.1:20880", false),
+		Entry("wildcard", &mesh_proto.AddressMatch{Wildcard: "10.0.*"}, "10.0.0.1:20880", true),
+		Entry("cidr", &mesh_proto.AddressMatch{Cird: "10.0.0.0/24"}, "10.0.0.1:20880", true),
+		Entry("cidr mismatch", &mesh_proto.AddressMatch{Cird: "10.0.1.0/24"}, "10.0.0.1", false),
+	)
+
+	It("should match all params", func() {
+		// given
+		params := map[string]string{"region": "hangzhou", "env": "gray"}
+		get := func(key string) (string, bool) {
+			value, ok := params[key]
+			return value, ok
+		}
+
+		// expect
+		Expect(route.MatchParams([]*mesh_proto.ParamMatch{
+			{Key: "region", Value: &mesh_proto.StringMatch{Exact: "hangzhou"}},
+			{Key: "env", Value: &mesh_proto.StringMatch{Noempty: "true"}},
+		}, get)).To(BeTrue())
+		Expect(route.MatchParams([]*mesh_proto.ParamMatch{
+			{Key: "region", Value: &mesh_proto.StringMatch{Exact: "hangzhou"}},
+			{Key: "zone", Value: &mesh_proto.StringMatch{Noempty: "true"}},
+		}, get)).To(BeFalse())
+	})
+})
@@ -0,0 +1,500 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package route evaluates the traffic rules of a mesh the same way the
+// proxyless SDKs do, which allows to simulate which providers a request
+// would reach without sending it.
+package route
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/route/condition"
+)
+
+const (
+	// TagKey is the attachment carrying the requested tag, and the param carrying the static tag of a provider.
+	TagKey = "dubbo.tag"
+	// ForceTagKey is the attachment which disables the fallback to untagged providers.
+	ForceTagKey = "dubbo.force.tag"
+	// DisabledKey is the override param which removes a provider from the candidates.
+	DisabledKey = "disabled"
+
+	anyHost = "0.0.0.0"
+)
+
+// Request describes the invocation to simulate.
+type Request struct {
+	// Application is the name of the consumer application
+	Application string `json:"application"`
+	// Address is the IP of the consumer
+	Address     string            `json:"address"`
+	Service     string            `json:"service"`
+	Version     string            `json:"version"`
+	Group       string            `json:"group"`
+	Method      string            `json:"method"`
+	Arguments   []string          `json:"arguments"`
+	Attachments map[string]string `json:"attachments"`
+}
+
+// Endpoint is an instance providing the requested service.
+type Endpoint struct {
+	Name        string            `json:"name"`
+	Application string            `json:"application"`
+	Address     string            `json:"address"`
+	Port        int64             `json:"port"`
+	Params      map[string]string `json:"params"`
+}
+
+// Rules are the traffic rules of the mesh. Rules which do not apply to the request are ignored.
+type Rules struct {
+	ConditionRoutes []*core_mesh.ConditionRouteResource
+	TagRoutes       []*core_mesh.TagRouteResource
+	DynamicConfigs  []*core_mesh.DynamicConfigResource
+}
+
+// Step records the effect of a single rule on the candidate endpoints.
+type Step struct {
+	Type    string `json:"type"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+	// Endpoints are the names of the candidates left after the step
+	Endpoints []string `json:"endpoints"`
+}
+
+type Result struct {
+	Endpoints []*Endpoint `json:"endpoints"`
+	// Parameters are the consumer params overridden by dynamic configs
+	Parameters map[string]string `json:"parameters"`
+	Steps      []*Step           `json:"steps"`
+}
+
+// Simulate selects the endpoints a request would reach. Rules are applied in
+// the order of the router chain of the SDKs: dynamic configs first, then the
+// tag routes of the provider applications, the condition route of the consumer
+// application and finally the condition route of the service.
+func Simulate(req *Request, endpoints []*Endpoint, rules Rules) *Result {
+	s := &simulation{
+		req: req,
+		result: &Result{
+			Parameters: map[string]string{},
+		},
+	}
+	candidates := make([]*Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		candidates = append(candidates, endpoint.clone())
+	}
+
+	candidates = s.applyDynamicConfigs(candidates, rules.DynamicConfigs)
+	candidates = s.applyTagRoutes(candidates, rules.TagRoutes)
+	candidates = s.applyConditionRoutes(candidates, rules.ConditionRoutes, consts.Application, req.Application)
+	candidates = s.applyConditionRoutes(candidates, rules.ConditionRoutes, consts.Service, "")
+
+	s.result.Endpoints = candidates
+	return s.result
+}
+
+type simulation struct {
+	req    *Request
+	result *Result
+}
+
+func (s *simulation) record(resourceType core_model.ResourceType, rule string, candidates []*Endpoint, format string, args ...interface{}) {
+	names := make([]string, 0, len(candidates))
+	for _, endpoint := range candidates {
+		names = append(names, endpoint.Name)
+	}
+	s.result.Steps = append(s.result.Steps, &Step{
+		Type:      string(resourceType),
+		Rule:      rule,
+		Message:   fmt.Sprintf(format, args...),
+		Endpoints: names,
+	})
+}
+
+func (s *simulation) applyDynamicConfigs(candidates []*Endpoint, configs []*core_mesh.DynamicConfigResource) []*Endpoint {
+	for _, config := range sortedByName(configs) {
+		spec := config.Spec
+		if !spec.GetEnabled() || !s.matchesKey(spec.GetScope(), spec.GetKey(), candidates) {
+			continue
+		}
+		var consumerParams, overridden int
+		for _, override := range spec.GetConfigs() {
+			if !override.GetEnabled() {
+				continue
+			}
+			if override.GetSide() != consts.ProviderSide && s.matchesConsumer(spec, override) {
+				if len(override.GetProviderAddresses()) == 0 {
+					for key, value := range override.GetParameters() {
+						s.result.Parameters[key] = value
+						consumerParams++
+					}
+					continue
+				}
+				for _, endpoint := range candidates {
+					if matchAddresses(override.GetProviderAddresses(), endpoint.Address, endpoint.port()) {
+						endpoint.override(override.GetParameters())
+						overridden++
+					}
+				}
+			}
+			if override.GetSide() != consts.ConsumerSide {
+				for _, endpoint := range candidates {
+					if s.matchesProvider(spec, override, endpoint) {
+						endpoint.override(override.GetParameters())
+						overridden++
+					}
+				}
+			}
+		}
+
+		var enabled []*Endpoint
+		for _, endpoint := range candidates {
+			if endpoint.Params[DisabledKey] != "true" {
+				enabled = append(enabled, endpoint)
+			}
+		}
+		s.record(core_mesh.DynamicConfigType, config.GetMeta().GetName(), enabled,
+			"overrode %d consumer param(s) and the params of %d endpoint(s), disabled %d endpoint(s)",
+			consumerParams, overridden, len(candidates)-len(enabled))
+		candidates = enabled
+	}
+	return candidates
+}
+
+// matchesKey reports whether a rule with the given scope and key applies to the request.
+// Application scoped rules apply to the consumer and to the provider applications.
+func (s *simulation) matchesKey(scope, key string, candidates []*Endpoint) bool {
+	if scope == consts.Service {
+		return s.matchesServiceKey(key)
+	}
+	if key == s.req.Application {
+		return true
+	}
+	for _, endpoint := range candidates {
+		if key == endpoint.Application {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesServiceKey matches a key in the format of "interface:version:group".
+func (s *simulation) matchesServiceKey(key string) bool {
+	parts := strings.SplitN(key, consts.Colon, 3)
+	for len(parts) < 3 {
+		parts = append(parts, "")
+	}
+	return parts[0] == s.req.Service && parts[1] == s.req.Version && parts[2] == s.req.Group
+}
+
+func (s *simulation) matchesConsumer(spec *mesh_proto.DynamicConfig, override *mesh_proto.OverrideConfig) bool {
+	// an application scoped rule only applies to that application
+	if spec.GetScope() != consts.Service && len(override.GetApplications()) == 0 && spec.GetKey() != s.req.Application {
+		return false
+	}
+	if len(override.GetAddresses()) > 0 && !matchAddresses(override.GetAddresses(), s.req.Address, "") {
+		return false
+	}
+	if len(override.GetApplications()) > 0 && !contains(override.GetApplications(), s.req.Application) {
+		return false
+	}
+	if len(override.GetServices()) > 0 && !contains(override.GetServices(), s.req.Service) {
+		return false
+	}
+	if match := override.GetMatch(); match != nil {
+		return MatchAddress(match.GetAddress(), s.req.Address) &&
+			MatchListString(match.GetApplication(), s.req.Application) &&
+			MatchListString(match.GetService(), s.req.Service) &&
+			MatchParams(match.GetParam(), s.get)
+	}
+	return true
+}
+
+func (s *simulation) matchesProvider(spec *mesh_proto.DynamicConfig, override *mesh_proto.OverrideConfig, endpoint *Endpoint) bool {
+	if spec.GetScope() != consts.Service && len(override.GetApplications()) == 0 && spec.GetKey() != endpoint.Application {
+		return false
+	}
+	if len(override.GetAddresses()) > 0 && !matchAddresses(override.GetAddresses(), endpoint.Address, endpoint.port()) {
+		return false
+	}
+	if len(override.GetApplications()) > 0 && !contains(override.GetApplications(), endpoint.Application) {
+		return false
+	}
+	if len(override.GetServices()) > 0 && !contains(override.GetServices(), s.req.Service) {
+		return false
+	}
+	if match := override.GetMatch(); match != nil {
+		return MatchAddress(match.GetAddress(), net.JoinHostPort(endpoint.Address, endpoint.port())) &&
+			MatchListString(match.GetApplication(), endpoint.Application) &&
+			MatchListString(match.GetService(), s.req.Service) &&
+			MatchParams(match.GetParam(), endpoint.get)
+	}
+	return true
+}
+
+func (s *simulation) applyTagRoutes(candidates []*Endpoint, tagRoutes []*core_mesh.TagRouteResource) []*Endpoint {
+	rules := make(map[string]*core_mesh.TagRouteResource)
+	for _, tagRoute := range tagRoutes {
+		if tagRoute.Spec.GetEnabled() {
+			rules[tagRoute.Spec.GetKey()] = tagRoute
+		}
+	}
+
+	// a tag route only applies to the instances of its application
+	var apps []string
+	byApp := make(map[string][]*Endpoint)
+	for _, endpoint := range candidates {
+		if _, ok := byApp[endpoint.Application]; !ok {
+			apps = append(apps, endpoint.Application)
+		}
+		byApp[endpoint.Application] = append(byApp[endpoint.Application], endpoint)
+	}
+
+	tag := s.req.Attachments[TagKey]
+	var selected []*Endpoint
+	for _, app := range apps {
+		rule, ok := rules[app]
+		if !ok {
+			if tag != "" || hasStaticTag(byApp[app]) {
+				result := s.routeByStaticTag(byApp[app], tag)
+				s.record(core_mesh.TagRouteType, "", result, "selected endpoints of %q by static tag %q", app, tag)
+				selected = append(selected, result...)
+				continue
+			}
+			selected = append(selected, byApp[app]...)
+			continue
+		}
+		result := s.routeByTagRoute(byApp[app], rule.Spec, tag)
+		s.record(core_mesh.TagRouteType, rule.GetMeta().GetName(), result, "selected endpoints of %q by tag %q", app, tag)
+		selected = append(selected, result...)
+	}
+	return selected
+}
+
+func (s *simulation) routeByTagRoute(endpoints []*Endpoint, rule *mesh_proto.TagRoute, tag string) []*Endpoint {
+	if tag != "" {
+		for _, t := range rule.GetTags() {
+			if t.GetName() != tag {
+				continue
+			}
+			result := filter(endpoints, func(endpoint *Endpoint) bool {
+				return endpoint.inTag(t)
+			})
+			if len(result) > 0 || rule.GetForce() {
+				return result
+			}
+		}
+	}
+
+	// only the endpoints which do not belong to any tag are left for fallback
+	untagged := filter(endpoints, func(endpoint *Endpoint) bool {
+		for _, t := range rule.GetTags() {
+			if endpoint.inTag(t) {
+				return false
+			}
+		}
+		return true
+	})
+	if tag == "" {
+		return s.routeByStaticTag(untagged, "")
+	}
+	result := filter(endpoints, func(endpoint *Endpoint) bool {
+		return endpoint.Params[TagKey] == tag
+	})
+	if len(result) > 0 || s.req.Attachments[ForceTagKey] == "true" {
+		return result
+	}
+	return s.routeByStaticTag(untagged, "")
+}
+
+// routeByStaticTag selects the endpoints by the tag they were started with. A
+// tagged request falls back to the untagged endpoints unless the tag is forced.
+func (s *simulation) routeByStaticTag(endpoints []*Endpoint, tag string) []*Endpoint {
+	if tag != "" {
+		result := filter(endpoints, func(endpoint *Endpoint) bool {
+			return endpoint.Params[TagKey] == tag
+		})
+		if len(result) > 0 || s.req.Attachments[ForceTagKey] == "true" {
+			return result
+		}
+	}
+	return filter(endpoints, func(endpoint *Endpoint) bool {
+		return endpoint.Params[TagKey] == ""
+	})
+}
+
+func (s *simulation) applyConditionRoutes(candidates []*Endpoint, conditionRoutes []*core_mesh.ConditionRouteResource, scope string, key string) []*Endpoint {
+	for _, conditionRoute := range sortedByName(conditionRoutes) {
+		spec := conditionRoute.Spec
+		if !spec.GetEnabled() || spec.GetScope() != scope {
+			continue
+		}
+		if scope == consts.Service && !s.matchesServiceKey(spec.GetKey()) || scope != consts.Service && spec.GetKey() != key {
+			continue
+		}
+		name := conditionRoute.GetMeta().GetName()
+		for i, expr := range spec.GetConditions() {
+			rule, err := condition.Parse(expr)
+			if err != nil {
+				s.record(core_mesh.ConditionRouteType, name, candidates, "ignored invalid condition %d: %s", i, err)
+				continue
+			}
+			if !rule.MatchWhen(s.get) {
+				s.record(core_mesh.ConditionRouteType, name, candidates, "request does not match condition %q", expr)
+				continue
+			}
+			result := filter(candidates, func(endpoint *Endpoint) bool {
+				return rule.MatchThen(endpoint.get)
+			})
+			switch {
+			case len(result) > 0:
+				candidates = result
+				s.record(core_mesh.ConditionRouteType, name, candidates, "selected endpoints by condition %q", expr)
+			case spec.GetForce():
+				candidates = result
+				s.record(core_mesh.ConditionRouteType, name, candidates, "no endpoint matches forced condition %q", expr)
+			default:
+				s.record(core_mesh.ConditionRouteType, name, candidates, "no endpoint matches condition %q, ignored", expr)
+			}
+		}
+	}
+	return candidates
+}
+
+// get resolves the keys of the "when" side of a condition.
+func (s *simulation) get(key string) (string, bool) {
+	switch {
+	case key == "method":
+		return s.req.Method, s.req.Method != ""
+	case key == "application":
+		return s.req.Application, s.req.Application != ""
+	case key == "host":
+		return s.req.Address, s.req.Address != ""
+	case key == "interface" || key == "service":
+		return s.req.Service, true
+	case key == "version":
+		return s.req.Version, s.req.Version != ""
+	case key == "group":
+		return s.req.Group, s.req.Group != ""
+	case strings.HasPrefix(key, "arguments["):
+		idx, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(key, "arguments["), "]"))
+		if err != nil || idx >= len(s.req.Arguments) {
+			return "", false
+		}
+		return s.req.Arguments[idx], true
+	case strings.HasPrefix(key, "attachments["):
+		value, ok := s.req.Attachments[strings.TrimSuffix(strings.TrimPrefix(key, "attachments["), "]")]
+		return value, ok
+	default:
+		value, ok := s.req.Attachments[key]
+		return value, ok
+	}
+}
+
+func (e *Endpoint) clone() *Endpoint {
+	clone := *e
+	clone.Params = make(map[string]string, len(e.Params))
+	for key, value := range e.Params {
+		clone.Params[key] = value
+	}
+	return &clone
+}
+
+func (e *Endpoint) port() string {
+	return strconv.FormatInt(e.Port, 10)
+}
+
+func (e *Endpoint) override(params map[string]string) {
+	for key, value := range params {
+		e.Params[key] = value
+	}
+}
+
+func (e *Endpoint) inTag(tag *mesh_proto.Tag) bool {
+	if matchAddresses(tag.GetAddresses(), e.Address, e.port()) {
+		return true
+	}
+	return len(tag.GetMatch()) > 0 && MatchParams(tag.GetMatch(), e.get)
+}
+
+// get resolves the keys of the "then" side of a condition.
+func (e *Endpoint) get(key string) (string, bool) {
+	switch key {
+	case "host":
+		return e.Address, true
+	case "port":
+		return e.port(), true
+	case "address":
+		return net.JoinHostPort(e.Address, e.port()), true
+	case "application":
+		return e.Application, true
+	default:
+		value, ok := e.Params[key]
+		return value, ok
+	}
+}
+
+func hasStaticTag(endpoints []*Endpoint) bool {
+	for _, endpoint := range endpoints {
+		if endpoint.Params[TagKey] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func filter(endpoints []*Endpoint, predicate func(*Endpoint) bool) []*Endpoint {
+	result := []*Endpoint{}
+	for _, endpoint := range endpoints {
+		if predicate(endpoint) {
+			result = append(result, endpoint)
+		}
+	}
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type named interface {
+	GetMeta() core_model.ResourceMeta
+}
+
+func sortedByName[T named](resources []T) []T {
+	sorted := append([]T(nil), resources...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetMeta().GetName() < sorted[j].GetMeta().GetName()
+	})
+	return sorted
+}
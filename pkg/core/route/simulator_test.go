@@ -0,0 +1,227 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package route_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/route"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+)
+
+var _ = Describe("Simulate()", func() {
+	var endpoints []*route.Endpoint
+	var req *route.Request
+
+	BeforeEach(func() {
+		endpoints = []*route.Endpoint{
+			{Name: "provider-1", Application: "provider", Address: "10.0.0.1", Port: 20880, Params: map[string]string{"version": "v1", "region": "hangzhou"}},
+			{Name: "provider-2", Application: "provider", Address: "10.0.0.2", Port: 20880, Params: map[string]string{"version": "v2", "region": "beijing"}},
+			{Name: "provider-3", Application: "provider", Address: "10.0.0.3", Port: 20880, Params: map[string]string{"version": "v2", route.TagKey: "canary"}},
+		}
+		req = &route.Request{
+			Application: "consumer",
+			Address:     "10.0.1.1",
+			Service:     "org.apache.dubbo.samples.GreetService",
+			Method:      "greet",
+			Arguments:   []string{"dubbo"},
+			Attachments: map[string]string{},
+		}
+	})
+
+	meta := func(name string) core_model.ResourceMeta {
+		return &test_model.ResourceMeta{Name: name, Mesh: core_model.DefaultMesh}
+	}
+	conditionRoute := func(scope, key string, force bool, conditions ...string) *core_mesh.ConditionRouteResource {
+		return &core_mesh.ConditionRouteResource{
+			Meta: meta(key + consts.ConditionRuleSuffix),
+			Spec: &mesh_proto.ConditionRoute{
+				Enabled:    true,
+				Force:      force,
+				Scope:      scope,
+				Key:        key,
+				Conditions: conditions,
+			},
+		}
+	}
+	tagRoute := func(force bool, tags ...*mesh_proto.Tag) *core_mesh.TagRouteResource {
+		return &core_mesh.TagRouteResource{
+			Meta: meta("provider" + consts.TagRuleSuffix),
+			Spec: &mesh_proto.TagRoute{
+				Enabled: true,
+				Force:   force,
+				Key:     "provider",
+				Tags:    tags,
+			},
+		}
+	}
+	names := func(result *route.Result) []string {
+		var res []string
+		for _, endpoint := range result.Endpoints {
+			res = append(res, endpoint.Name)
+		}
+		return res
+	}
+
+	It("should select untagged endpoints without rules", func() {
+		// when
+		result := route.Simulate(req, endpoints, route.Rules{})
+
+		// then
+		Expect(names(result)).To(Equal([]string{"provider-1", "provider-2"}))
+	})
+
+	DescribeTable("should apply condition routes",
+		func(given []*core_mesh.ConditionRouteResource, expected []string) {
+			// when
+			result := route.Simulate(req, endpoints[:2], route.Rules{ConditionRoutes: given})
+
+			// then
+			Expect(names(result)).To(Equal(expected))
+		},
+		Entry("service scope", []*core_mesh.ConditionRouteResource{
+			conditionRoute(consts.Service, "org.apache.dubbo.samples.GreetService", false, "method=greet => version=v1"),
+		}, []string{"provider-1"}),
+		Entry("application scope", []*core_mesh.ConditionRouteResource{
+			conditionRoute(consts.Application, "consumer", false, "arguments[0]=dubbo => region=beijing"),
+		}, []string{"provider-2"}),
+		Entry("rule of another consumer", []*core_mesh.ConditionRouteResource{
+			conditionRoute(consts.Application, "other", false, "=> region=beijing"),
+		}, []string{"provider-1", "provider-2"}),
+		Entry("request not matching", []*core_mesh.ConditionRouteResource{
+			conditionRoute(consts.Service, "org.apache.dubbo.samples.GreetService", false, "method=hello => version=v1"),
+		}, []string{"provider-1", "provider-2"}),
+		Entry("no endpoint matching", []*core_mesh.ConditionRouteResource{
+			conditionRoute(consts.Service, "org.apache.dubbo.samples.GreetService", false, "=> version=v3"),
+		}, []string{"provider-1", "provider-2"}),
+		Entry("no endpoint matching with force", []*core_mesh.ConditionRouteResource{
+			conditionRoute(consts.Service, "org.apache.dubbo.samples.GreetService", true, "=> version=v3"),
+		}, nil),
+		Entry("multiple conditions", []*core_mesh.ConditionRouteResource{
+			conditionRoute(consts.Service, "org.apache.dubbo.samples.GreetService", false, "=> host=10.0.0.*", "method=greet => host!=10.0.0.1"),
+		}, []string{"provider-2"}),
+	)
+
+	DescribeTable("should apply tag routes",
+		func(tag string, force bool, expected []string) {
+			// given
+			req.Attachments[route.TagKey] = tag
+			rules := route.Rules{
+				TagRoutes: []*core_mesh.TagRouteResource{
+					tagRoute(force,
+						&mesh_proto.Tag{Name: "gray", Addresses: []string{"10.0.0.2:20880"}},
+						&mesh_proto.Tag{Name: "hangzhou", Match: []*mesh_proto.ParamMatch{
+							{Key: "region", Value: &mesh_proto.StringMatch{Exact: "hangzhou"}},
+						}},
+						&mesh_proto.Tag{Name: "empty", Addresses: []string{"10.0.0.9"}},
+					),
+				},
+			}
+
+			// when
+			result := route.Simulate(req, endpoints, rules)
+
+			// then
+			Expect(names(result)).To(Equal(expected))
+		},
+		Entry("tag by address", "gray", false, []string{"provider-2"}),
+		Entry("tag by param match", "hangzhou", false, []string{"provider-1"}),
+		Entry("static tag", "canary", false, []string{"provider-3"}),
+		Entry("request without tag", "", false, nil),
+		Entry("tag without endpoints", "empty", false, nil),
+		Entry("tag without endpoints with force", "empty", true, nil),
+	)
+
+	It("should fall back to untagged endpoints unless the tag is forced", func() {
+		// given
+		req.Attachments[route.TagKey] = "missing"
+
+		// expect
+		Expect(names(route.Simulate(req, endpoints, route.Rules{}))).To(Equal([]string{"provider-1", "provider-2"}))
+
+		// when
+		req.Attachments[route.ForceTagKey] = "true"
+
+		// then
+		Expect(names(route.Simulate(req, endpoints, route.Rules{}))).To(BeEmpty())
+	})
+
+	It("should apply dynamic configs", func() {
+		// given
+		rules := route.Rules{
+			DynamicConfigs: []*core_mesh.DynamicConfigResource{
+				{
+					Meta: meta("provider" + consts.ConfiguratorRuleSuffix),
+					Spec: &mesh_proto.DynamicConfig{
+						Key:     "provider",
+						Scope:   consts.Application,
+						Enabled: true,
+						Configs: []*mesh_proto.OverrideConfig{
+							{
+								Side:       consts.ProviderSide,
+								Enabled:    true,
+								Addresses:  []string{"10.0.0.2"},
+								Parameters: map[string]string{route.DisabledKey: "true"},
+							},
+							{
+								Side:       consts.ProviderSide,
+								Enabled:    true,
+								Match:      &mesh_proto.ConditionMatch{Param: []*mesh_proto.ParamMatch{{Key: "version", Value: &mesh_proto.StringMatch{Exact: "v1"}}}},
+								Parameters: map[string]string{"weight": "200"},
+							},
+						},
+					},
+				},
+				{
+					Meta: meta("org.apache.dubbo.samples.GreetService::" + consts.ConfiguratorRuleSuffix),
+					Spec: &mesh_proto.DynamicConfig{
+						Key:     "org.apache.dubbo.samples.GreetService::",
+						Scope:   consts.Service,
+						Enabled: true,
+						Configs: []*mesh_proto.OverrideConfig{
+							{
+								Side:       consts.ConsumerSide,
+								Enabled:    true,
+								Parameters: map[string]string{consts.TimeoutKey: "5000"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		// when
+		result := route.Simulate(req, endpoints, rules)
+
+		// then
+		Expect(names(result)).To(Equal([]string{"provider-1"}))
+		Expect(result.Endpoints[0].Params).To(HaveKeyWithValue("weight", "200"))
+		Expect(result.Parameters).To(Equal(map[string]string{consts.TimeoutKey: "5000"}))
+		Expect(result.Steps).To(HaveLen(3))
+		// and the endpoints passed in are not modified
+		Expect(endpoints[0].Params).ToNot(HaveKey("weight"))
+	})
+})
@@ -36,6 +36,7 @@ import (
 import (
 	dubbo_cp "github.com/apache/dubbo-kubernetes/pkg/config/app/dubbo-cp"
 	"github.com/apache/dubbo-kubernetes/pkg/core"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
 	config_manager "github.com/apache/dubbo-kubernetes/pkg/core/config/manager"
 	"github.com/apache/dubbo-kubernetes/pkg/core/datasource"
 	"github.com/apache/dubbo-kubernetes/pkg/core/dns/lookup"
@@ -74,6 +75,8 @@ type BuilderContext interface {
 	DataplaneCache() *sync.Map
 	DDSContext() *dds_context.Context
 	ResourceValidators() ResourceValidators
+	CaManagers() core_ca.Managers
+	DataSourceLoader() datasource.Loader
 }
 
 var _ BuilderContext = &Builder{}
@@ -94,6 +97,7 @@ type Builder struct {
 	leadInfo             component.LeaderInfo
 	erf                  events.EventBus
 	dsl                  datasource.Loader
+	cam                  core_ca.Managers
 	dps                  *dp_server.DpServer
 	registryCenter       dubboRegistry.Registry
 	metadataReportCenter report.MetadataReport
@@ -118,6 +122,7 @@ func BuilderFor(appCtx context.Context, cfg dubbo_cp.Config) (*Builder, error) {
 	return &Builder{
 		cfg: cfg,
 		ext: context.Background(),
+		cam: core_ca.Managers{},
 		runtimeInfo: &runtimeInfo{
 			instanceId: fmt.Sprintf("%s-%s", hostname, suffix),
 			startTime:  time.Now(),
@@ -203,6 +208,11 @@ func (b *Builder) WithDataSourceLoader(loader datasource.Loader) *Builder {
 	return b
 }
 
+func (b *Builder) WithCaManager(name string, cam core_ca.Manager) *Builder {
+	b.cam[name] = cam
+	return b
+}
+
 func (b *Builder) WithDpServer(dps *dp_server.DpServer) *Builder {
 	b.dps = dps
 	return b
@@ -313,6 +323,8 @@ func (b *Builder) Build() (Runtime, error) {
 			appCtx:               b.appCtx,
 			meshCache:            b.meshCache,
 			regClient:            b.regClient,
+			cam:                  b.cam,
+			dsl:                  b.dsl,
 		},
 		Manager: b.cm,
 	}, nil
@@ -410,6 +422,14 @@ func (b *Builder) ResourceValidators() ResourceValidators {
 	return b.rv
 }
 
+func (b *Builder) CaManagers() core_ca.Managers {
+	return b.cam
+}
+
+func (b *Builder) DataSourceLoader() datasource.Loader {
+	return b.dsl
+}
+
 func (b *Builder) AppCtx() context.Context {
 	return b.appCtx
 }
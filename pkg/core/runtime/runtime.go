@@ -32,7 +32,9 @@ import (
 import (
 	dubbo_cp "github.com/apache/dubbo-kubernetes/pkg/config/app/dubbo-cp"
 	"github.com/apache/dubbo-kubernetes/pkg/config/core"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
 	config_manager "github.com/apache/dubbo-kubernetes/pkg/core/config/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/datasource"
 	"github.com/apache/dubbo-kubernetes/pkg/core/governance"
 	managers_dataplane "github.com/apache/dubbo-kubernetes/pkg/core/managers/apis/dataplane"
 	managers_mesh "github.com/apache/dubbo-kubernetes/pkg/core/managers/apis/mesh"
@@ -85,6 +87,8 @@ type RuntimeContext interface {
 	AdminRegistry() *registry.Registry
 	RegClient() reg_client.RegClient
 	ResourceValidators() ResourceValidators
+	CaManagers() core_ca.Managers
+	DataSourceLoader() datasource.Loader
 	// AppContext returns a context.Context which tracks the lifetime of the apps, it gets cancelled when the app is starting to shutdown.
 	AppContext() context.Context
 	XDS() xds_runtime.XDSRuntimeContext
@@ -172,6 +176,8 @@ type runtimeContext struct {
 	meshCache            *mesh.Cache
 	regClient            reg_client.RegClient
 	serviceDiscovery     dubboRegistry.ServiceDiscovery
+	cam                  core_ca.Managers
+	dsl                  datasource.Loader
 }
 
 func (b *runtimeContext) RegClient() reg_client.RegClient {
@@ -258,6 +264,14 @@ func (rc *runtimeContext) DpServer() *dp_server.DpServer {
 	return rc.dps
 }
 
+func (rc *runtimeContext) CaManagers() core_ca.Managers {
+	return rc.cam
+}
+
+func (rc *runtimeContext) DataSourceLoader() datasource.Loader {
+	return rc.dsl
+}
+
 func (rc *runtimeContext) ResourceValidators() ResourceValidators {
 	return rc.rv
 }
@@ -0,0 +1,190 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"crypto/rsa"
+	"time"
+)
+
+import (
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/pkg/errors"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	config_types "github.com/apache/dubbo-kubernetes/pkg/config/types"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens"
+)
+
+const (
+	// SigningKeyPrefix is the prefix of the Secret resources with the keys signing dataplane tokens.
+	// Every mesh has its own signing keys "dataplane-token-signing-key-{mesh}-{serial number}".
+	SigningKeyPrefix = "dataplane-token-signing-key"
+	// RevocationsSecretPrefix is the prefix of the Secret resources with the IDs of revoked
+	// dataplane tokens. Every mesh has its own list "dataplane-token-revocations-{mesh}".
+	RevocationsSecretPrefix = "dataplane-token-revocations"
+)
+
+func SigningKeyPrefixFor(mesh string) string {
+	return SigningKeyPrefix + "-" + mesh
+}
+
+func RevocationsSecretFor(mesh string) string {
+	return RevocationsSecretPrefix + "-" + mesh
+}
+
+// Identity is a set of attributes a dataplane proxy using the token has to match.
+// Empty Name, Tags or Type means that the token is valid for any value of the attribute.
+type Identity struct {
+	Name string
+	Mesh string
+	Tags mesh_proto.MultiValueTagSet
+	Type mesh_proto.ProxyType
+}
+
+type claims struct {
+	Name string              `json:"Name"`
+	Mesh string              `json:"Mesh"`
+	Tags map[string][]string `json:"Tags"`
+	Type string              `json:"Type"`
+	jwt.RegisteredClaims
+}
+
+var _ tokens.Claims = &claims{}
+
+func (c *claims) ID() string {
+	return c.RegisteredClaims.ID
+}
+
+func (c *claims) SetRegisteredClaims(registeredClaims jwt.RegisteredClaims) {
+	c.RegisteredClaims = registeredClaims
+}
+
+type Issuer interface {
+	Generate(ctx context.Context, identity Identity, validFor time.Duration) (tokens.Token, error)
+}
+
+// NewIssuer returns an issuer signing tokens with the latest signing key of the token's mesh.
+func NewIssuer(resManager manager.ResourceManager) Issuer {
+	return &issuer{
+		signingKeyManager: func(mesh string) tokens.SigningKeyManager {
+			return tokens.NewSigningKeyManager(resManager, SigningKeyPrefixFor(mesh))
+		},
+	}
+}
+
+// NewOfflineIssuer returns an issuer signing tokens with the given key, regardless of the mesh.
+func NewOfflineIssuer(signingKey *rsa.PrivateKey, keyID string) Issuer {
+	return &issuer{
+		signingKeyManager: func(string) tokens.SigningKeyManager {
+			return tokens.NewStaticSigningKeyManager(signingKey, keyID)
+		},
+	}
+}
+
+var _ Issuer = &issuer{}
+
+type issuer struct {
+	signingKeyManager func(mesh string) tokens.SigningKeyManager
+}
+
+func (i *issuer) Generate(ctx context.Context, identity Identity, validFor time.Duration) (tokens.Token, error) {
+	if identity.Mesh == "" {
+		return "", errors.New("mesh has to be defined")
+	}
+	tags := map[string][]string{}
+	for tag := range identity.Tags {
+		tags[tag] = identity.Tags.Values(tag)
+	}
+	c := &claims{
+		Name: identity.Name,
+		Mesh: identity.Mesh,
+		Tags: tags,
+		Type: string(identity.Type),
+	}
+	return tokens.NewTokenIssuer(i.signingKeyManager(identity.Mesh)).Generate(ctx, c, validFor)
+}
+
+type Validator interface {
+	Validate(ctx context.Context, token tokens.Token) (Identity, error)
+}
+
+// NewValidator returns a validator that checks tokens against the public keys from the
+// configuration and, if useSecrets is set, against the signing keys of the token's mesh.
+func NewValidator(resManager manager.ReadOnlyResourceManager, publicKeys []config_types.MeshedPublicKey, useSecrets bool) (Validator, error) {
+	staticKeys := map[string][]config_types.PublicKey{}
+	for _, key := range publicKeys {
+		staticKeys[key.Mesh] = append(staticKeys[key.Mesh], key.PublicKey)
+	}
+	staticAccessors := map[string]tokens.SigningKeyAccessor{}
+	for mesh, keys := range staticKeys {
+		accessor, err := tokens.NewStaticSigningKeyAccessor(keys)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public keys of mesh %q", mesh)
+		}
+		staticAccessors[mesh] = accessor
+	}
+	return &validator{
+		resManager:      resManager,
+		staticAccessors: staticAccessors,
+		useSecrets:      useSecrets,
+	}, nil
+}
+
+var _ Validator = &validator{}
+
+type validator struct {
+	resManager      manager.ReadOnlyResourceManager
+	staticAccessors map[string]tokens.SigningKeyAccessor
+	useSecrets      bool
+}
+
+func (v *validator) Validate(ctx context.Context, token tokens.Token) (Identity, error) {
+	// the mesh has to be known upfront to pick the signing keys, the signature is verified below
+	unverified := &claims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, unverified); err != nil {
+		return Identity{}, errors.Wrap(err, "could not parse token")
+	}
+	var accessors []tokens.SigningKeyAccessor
+	if accessor, ok := v.staticAccessors[unverified.Mesh]; ok {
+		accessors = append(accessors, accessor)
+	}
+	if v.useSecrets {
+		accessors = append(accessors, tokens.NewSigningKeyAccessor(v.resManager, SigningKeyPrefixFor(unverified.Mesh)))
+	}
+
+	c := &claims{}
+	revocations := tokens.NewSecretsRevocations(v.resManager, RevocationsSecretFor(unverified.Mesh))
+	if err := tokens.NewValidator(accessors, revocations).ParseWithValidation(ctx, token, c); err != nil {
+		return Identity{}, err
+	}
+	if c.Mesh == "" {
+		return Identity{}, errors.New("token has to have a mesh")
+	}
+	return Identity{
+		Name: c.Name,
+		Mesh: c.Mesh,
+		Tags: mesh_proto.MultiValueTagSetFrom(c.Tags),
+		Type: mesh_proto.ProxyType(c.Type),
+	}, nil
+}
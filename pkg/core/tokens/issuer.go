@@ -0,0 +1,87 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"context"
+	"time"
+)
+
+import (
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/google/uuid"
+
+	"github.com/pkg/errors"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+)
+
+// Issuer signs the claims with the latest signing key. If there is no signing key yet,
+// the default one is created.
+type Issuer interface {
+	Generate(ctx context.Context, claims Claims, validFor time.Duration) (Token, error)
+}
+
+func NewTokenIssuer(signingKeyManager SigningKeyManager) Issuer {
+	return &jwtTokenIssuer{
+		signingKeyManager: signingKeyManager,
+	}
+}
+
+var _ Issuer = &jwtTokenIssuer{}
+
+type jwtTokenIssuer struct {
+	signingKeyManager SigningKeyManager
+}
+
+func (j *jwtTokenIssuer) Generate(ctx context.Context, claims Claims, validFor time.Duration) (Token, error) {
+	signingKey, keyID, err := j.signingKeyManager.GetLatestSigningKey(ctx)
+	if IsSigningKeyNotFoundErr(err) {
+		if err := j.signingKeyManager.CreateDefaultSigningKey(ctx); err != nil && !errors.Is(err, &core_store.ResourceConflictError{}) {
+			return "", errors.Wrap(err, "could not create the default signing key")
+		}
+		signingKey, keyID, err = j.signingKeyManager.GetLatestSigningKey(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	now := core.Now()
+	registered := jwt.RegisteredClaims{
+		ID:       uuid.New().String(),
+		IssuedAt: jwt.NewNumericDate(now),
+		// account for the clock skew between the control planes
+		NotBefore: jwt.NewNumericDate(now.Add(-5 * time.Minute)),
+	}
+	if validFor != 0 {
+		registered.ExpiresAt = jwt.NewNumericDate(now.Add(validFor))
+	}
+	claims.SetRegisteredClaims(registered)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header[KeyIDHeader] = keyID
+	tokenString, err := token.SignedString(signingKey)
+	if err != nil {
+		return "", errors.Wrap(err, "could not sign a token")
+	}
+	return tokenString, nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"context"
+	"strings"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/system"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+)
+
+// Revocations tells whether a token was revoked before it expired.
+type Revocations interface {
+	IsRevoked(ctx context.Context, id string) (bool, error)
+}
+
+// NewSecretsRevocations returns revocations stored in the Secret resource with the given name.
+// The data of the Secret is a comma or new line separated list of the IDs of revoked tokens.
+func NewSecretsRevocations(resManager manager.ReadOnlyResourceManager, secretName string) Revocations {
+	return &secretsRevocations{
+		resManager: resManager,
+		secretName: secretName,
+	}
+}
+
+var _ Revocations = &secretsRevocations{}
+
+type secretsRevocations struct {
+	resManager manager.ReadOnlyResourceManager
+	secretName string
+}
+
+func (s *secretsRevocations) IsRevoked(ctx context.Context, id string) (bool, error) {
+	secret := system.NewSecretResource()
+	if err := s.resManager.Get(ctx, secret, store.GetByKey(s.secretName, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, revoked := range strings.FieldsFunc(string(secret.Spec.GetData().GetValue()), func(r rune) bool {
+		return r == ',' || r == '\n'
+	}) {
+		if strings.TrimSpace(revoked) == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NoRevocations is used when tokens cannot be revoked, e.g. when the control plane has no access to the Secrets.
+var NoRevocations Revocations = &noRevocations{}
+
+type noRevocations struct{}
+
+func (n *noRevocations) IsRevoked(context.Context, string) (bool, error) {
+	return false, nil
+}
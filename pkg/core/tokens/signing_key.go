@@ -0,0 +1,187 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+import (
+	system_proto "github.com/apache/dubbo-kubernetes/api/system/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/system"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	util_rsa "github.com/apache/dubbo-kubernetes/pkg/util/rsa"
+)
+
+const (
+	// KeyIDHeader is the JWT header that carries the serial number of the signing key.
+	KeyIDHeader = "kid"
+	// DefaultSerialNumber is the serial number of the signing key created by the control plane.
+	DefaultSerialNumber = 1
+)
+
+// SigningKeyManager manages the private keys stored as Secret resources
+// named "<prefix>-<serial number>".
+type SigningKeyManager interface {
+	GetLatestSigningKey(ctx context.Context) (*rsa.PrivateKey, string, error)
+	CreateDefaultSigningKey(ctx context.Context) error
+	CreateSigningKey(ctx context.Context, serialNumber int) error
+}
+
+func NewSigningKeyManager(manager manager.ResourceManager, signingKeyPrefix string) SigningKeyManager {
+	return &signingKeyManager{
+		manager:          manager,
+		signingKeyPrefix: signingKeyPrefix,
+	}
+}
+
+var _ SigningKeyManager = &signingKeyManager{}
+
+type signingKeyManager struct {
+	manager          manager.ResourceManager
+	signingKeyPrefix string
+}
+
+func (s *signingKeyManager) GetLatestSigningKey(ctx context.Context) (*rsa.PrivateKey, string, error) {
+	secrets := &system.SecretResourceList{}
+	if err := s.manager.List(ctx, secrets); err != nil {
+		return nil, "", errors.Wrap(err, "could not retrieve signing key from secret manager")
+	}
+
+	serialNumbers := signingKeySerialNumbers(secrets, s.signingKeyPrefix)
+	if len(serialNumbers) == 0 {
+		return nil, "", &SigningKeyNotFound{
+			KeyID:  strconv.Itoa(DefaultSerialNumber),
+			Prefix: s.signingKeyPrefix,
+		}
+	}
+	latest := serialNumbers[len(serialNumbers)-1]
+	for _, secret := range secrets.Items {
+		if secret.GetMeta().GetName() == SigningKeyResourceKey(s.signingKeyPrefix, latest).Name {
+			key, err := util_rsa.FromPEMBytesToPrivateKey(secret.Spec.GetData().GetValue())
+			if err != nil {
+				return nil, "", errors.Wrapf(err, "could not parse the signing key %q", secret.GetMeta().GetName())
+			}
+			return key, strconv.Itoa(latest), nil
+		}
+	}
+	return nil, "", &SigningKeyNotFound{
+		KeyID:  strconv.Itoa(latest),
+		Prefix: s.signingKeyPrefix,
+	}
+}
+
+func (s *signingKeyManager) CreateDefaultSigningKey(ctx context.Context) error {
+	return s.CreateSigningKey(ctx, DefaultSerialNumber)
+}
+
+func (s *signingKeyManager) CreateSigningKey(ctx context.Context, serialNumber int) error {
+	key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+	if err != nil {
+		return errors.Wrap(err, "could not generate a signing key")
+	}
+	keyBytes, err := util_rsa.FromPrivateKeyToPEMBytes(key)
+	if err != nil {
+		return errors.Wrap(err, "could not encode the signing key")
+	}
+	secret := system.NewSecretResource()
+	secret.Spec = &system_proto.Secret{
+		Data: &wrapperspb.BytesValue{Value: keyBytes},
+	}
+	return s.manager.Create(ctx, secret, store.CreateBy(SigningKeyResourceKey(s.signingKeyPrefix, serialNumber)))
+}
+
+// NewStaticSigningKeyManager returns a manager of a single key provided by the user.
+// It is used to sign tokens offline, without the access to the control plane.
+func NewStaticSigningKeyManager(key *rsa.PrivateKey, keyID string) SigningKeyManager {
+	return &staticSigningKeyManager{
+		key:   key,
+		keyID: keyID,
+	}
+}
+
+var _ SigningKeyManager = &staticSigningKeyManager{}
+
+type staticSigningKeyManager struct {
+	key   *rsa.PrivateKey
+	keyID string
+}
+
+func (s *staticSigningKeyManager) GetLatestSigningKey(context.Context) (*rsa.PrivateKey, string, error) {
+	return s.key, s.keyID, nil
+}
+
+func (s *staticSigningKeyManager) CreateDefaultSigningKey(context.Context) error {
+	return errors.New("static signing key manager cannot create signing keys")
+}
+
+func (s *staticSigningKeyManager) CreateSigningKey(context.Context, int) error {
+	return errors.New("static signing key manager cannot create signing keys")
+}
+
+func SigningKeyResourceKey(signingKeyPrefix string, serialNumber int) model.ResourceKey {
+	return model.ResourceKey{
+		Name: fmt.Sprintf("%s-%d", signingKeyPrefix, serialNumber),
+		Mesh: model.NoMesh,
+	}
+}
+
+// signingKeySerialNumbers returns sorted serial numbers of the signing keys with the given prefix.
+func signingKeySerialNumbers(secrets *system.SecretResourceList, signingKeyPrefix string) []int {
+	var serialNumbers []int
+	for _, secret := range secrets.Items {
+		name := secret.GetMeta().GetName()
+		if !strings.HasPrefix(name, signingKeyPrefix+"-") {
+			continue
+		}
+		serialNumber, err := strconv.Atoi(strings.TrimPrefix(name, signingKeyPrefix+"-"))
+		if err != nil {
+			continue
+		}
+		serialNumbers = append(serialNumbers, serialNumber)
+	}
+	sort.Ints(serialNumbers)
+	return serialNumbers
+}
+
+type SigningKeyNotFound struct {
+	KeyID  string
+	Prefix string
+}
+
+func (s *SigningKeyNotFound) Error() string {
+	return fmt.Sprintf("there is no signing key with KID %s and prefix %q", s.KeyID, s.Prefix)
+}
+
+func IsSigningKeyNotFoundErr(err error) bool {
+	var target *SigningKeyNotFound
+	return errors.As(err, &target)
+}
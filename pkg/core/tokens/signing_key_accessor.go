@@ -0,0 +1,118 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"context"
+	"crypto/rsa"
+	"os"
+	"strconv"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	config_types "github.com/apache/dubbo-kubernetes/pkg/config/types"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/system"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	util_rsa "github.com/apache/dubbo-kubernetes/pkg/util/rsa"
+)
+
+// SigningKeyAccessor provides public keys used to verify the signature of a token.
+type SigningKeyAccessor interface {
+	GetPublicKey(ctx context.Context, keyID string) (*rsa.PublicKey, error)
+}
+
+// NewSigningKeyAccessor returns an accessor of the public keys derived from the signing keys
+// stored as Secret resources.
+func NewSigningKeyAccessor(resManager manager.ReadOnlyResourceManager, signingKeyPrefix string) SigningKeyAccessor {
+	return &signingKeyAccessor{
+		resManager:       resManager,
+		signingKeyPrefix: signingKeyPrefix,
+	}
+}
+
+var _ SigningKeyAccessor = &signingKeyAccessor{}
+
+type signingKeyAccessor struct {
+	resManager       manager.ReadOnlyResourceManager
+	signingKeyPrefix string
+}
+
+func (s *signingKeyAccessor) GetPublicKey(ctx context.Context, keyID string) (*rsa.PublicKey, error) {
+	serialNumber, err := strconv.Atoi(keyID)
+	if err != nil {
+		return nil, &SigningKeyNotFound{KeyID: keyID, Prefix: s.signingKeyPrefix}
+	}
+	secret := system.NewSecretResource()
+	if err := s.resManager.Get(ctx, secret, store.GetBy(SigningKeyResourceKey(s.signingKeyPrefix, serialNumber))); err != nil {
+		if store.IsResourceNotFound(err) {
+			return nil, &SigningKeyNotFound{KeyID: keyID, Prefix: s.signingKeyPrefix}
+		}
+		return nil, errors.Wrap(err, "could not retrieve signing key")
+	}
+	key, err := util_rsa.FromPEMBytesToPrivateKey(secret.Spec.GetData().GetValue())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse the signing key")
+	}
+	return &key.PublicKey, nil
+}
+
+// NewStaticSigningKeyAccessor returns an accessor of the public keys provided in the configuration.
+// It is used to validate tokens issued offline.
+func NewStaticSigningKeyAccessor(keys []config_types.PublicKey) (SigningKeyAccessor, error) {
+	accessor := &staticSigningKeyAccessor{
+		keys: map[string]*rsa.PublicKey{},
+	}
+	for _, key := range keys {
+		keyBytes := []byte(key.Key)
+		if key.KeyFile != "" {
+			content, err := os.ReadFile(key.KeyFile)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not read the public key with KID %s", key.KID)
+			}
+			keyBytes = content
+		}
+		if !util_rsa.IsPublicKeyPEMBytes(keyBytes) {
+			return nil, errors.Errorf("public key with KID %s is not a PEM encoded RSA public key", key.KID)
+		}
+		publicKey, err := util_rsa.FromPEMBytesToPublicKey(keyBytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse the public key with KID %s", key.KID)
+		}
+		accessor.keys[key.KID] = publicKey
+	}
+	return accessor, nil
+}
+
+var _ SigningKeyAccessor = &staticSigningKeyAccessor{}
+
+type staticSigningKeyAccessor struct {
+	keys map[string]*rsa.PublicKey
+}
+
+func (s *staticSigningKeyAccessor) GetPublicKey(_ context.Context, keyID string) (*rsa.PublicKey, error) {
+	key, ok := s.keys[keyID]
+	if !ok {
+		return nil, &SigningKeyNotFound{KeyID: keyID}
+	}
+	return key, nil
+}
@@ -0,0 +1,33 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Token is a JWT signed by the control plane or offline with a private key.
+type Token = string
+
+// Claims are the claims embedded in a token. Every kind of token (dataplane, zone)
+// defines its own claims on top of the registered ones.
+type Claims interface {
+	jwt.Claims
+	ID() string
+	SetRegisteredClaims(claims jwt.RegisteredClaims)
+}
@@ -0,0 +1,88 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+)
+
+import (
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/pkg/errors"
+)
+
+// Validator verifies the signature and the registered claims of a token and decodes
+// its claims. Public keys are looked up in the accessors in order. A token that is on
+// the revocation list is rejected.
+type Validator interface {
+	ParseWithValidation(ctx context.Context, token Token, claims Claims) error
+}
+
+func NewValidator(keyAccessors []SigningKeyAccessor, revocations Revocations) Validator {
+	return &jwtTokenValidator{
+		keyAccessors: keyAccessors,
+		revocations:  revocations,
+	}
+}
+
+var _ Validator = &jwtTokenValidator{}
+
+type jwtTokenValidator struct {
+	keyAccessors []SigningKeyAccessor
+	revocations  Revocations
+}
+
+func (j *jwtTokenValidator) ParseWithValidation(ctx context.Context, rawToken Token, claims Claims) error {
+	_, err := jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		keyID, ok := token.Header[KeyIDHeader].(string)
+		if !ok {
+			return nil, errors.Errorf("JWT token must have %s header", KeyIDHeader)
+		}
+		return j.publicKey(ctx, keyID)
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}))
+	if err != nil {
+		return errors.Wrap(err, "could not parse token")
+	}
+	if claims.ID() == "" {
+		return errors.New("token must have an ID")
+	}
+	revoked, err := j.revocations.IsRevoked(ctx, claims.ID())
+	if err != nil {
+		return errors.Wrap(err, "could not check if the token is revoked")
+	}
+	if revoked {
+		return errors.New("token is revoked")
+	}
+	return nil
+}
+
+func (j *jwtTokenValidator) publicKey(ctx context.Context, keyID string) (*rsa.PublicKey, error) {
+	for _, accessor := range j.keyAccessors {
+		key, err := accessor.GetPublicKey(ctx, keyID)
+		if err == nil {
+			return key, nil
+		}
+		if !IsSigningKeyNotFoundErr(err) {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("there is no public key with KID %s to verify the token", keyID)
+}
@@ -0,0 +1,154 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zone
+
+import (
+	"context"
+	"crypto/rsa"
+	"time"
+)
+
+import (
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/pkg/errors"
+)
+
+import (
+	config_types "github.com/apache/dubbo-kubernetes/pkg/config/types"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens"
+)
+
+const (
+	// SigningKeyPrefix is the prefix of the Secret resources with the keys signing zone tokens.
+	SigningKeyPrefix = "zone-token-signing-key"
+	// RevocationsSecret is the name of the Secret resource with the IDs of revoked zone tokens.
+	RevocationsSecret = "zone-token-revocations"
+)
+
+const (
+	IngressScope = "ingress"
+	EgressScope  = "egress"
+)
+
+var FullScope = []string{IngressScope, EgressScope}
+
+// Identity is a zone and a list of the proxy types the token can be used by.
+type Identity struct {
+	Zone  string
+	Scope []string
+}
+
+type claims struct {
+	Zone  string   `json:"Zone"`
+	Scope []string `json:"Scope"`
+	jwt.RegisteredClaims
+}
+
+var _ tokens.Claims = &claims{}
+
+func (c *claims) ID() string {
+	return c.RegisteredClaims.ID
+}
+
+func (c *claims) SetRegisteredClaims(registeredClaims jwt.RegisteredClaims) {
+	c.RegisteredClaims = registeredClaims
+}
+
+type Issuer interface {
+	Generate(ctx context.Context, identity Identity, validFor time.Duration) (tokens.Token, error)
+}
+
+// NewIssuer returns an issuer signing tokens with the latest zone token signing key.
+func NewIssuer(resManager manager.ResourceManager) Issuer {
+	return &issuer{
+		issuer: tokens.NewTokenIssuer(tokens.NewSigningKeyManager(resManager, SigningKeyPrefix)),
+	}
+}
+
+// NewOfflineIssuer returns an issuer signing tokens with the given key.
+func NewOfflineIssuer(signingKey *rsa.PrivateKey, keyID string) Issuer {
+	return &issuer{
+		issuer: tokens.NewTokenIssuer(tokens.NewStaticSigningKeyManager(signingKey, keyID)),
+	}
+}
+
+var _ Issuer = &issuer{}
+
+type issuer struct {
+	issuer tokens.Issuer
+}
+
+func (i *issuer) Generate(ctx context.Context, identity Identity, validFor time.Duration) (tokens.Token, error) {
+	if identity.Zone == "" {
+		return "", errors.New("zone has to be defined")
+	}
+	for _, scope := range identity.Scope {
+		if scope != IngressScope && scope != EgressScope {
+			return "", errors.Errorf("invalid scope %q, available scopes: %v", scope, FullScope)
+		}
+	}
+	return i.issuer.Generate(ctx, &claims{
+		Zone:  identity.Zone,
+		Scope: identity.Scope,
+	}, validFor)
+}
+
+type Validator interface {
+	Validate(ctx context.Context, token tokens.Token) (Identity, error)
+}
+
+// NewValidator returns a validator that checks tokens against the public keys from the
+// configuration and, if useSecrets is set, against the zone token signing keys.
+func NewValidator(resManager manager.ReadOnlyResourceManager, publicKeys []config_types.PublicKey, useSecrets bool) (Validator, error) {
+	var accessors []tokens.SigningKeyAccessor
+	if len(publicKeys) > 0 {
+		accessor, err := tokens.NewStaticSigningKeyAccessor(publicKeys)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid public keys")
+		}
+		accessors = append(accessors, accessor)
+	}
+	if useSecrets {
+		accessors = append(accessors, tokens.NewSigningKeyAccessor(resManager, SigningKeyPrefix))
+	}
+	return &validator{
+		validator: tokens.NewValidator(accessors, tokens.NewSecretsRevocations(resManager, RevocationsSecret)),
+	}, nil
+}
+
+var _ Validator = &validator{}
+
+type validator struct {
+	validator tokens.Validator
+}
+
+func (v *validator) Validate(ctx context.Context, token tokens.Token) (Identity, error) {
+	c := &claims{}
+	if err := v.validator.ParseWithValidation(ctx, token, c); err != nil {
+		return Identity{}, err
+	}
+	if c.Zone == "" {
+		return Identity{}, errors.New("token has to have a zone")
+	}
+	return Identity{
+		Zone:  c.Zone,
+		Scope: c.Scope,
+	}, nil
+}
@@ -28,6 +28,11 @@ import (
 	"github.com/asaskevich/govalidator"
 
 	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+import (
+	util_k8s "github.com/apache/dubbo-kubernetes/pkg/util/k8s"
 )
 
 func ValidateDurationNotNegative(path PathBuilder, duration *k8s.Duration) ValidationError {
@@ -121,6 +126,21 @@ func ValidateUInt32PercentageOrNil(path PathBuilder, percentage *uint32) Validat
 	return err
 }
 
+func ValidatePercentage(path PathBuilder, percentage intstr.IntOrString) ValidationError {
+	var err ValidationError
+	value, parseErr := util_k8s.ParsePercentage(percentage)
+	if parseErr != nil {
+		err.AddViolationAt(path, parseErr.Error())
+		return err
+	}
+
+	if value < 0 || value > 100 {
+		err.AddViolationAt(path, HasToBeInPercentageRange)
+	}
+
+	return err
+}
+
 func ValidateStringDefined(path PathBuilder, value string) ValidationError {
 	var err ValidationError
 	if value == "" {
@@ -19,7 +19,9 @@ package xds
 
 import (
 	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
 )
 
 // TypedMatchingPolicies all policies of this type matching
@@ -29,12 +31,19 @@ type TypedMatchingPolicies struct {
 	OutboundPolicies  map[mesh_proto.OutboundInterface][]core_model.Resource
 	ServicePolicies   map[ServiceName][]core_model.Resource
 	DataplanePolicies []core_model.Resource
+	FromRules         core_rules.FromRules
+	ToRules           core_rules.ToRules
+	SingleItemRules   core_rules.SingleItemRules
 }
 
 type PluginOriginatedPolicies map[core_model.ResourceType]TypedMatchingPolicies
 
+// AuthorizationPolicyMap holds the authorization policies that apply to each inbound.
+type AuthorizationPolicyMap map[mesh_proto.InboundInterface][]*core_mesh.AuthorizationPolicyResource
+
 type MatchedPolicies struct {
 	// Inbound(Listener) -> Policy
+	AuthorizationPolicies AuthorizationPolicyMap
 
 	// Service(Cluster) -> Policy
 
@@ -104,6 +104,12 @@ type EndpointList []Endpoint
 // EndpointMap holds routing-related information about a set of endpoints grouped by service name.
 type EndpointMap map[ServiceName][]Endpoint
 
+// VIPDomains is a virtual IP of a service together with the domains that resolve to it.
+type VIPDomains struct {
+	Address string
+	Domains []string
+}
+
 // SocketAddressProtocol is the L4 protocol the listener should bind to
 type SocketAddressProtocol int32
 
@@ -128,6 +134,8 @@ type Proxy struct {
 
 	// ZoneIngressProxy is available only when XDS is generated for ZoneIngress data plane proxy.
 	ZoneIngressProxy *ZoneIngressProxy
+	// ZoneEgressProxy is available only when XDS is generated for ZoneEgress data plane proxy.
+	ZoneEgressProxy *ZoneEgressProxy
 	// RuntimeExtensions a set of extensions to add for custom extensions
 	RuntimeExtensions map[string]interface{}
 	// Zone the zone the proxy is in
@@ -139,6 +147,17 @@ type ServerSideTLSCertPaths struct {
 	KeyPath  string
 }
 
+// IdentitySecret holds the identity certificate chain and the private key of a data plane proxy.
+type IdentitySecret struct {
+	PemCerts [][]byte
+	PemKey   []byte
+}
+
+// CaSecret holds the root certificates of a Mesh that peers are verified against.
+type CaSecret struct {
+	PemCerts [][]byte
+}
+
 type IdentityCertRequest interface {
 	Name() string
 }
@@ -173,6 +192,19 @@ type ZoneIngressProxy struct {
 	MeshResourceList    []*MeshIngressResources
 }
 
+type MeshEgressResources struct {
+	Mesh        *core_mesh.MeshResource
+	EndpointMap EndpointMap
+	// ExternalServicesEndpointMap contains the endpoints of external services ZoneEgress originates the traffic to.
+	ExternalServicesEndpointMap EndpointMap
+	Resources                   map[core_model.ResourceType]core_model.ResourceList
+}
+
+type ZoneEgressProxy struct {
+	ZoneEgressResource *core_mesh.ZoneEgressResource
+	MeshResourcesList  []*MeshEgressResources
+}
+
 type Routing struct {
 	OutboundTargets EndpointMap
 	// ExternalServiceOutboundTargets contains endpoint map for direct access of external services (without egress)
@@ -15,37 +15,32 @@
  * limitations under the License.
  */
 
-package v3
+package dns
 
 import (
-	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
-
-	"google.golang.org/protobuf/types/known/emptypb"
+	config_core "github.com/apache/dubbo-kubernetes/pkg/config/core"
+	"github.com/apache/dubbo-kubernetes/pkg/core/dns/vips"
+	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
 )
 
-import (
-	"github.com/apache/dubbo-kubernetes/pkg/util/proto"
-)
-
-type TripleConfigurer struct{}
-
-var _ FilterChainConfigurer = &TripleConfigurer{}
-
-func (c *TripleConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
-	// use empty pbst for now
-	pbst, err := proto.MarshalAnyDeterministic(
-		&emptypb.Empty{})
+func Setup(rt core_runtime.Runtime) error {
+	cfg := rt.Config().DNSServer
+	if !cfg.ServiceVipEnabled {
+		return nil
+	}
+	if rt.Config().Mode == config_core.Global {
+		// the global control plane does not serve data plane proxies
+		return nil
+	}
+	allocator, err := NewVIPsAllocator(
+		rt.ReadOnlyResourceManager(),
+		vips.NewPersistence(rt.ConfigManager()),
+		cfg.CIDR,
+		cfg.VIPRefreshInterval.Duration,
+		cfg.VIPReleaseGracePeriod.Duration,
+	)
 	if err != nil {
 		return err
 	}
-
-	filterChain.Filters = append([]*envoy_listener.Filter{
-		{
-			Name: "envoy.filters.network.triple_connection_manager",
-			ConfigType: &envoy_listener.Filter_TypedConfig{
-				TypedConfig: pbst,
-			},
-		},
-	}, filterChain.Filters...)
-	return nil
+	return rt.Add(allocator)
 }
@@ -0,0 +1,135 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dns
+
+import (
+	"context"
+	"time"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	"github.com/apache/dubbo-kubernetes/pkg/core/dns/vips"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/core/runtime/component"
+)
+
+var vipsAllocatorLog = core.Log.WithName("dns-vips-allocator")
+
+// VIPsAllocator periodically allocates the virtual IPs of the services and the applications of every mesh and persists them.
+type VIPsAllocator struct {
+	resManager      manager.ReadOnlyResourceManager
+	persistence     *vips.Persistence
+	allocator       *vips.Allocator
+	refreshInterval time.Duration
+}
+
+var _ component.Component = &VIPsAllocator{}
+
+func NewVIPsAllocator(
+	resManager manager.ReadOnlyResourceManager,
+	persistence *vips.Persistence,
+	cidr string,
+	refreshInterval time.Duration,
+	releaseGracePeriod time.Duration,
+) (*VIPsAllocator, error) {
+	allocator, err := vips.NewAllocator(cidr, releaseGracePeriod)
+	if err != nil {
+		return nil, err
+	}
+	return &VIPsAllocator{
+		resManager:      resManager,
+		persistence:     persistence,
+		allocator:       allocator,
+		refreshInterval: refreshInterval,
+	}, nil
+}
+
+func (a *VIPsAllocator) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(a.refreshInterval)
+	defer ticker.Stop()
+	vipsAllocatorLog.Info("starting the allocation of virtual IPs", "refreshInterval", a.refreshInterval)
+	for {
+		select {
+		case <-ticker.C:
+			if err := a.CreateOrUpdateVIPConfigs(context.Background()); err != nil {
+				vipsAllocatorLog.Error(err, "could not allocate virtual IPs")
+			}
+		case <-stop:
+			vipsAllocatorLog.Info("stopping the allocation of virtual IPs")
+			return nil
+		}
+	}
+}
+
+func (a *VIPsAllocator) NeedLeaderElection() bool {
+	// only one instance can allocate the addresses, otherwise the instances would overwrite each other
+	return true
+}
+
+// CreateOrUpdateVIPConfigs allocates the virtual IPs of the services and the applications of every mesh.
+func (a *VIPsAllocator) CreateOrUpdateVIPConfigs(ctx context.Context) error {
+	meshes := core_mesh.MeshResourceList{}
+	if err := a.resManager.List(ctx, &meshes); err != nil {
+		return errors.Wrap(err, "could not list meshes")
+	}
+	var errs []error
+	for _, mesh := range meshes.Items {
+		if err := a.createOrUpdateVIPConfig(ctx, mesh.GetMeta().GetName()); err != nil {
+			errs = append(errs, errors.Wrapf(err, "mesh %q", mesh.GetMeta().GetName()))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Errorf("could not allocate virtual IPs: %v", errs)
+	}
+	return nil
+}
+
+func (a *VIPsAllocator) createOrUpdateVIPConfig(ctx context.Context, mesh string) error {
+	dataplanes := core_mesh.DataplaneResourceList{}
+	if err := a.resManager.List(ctx, &dataplanes, core_store.ListByMesh(mesh)); err != nil {
+		return errors.Wrap(err, "could not list dataplanes")
+	}
+	var entries []vips.Entry
+	for entry := range vips.Ports(dataplanes.Items) {
+		entries = append(entries, entry)
+	}
+
+	current, err := a.persistence.Get(ctx, mesh)
+	if err != nil {
+		return err
+	}
+	list, err := a.allocator.Allocate(current, entries, core.Now())
+	if err != nil {
+		return err
+	}
+	if list.Equal(current) {
+		return nil
+	}
+	if err := a.persistence.Set(ctx, mesh, list); err != nil {
+		return errors.Wrap(err, "could not persist virtual IPs")
+	}
+	vipsAllocatorLog.V(1).Info("virtual IPs updated", "mesh", mesh, "entries", len(list))
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dns_test
+
+import (
+	"context"
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	config_manager "github.com/apache/dubbo-kubernetes/pkg/core/config/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/dns/vips"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_manager "github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/dns"
+	resources_memory "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("VIPsAllocator", func() {
+	var resManager core_manager.ResourceManager
+	var persistence *vips.Persistence
+	var allocator *dns.VIPsAllocator
+	var now time.Time
+
+	createDataplane := func(name string, app string, service string, port uint32) {
+		dp := &core_mesh.DataplaneResource{
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: port,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag:  service,
+								mesh_proto.ProtocolTag: "tcp",
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(resManager.Create(context.Background(), dp, core_store.CreateByKey(name, core_model.DefaultMesh), core_store.CreateWithLabels(map[string]string{mesh_proto.AppTag: app}))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		now = time.Now().UTC().Truncate(time.Second)
+		core.Now = func() time.Time {
+			return now
+		}
+		store := resources_memory.NewStore()
+		resManager = core_manager.NewResourceManager(store)
+		persistence = vips.NewPersistence(config_manager.NewConfigManager(store))
+
+		var err error
+		allocator, err = dns.NewVIPsAllocator(resManager, persistence, "127.1.0.0/16", time.Second, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+		createDataplane("provider-1", "shop", "provider", 20880)
+		createDataplane("provider-2", "shop", "provider", 20880)
+		createDataplane("consumer-1", "", "consumer", 8080)
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	It("should allocate a virtual IP to every service and application of the mesh", func() {
+		// when
+		err := allocator.CreateOrUpdateVIPConfigs(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		list, err := persistence.Get(context.Background(), core_model.DefaultMesh)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(vips.List{
+			vips.NewApplicationEntry("shop"): {Address: "127.1.0.1"},
+			vips.NewServiceEntry("consumer"): {Address: "127.1.0.2"},
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.3"},
+		}))
+	})
+
+	It("should keep the virtual IPs of the removed services reserved for the grace period", func() {
+		// given
+		Expect(allocator.CreateOrUpdateVIPConfigs(context.Background())).To(Succeed())
+		releasedAt := now
+		Expect(resManager.Delete(context.Background(), core_mesh.NewDataplaneResource(), core_store.DeleteByKey("consumer-1", core_model.DefaultMesh))).To(Succeed())
+		Expect(allocator.CreateOrUpdateVIPConfigs(context.Background())).To(Succeed())
+
+		// when
+		now = now.Add(30 * time.Minute)
+		createDataplane("backend-1", "", "backend", 9090)
+		err := allocator.CreateOrUpdateVIPConfigs(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		list, err := persistence.Get(context.Background(), core_model.DefaultMesh)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(vips.List{
+			vips.NewApplicationEntry("shop"): {Address: "127.1.0.1"},
+			vips.NewServiceEntry("backend"):  {Address: "127.1.0.4"},
+			vips.NewServiceEntry("consumer"): {Address: "127.1.0.2", ReleasedAt: &releasedAt},
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.3"},
+		}))
+	})
+
+	It("should reuse the virtual IPs of the removed services after the grace period", func() {
+		// given
+		Expect(allocator.CreateOrUpdateVIPConfigs(context.Background())).To(Succeed())
+		Expect(resManager.Delete(context.Background(), core_mesh.NewDataplaneResource(), core_store.DeleteByKey("consumer-1", core_model.DefaultMesh))).To(Succeed())
+		Expect(allocator.CreateOrUpdateVIPConfigs(context.Background())).To(Succeed())
+
+		// when
+		now = now.Add(time.Hour)
+		createDataplane("backend-1", "", "backend", 9090)
+		err := allocator.CreateOrUpdateVIPConfigs(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		list, err := persistence.Get(context.Background(), core_model.DefaultMesh)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal(vips.List{
+			vips.NewApplicationEntry("shop"): {Address: "127.1.0.1"},
+			vips.NewServiceEntry("backend"):  {Address: "127.1.0.2"},
+			vips.NewServiceEntry("provider"): {Address: "127.1.0.3"},
+		}))
+	})
+})
@@ -44,6 +44,7 @@ import (
 	dp_server "github.com/apache/dubbo-kubernetes/pkg/config/dp-server"
 	"github.com/apache/dubbo-kubernetes/pkg/core"
 	"github.com/apache/dubbo-kubernetes/pkg/core/runtime/component"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/auth"
 )
 
 var log = core.Log.WithName("dp-server")
@@ -65,7 +66,9 @@ type DpServer struct {
 
 var _ component.Component = &DpServer{}
 
-func NewDpServer(config dp_server.DpServerConfig, filter Filter) *DpServer {
+// NewDpServer creates the server of the data plane APIs. Every gRPC service registered
+// on the server is guarded by the authenticator.
+func NewDpServer(config dp_server.DpServerConfig, filter Filter, authenticator auth.Authenticator, resolver auth.ProxyResolver) *DpServer {
 	grpcOptions := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(grpcMaxConcurrentStreams),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
@@ -76,6 +79,8 @@ func NewDpServer(config dp_server.DpServerConfig, filter Filter) *DpServer {
 			MinTime:             grpcKeepAliveTime,
 			PermitWithoutStream: true,
 		}),
+		grpc.ChainUnaryInterceptor(auth.UnaryAuthenticationInterceptor(authenticator)),
+		grpc.ChainStreamInterceptor(auth.StreamAuthenticationInterceptor(authenticator, resolver)),
 	}
 	grpcServer := grpc.NewServer(grpcOptions...)
 
@@ -46,9 +46,12 @@ type stream struct {
 	subscribedInterfaceNames map[string]struct{}
 	// subscribedApplicationNames records request's applicationName in MetaDataSync Request from data plane.
 	subscribedApplicationNames map[string]struct{}
+	// subscribedRuleKeys records the keys of the last RuleSync Request from data plane, grouped by rule type.
+	subscribedRuleKeys map[core_model.ResourceType]map[string]struct{}
 
 	mappingLastNonce  string
 	metadataLastNonce string
+	ruleLastNonces    map[core_model.ResourceType]string
 	mu                sync.RWMutex
 }
 
@@ -58,6 +61,9 @@ func NewDubboSyncStream(streamClient grpc.ServerStream) DubboSyncStream {
 
 		subscribedInterfaceNames:   make(map[string]struct{}),
 		subscribedApplicationNames: make(map[string]struct{}),
+		subscribedRuleKeys:         make(map[core_model.ResourceType]map[string]struct{}),
+
+		ruleLastNonces: make(map[core_model.ResourceType]string),
 	}
 }
 
@@ -66,6 +72,7 @@ type DubboSyncStream interface {
 	Send(resourceList core_model.ResourceList, revision int64) error
 	SubscribedInterfaceNames() []string
 	SubscribedApplicationNames() []string
+	SubscribedRuleKeys(ruleType core_model.ResourceType) []string
 }
 
 func (s *stream) Recv() (proto.Message, error) {
@@ -84,7 +91,7 @@ func (s *stream) Recv() (proto.Message, error) {
 		s.mu.Lock()
 		interfaceName := request.GetInterfaceName()
 		s.subscribedInterfaceNames[interfaceName] = struct{}{}
-		s.mu.Lock()
+		s.mu.Unlock()
 
 		return request, nil
 	case mesh_proto.MetadataService_MetadataSyncServer:
@@ -101,17 +108,66 @@ func (s *stream) Recv() (proto.Message, error) {
 		s.mu.Lock()
 		appName := request.GetApplicationName()
 		s.subscribedApplicationNames[appName] = struct{}{}
-		s.mu.Lock()
+		s.mu.Unlock()
 
 		return request, nil
+	case mesh_proto.RuleService_RuleSyncServer:
+		return s.recvRuleSyncRequest()
 	default:
 		return nil, errors.New("unknown type request")
 	}
 }
 
+// recvRuleSyncRequest receives the next RuleSyncRequest of a known rule type. The keys of the request
+// replace the ones subscribed before for the rule type. A request of an unknown rule type is answered
+// with an error response instead of closing the stream.
+func (s *stream) recvRuleSyncRequest() (*mesh_proto.RuleSyncRequest, error) {
+	for {
+		request := &mesh_proto.RuleSyncRequest{}
+		err := s.streamClient.RecvMsg(request)
+		if err != nil {
+			return nil, err
+		}
+		ruleType := core_model.ResourceType(request.GetRuleType())
+		if !isRuleType(ruleType) {
+			if err := s.sendRuleSyncError(request, errors.Errorf("unknown rule type %q in rule sync request", ruleType)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// subscribe Rules
+		s.mu.Lock()
+		if lastNonce := s.ruleLastNonces[ruleType]; lastNonce != "" && lastNonce != request.GetNonce() {
+			s.mu.Unlock()
+			return nil, errors.New("rule sync request's nonce is different to last nonce")
+		}
+		keys := make(map[string]struct{}, len(request.GetKeys()))
+		for _, key := range request.GetKeys() {
+			keys[key] = struct{}{}
+		}
+		s.subscribedRuleKeys[ruleType] = keys
+		s.mu.Unlock()
+
+		return request, nil
+	}
+}
+
+func (s *stream) sendRuleSyncError(request *mesh_proto.RuleSyncRequest, err error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.streamClient.SendMsg(&mesh_proto.RuleSyncResponse{
+		Nonce:       uuid.NewString(),
+		RuleType:    request.GetRuleType(),
+		ErrorDetail: err.Error(),
+	})
+}
+
 func (s *stream) Send(resourceList core_model.ResourceList, revision int64) error {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	// grpc stream does not support concurrent SendMsg, and nonces are updated here
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	nonce := uuid.NewString()
 
@@ -152,6 +208,51 @@ func (s *stream) Send(resourceList core_model.ResourceList, revision int64) erro
 			MetaDatum: metaDatum,
 		}
 		return s.streamClient.SendMsg(response)
+	case *core_mesh.TagRouteResourceList:
+		tagRouteList := resourceList.(*core_mesh.TagRouteResourceList)
+		tagRoutes := make([]*mesh_proto.TagRoute, 0, len(tagRouteList.Items))
+		for _, item := range tagRouteList.Items {
+			tagRoutes = append(tagRoutes, item.Spec)
+		}
+
+		s.ruleLastNonces[core_mesh.TagRouteType] = nonce
+		response := &mesh_proto.RuleSyncResponse{
+			Nonce:     nonce,
+			Revision:  revision,
+			RuleType:  string(core_mesh.TagRouteType),
+			TagRoutes: tagRoutes,
+		}
+		return s.streamClient.SendMsg(response)
+	case *core_mesh.ConditionRouteResourceList:
+		conditionRouteList := resourceList.(*core_mesh.ConditionRouteResourceList)
+		conditionRoutes := make([]*mesh_proto.ConditionRoute, 0, len(conditionRouteList.Items))
+		for _, item := range conditionRouteList.Items {
+			conditionRoutes = append(conditionRoutes, item.Spec)
+		}
+
+		s.ruleLastNonces[core_mesh.ConditionRouteType] = nonce
+		response := &mesh_proto.RuleSyncResponse{
+			Nonce:           nonce,
+			Revision:        revision,
+			RuleType:        string(core_mesh.ConditionRouteType),
+			ConditionRoutes: conditionRoutes,
+		}
+		return s.streamClient.SendMsg(response)
+	case *core_mesh.DynamicConfigResourceList:
+		dynamicConfigList := resourceList.(*core_mesh.DynamicConfigResourceList)
+		dynamicConfigs := make([]*mesh_proto.DynamicConfig, 0, len(dynamicConfigList.Items))
+		for _, item := range dynamicConfigList.Items {
+			dynamicConfigs = append(dynamicConfigs, item.Spec)
+		}
+
+		s.ruleLastNonces[core_mesh.DynamicConfigType] = nonce
+		response := &mesh_proto.RuleSyncResponse{
+			Nonce:          nonce,
+			Revision:       revision,
+			RuleType:       string(core_mesh.DynamicConfigType),
+			DynamicConfigs: dynamicConfigs,
+		}
+		return s.streamClient.SendMsg(response)
 	default:
 		return errors.New("unknown type request")
 	}
@@ -180,3 +281,24 @@ func (s *stream) SubscribedApplicationNames() []string {
 
 	return result
 }
+
+func (s *stream) SubscribedRuleKeys(ruleType core_model.ResourceType) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]string, 0, len(s.subscribedRuleKeys[ruleType]))
+	for key := range s.subscribedRuleKeys[ruleType] {
+		result = append(result, key)
+	}
+
+	return result
+}
+
+func isRuleType(resourceType core_model.ResourceType) bool {
+	switch resourceType {
+	case core_mesh.TagRouteType, core_mesh.ConditionRouteType, core_mesh.DynamicConfigType:
+		return true
+	default:
+		return false
+	}
+}
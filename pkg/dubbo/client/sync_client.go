@@ -35,6 +35,7 @@ import (
 type Callbacks struct {
 	OnMappingSyncRequestReceived  func(request *mesh_proto.MappingSyncRequest) error
 	OnMetadataSyncRequestReceived func(request *mesh_proto.MetadataSyncRequest) error
+	OnRuleSyncRequestReceived     func(request *mesh_proto.RuleSyncRequest) error
 }
 
 // DubboSyncClient Handle Dubbo Sync Request from client
@@ -96,6 +97,13 @@ func (s *dubboSyncClient) HandleReceive() error {
 			} else {
 				s.log.Info("OnMetadataSyncRequestReceived successed")
 			}
+		case *mesh_proto.RuleSyncRequest:
+			err = s.callbacks.OnRuleSyncRequestReceived(received.(*mesh_proto.RuleSyncRequest))
+			if err != nil {
+				s.log.Error(err, "error in OnRuleSyncRequestReceived")
+			} else {
+				s.log.Info("OnRuleSyncRequestReceived successed")
+			}
 		default:
 			return errors.New("unknown type request")
 		}
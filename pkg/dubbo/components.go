@@ -34,6 +34,7 @@ import (
 	core_runtime "github.com/apache/dubbo-kubernetes/pkg/core/runtime"
 	dubbo_metadata "github.com/apache/dubbo-kubernetes/pkg/dubbo/metadata"
 	"github.com/apache/dubbo-kubernetes/pkg/dubbo/pusher"
+	dubbo_rule "github.com/apache/dubbo-kubernetes/pkg/dubbo/rule"
 	dubbo_mapping "github.com/apache/dubbo-kubernetes/pkg/dubbo/servicemapping"
 	k8s_extensions "github.com/apache/dubbo-kubernetes/pkg/plugins/extensions/k8s"
 )
@@ -59,10 +60,10 @@ func Setup(rt core_runtime.Runtime) error {
 	dubboPusher := pusher.NewPusher(rt.ResourceManager(), rt.EventBus(), func() *time.Ticker {
 		// todo: should configured by config in the future
 		return time.NewTicker(time.Minute * 10)
-	}, []core_model.ResourceType{
+	}, append([]core_model.ResourceType{
 		core_mesh.MappingType,
 		core_mesh.MetaDataType,
-	})
+	}, dubbo_rule.RuleTypes...))
 
 	// register ServiceNameMappingService
 	serviceMapping := dubbo_mapping.NewSnpServer(
@@ -91,5 +92,9 @@ func Setup(rt core_runtime.Runtime) error {
 		rt.Config().Store.Kubernetes.SystemNamespace,
 	)
 	mesh_proto.RegisterMetadataServiceServer(rt.DpServer().GrpcServer(), metadata)
+
+	// register RuleService
+	rule := dubbo_rule.NewRuleServer(dubboPusher)
+	mesh_proto.RegisterRuleServiceServer(rt.DpServer().GrpcServer(), rule)
 	return rt.Add(dubboPusher, serviceMapping, metadata)
 }
@@ -229,7 +229,7 @@ func (m *MetadataServer) MetadataSync(stream mesh_proto.MetadataService_Metadata
 			}
 
 			// only send Metadata which client subscribed
-			newResourceList := &core_mesh.MeshResourceList{}
+			newResourceList := &core_mesh.MetaDataResourceList{}
 			for _, resource := range resourceList.GetItems() {
 				expected := false
 				metaData := resource.(*core_mesh.MetaDataResource)
@@ -28,6 +28,10 @@ type Pusher interface {
 	// AddCallback add callback for target resource type using id
 	// for example, id is a unique id for every client, when resource changed for target resourceType, it will invoke callback
 	AddCallback(resourceType core_model.ResourceType, id string, callback ResourceChangedCallbackFn, filters ...ResourceChangedEventFilter)
+	// AddDedupCallback add callback like AddCallback, but the callback is only invoked when the filtered resources
+	// differ from the last pushed ones, and it is invoked with an empty list to answer a request or to drop
+	// the resources which no longer match.
+	AddDedupCallback(resourceType core_model.ResourceType, id string, callback ResourceChangedCallbackFn, filters ...ResourceChangedEventFilter)
 	// RemoveCallback remove callback
 	RemoveCallback(resourceType core_model.ResourceType, id string)
 	// InvokeCallback invoke a target callback
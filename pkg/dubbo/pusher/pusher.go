@@ -193,19 +193,29 @@ func (p *pusher) Start(stop <-chan struct{}) error {
 				continue
 			}
 
-			revision := p.resourceRevisions[resourceType]
 			lastedPushed := p.resourceLastPushed[resourceType]
 			if lastedPushed == nil {
-				log.Info("last pushed is nil", "ResourceType", resourceType, "id", id)
-				continue
+				// nothing has been pushed yet, load the current resources so that the request can be answered.
+				resourceList, err := registry.Global().NewList(resourceType)
+				if err != nil {
+					log.Error(err, "failed to get resourceList")
+					continue
+				}
+				if err := p.resourceManager.List(ctx, resourceList); err != nil {
+					log.Error(err, "list resource failed", "ResourceType", resourceType)
+					continue
+				}
+				p.resourceLastPushed[resourceType] = resourceList
+				lastedPushed = resourceList
 			}
+			revision := p.resourceRevisions[resourceType]
 
 			resourceList := lastedPushed
 			if req.requestFilter != nil {
 				resourceList = req.requestFilter(req.request, lastedPushed)
 			}
 
-			cb.Invoke(PushedItems{
+			cb.InvokeRequested(PushedItems{
 				resourceList: resourceList,
 				revision:     revision,
 			})
@@ -237,6 +247,10 @@ func (p *pusher) AddCallback(resourceType core_model.ResourceType, id string, ca
 	p.resourceChangedCallbacks.AddCallBack(resourceType, id, callback, filters...)
 }
 
+func (p *pusher) AddDedupCallback(resourceType core_model.ResourceType, id string, callback ResourceChangedCallbackFn, filters ...ResourceChangedEventFilter) {
+	p.resourceChangedCallbacks.AddDedupCallBack(resourceType, id, callback, filters...)
+}
+
 func (p *pusher) RemoveCallback(resourceType core_model.ResourceType, id string) {
 	p.resourceChangedCallbacks.RemoveCallBack(resourceType, id)
 }
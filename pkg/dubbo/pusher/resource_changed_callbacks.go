@@ -18,6 +18,7 @@
 package pusher
 
 import (
+	"reflect"
 	"sync"
 )
 
@@ -35,22 +36,61 @@ type ResourceChangedCallback struct {
 	mu       sync.Mutex // Only one can run at a time
 	Callback ResourceChangedCallbackFn
 	Filters  []ResourceChangedEventFilter
+
+	// Dedup makes the callback skip resources which are equal to the last pushed ones,
+	// and push empty lists once something was pushed, so that the client drops the resources
+	// which no longer match. Requests are always answered, even with an empty list.
+	Dedup bool
+	// lastPushed is the filtered resource list which was pushed last time, it is only kept with Dedup.
+	lastPushed core_model.ResourceList
 }
 
 func (c *ResourceChangedCallback) Invoke(items PushedItems) {
+	c.invoke(items, false)
+}
+
+// InvokeRequested invokes the callback to answer a request of the client.
+func (c *ResourceChangedCallback) InvokeRequested(items PushedItems) {
+	c.invoke(items, true)
+}
+
+func (c *ResourceChangedCallback) invoke(items PushedItems, requested bool) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	pushed := items.resourceList
+	if pushed == nil {
+		return
+	}
 	for _, filter := range c.Filters {
-		pushed = filter(items.resourceList)
+		pushed = filter(pushed)
+		if pushed == nil {
+			return
+		}
 	}
-	if len(pushed.GetItems()) == 0 {
+
+	if c.Dedup {
+		if !requested && !c.changed(pushed) {
+			return
+		}
+		c.lastPushed = pushed
+	} else if len(pushed.GetItems()) == 0 {
 		return
 	}
 
 	callback := c.Callback
-	callback(items)
+	callback(PushedItems{
+		resourceList: pushed,
+		revision:     items.revision,
+	})
+}
+
+// changed returns whether the filtered resources differ from the last pushed ones.
+func (c *ResourceChangedCallback) changed(pushed core_model.ResourceList) bool {
+	if c.lastPushed == nil || len(c.lastPushed.GetItems()) == 0 {
+		return len(pushed.GetItems()) != 0
+	}
+	return !reflect.DeepEqual(c.lastPushed.GetItems(), pushed.GetItems())
 }
 
 type ResourceChangedCallbacks struct {
@@ -98,6 +138,22 @@ func (callbacks *ResourceChangedCallbacks) AddCallBack(
 	callbacks.callbackMap[resourceType][id] = &ResourceChangedCallback{Callback: callback, Filters: filters}
 }
 
+func (callbacks *ResourceChangedCallbacks) AddDedupCallBack(
+	resourceType core_model.ResourceType,
+	id string,
+	callback ResourceChangedCallbackFn,
+	filters ...ResourceChangedEventFilter,
+) {
+	callbacks.mu.Lock()
+	defer callbacks.mu.Unlock()
+
+	if _, ok := callbacks.callbackMap[resourceType]; !ok {
+		callbacks.callbackMap[resourceType] = make(map[string]*ResourceChangedCallback)
+	}
+
+	callbacks.callbackMap[resourceType][id] = &ResourceChangedCallback{Callback: callback, Filters: filters, Dedup: true}
+}
+
 func (callbacks *ResourceChangedCallbacks) RemoveCallBack(resourceType core_model.ResourceType, id string) {
 	callbacks.mu.Lock()
 	defer callbacks.mu.Unlock()
@@ -0,0 +1,234 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pusher
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+)
+
+var _ = Describe("ResourceChangedCallback", func() {
+	tagRoute := func(name, key string) *core_mesh.TagRouteResource {
+		return &core_mesh.TagRouteResource{
+			Meta: &test_model.ResourceMeta{Name: name, Mesh: core_model.DefaultMesh},
+			Spec: &mesh_proto.TagRoute{Key: key},
+		}
+	}
+
+	onlyKey := func(key string) ResourceChangedEventFilter {
+		return func(resourceList core_model.ResourceList) core_model.ResourceList {
+			filtered := &core_mesh.TagRouteResourceList{}
+			for _, item := range resourceList.(*core_mesh.TagRouteResourceList).Items {
+				if item.Spec.Key == key {
+					_ = filtered.AddItem(item)
+				}
+			}
+			return filtered
+		}
+	}
+
+	It("should invoke callback with filtered resources and the revision", func() {
+		// given
+		var pushed []PushedItems
+		cb := &ResourceChangedCallback{
+			Callback: func(items PushedItems) {
+				pushed = append(pushed, items)
+			},
+			Filters: []ResourceChangedEventFilter{onlyKey("app-1")},
+		}
+		list := &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-1", "app-1"))).To(Succeed())
+		Expect(list.AddItem(tagRoute("rule-2", "app-2"))).To(Succeed())
+
+		// when
+		cb.Invoke(PushedItems{resourceList: list, revision: 3})
+
+		// then
+		Expect(pushed).To(HaveLen(1))
+		Expect(pushed[0].Revision()).To(Equal(int64(3)))
+		Expect(pushed[0].ResourceList().GetItems()).To(HaveLen(1))
+		Expect(pushed[0].ResourceList().GetItems()[0].GetMeta().GetName()).To(Equal("rule-1"))
+	})
+
+	It("should apply all filters in order", func() {
+		// given
+		var pushed []PushedItems
+		cb := &ResourceChangedCallback{
+			Callback: func(items PushedItems) {
+				pushed = append(pushed, items)
+			},
+			Filters: []ResourceChangedEventFilter{onlyKey("app-1"), onlyKey("app-2")},
+		}
+		list := &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-1", "app-1"))).To(Succeed())
+		Expect(list.AddItem(tagRoute("rule-2", "app-2"))).To(Succeed())
+
+		// when
+		cb.Invoke(PushedItems{resourceList: list, revision: 1})
+
+		// then nothing is left after both filters
+		Expect(pushed).To(BeEmpty())
+	})
+
+	It("should not invoke callback when a filter rejects the resource list", func() {
+		// given
+		invoked := false
+		cb := &ResourceChangedCallback{
+			Callback: func(items PushedItems) {
+				invoked = true
+			},
+			Filters: []ResourceChangedEventFilter{func(core_model.ResourceList) core_model.ResourceList {
+				return nil
+			}},
+		}
+		list := &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-1", "app-1"))).To(Succeed())
+
+		// when
+		cb.Invoke(PushedItems{resourceList: list, revision: 1})
+
+		// then
+		Expect(invoked).To(BeFalse())
+	})
+
+	It("should push an empty list when the last subscribed resource is gone", func() {
+		// given
+		var pushed []PushedItems
+		cb := &ResourceChangedCallback{
+			Callback: func(items PushedItems) {
+				pushed = append(pushed, items)
+			},
+			Filters: []ResourceChangedEventFilter{onlyKey("app-1")},
+			Dedup:   true,
+		}
+		list := &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-1", "app-1"))).To(Succeed())
+		Expect(list.AddItem(tagRoute("rule-2", "app-2"))).To(Succeed())
+		cb.Invoke(PushedItems{resourceList: list, revision: 1})
+
+		// when rule-1 is deleted while rule-2 still exists
+		list = &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-2", "app-2"))).To(Succeed())
+		cb.Invoke(PushedItems{resourceList: list, revision: 2})
+
+		// then
+		Expect(pushed).To(HaveLen(2))
+		Expect(pushed[1].Revision()).To(Equal(int64(2)))
+		Expect(pushed[1].ResourceList().GetItems()).To(BeEmpty())
+	})
+
+	It("should not push when the filtered resources did not change", func() {
+		// given
+		var pushed []PushedItems
+		cb := &ResourceChangedCallback{
+			Callback: func(items PushedItems) {
+				pushed = append(pushed, items)
+			},
+			Filters: []ResourceChangedEventFilter{onlyKey("app-1")},
+			Dedup:   true,
+		}
+		list := &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-1", "app-1"))).To(Succeed())
+		cb.Invoke(PushedItems{resourceList: list, revision: 1})
+
+		// when only a rule which is not subscribed changes
+		list = &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-1", "app-1"))).To(Succeed())
+		Expect(list.AddItem(tagRoute("rule-2", "app-2"))).To(Succeed())
+		cb.Invoke(PushedItems{resourceList: list, revision: 2})
+
+		// then
+		Expect(pushed).To(HaveLen(1))
+		Expect(pushed[0].Revision()).To(Equal(int64(1)))
+	})
+
+	It("should push unchanged resources again without dedup", func() {
+		// given
+		var pushed []PushedItems
+		cb := &ResourceChangedCallback{
+			Callback: func(items PushedItems) {
+				pushed = append(pushed, items)
+			},
+			Filters: []ResourceChangedEventFilter{onlyKey("app-1")},
+		}
+		list := &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-1", "app-1"))).To(Succeed())
+
+		// when the same resources are pushed twice, like on a full resync
+		cb.Invoke(PushedItems{resourceList: list, revision: 1})
+		cb.Invoke(PushedItems{resourceList: list, revision: 1})
+
+		// then
+		Expect(pushed).To(HaveLen(2))
+	})
+
+	It("should not push an empty list without dedup", func() {
+		// given
+		invoked := false
+		cb := &ResourceChangedCallback{
+			Callback: func(items PushedItems) {
+				invoked = true
+			},
+			Filters: []ResourceChangedEventFilter{onlyKey("app-1")},
+		}
+		list := &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-2", "app-2"))).To(Succeed())
+
+		// when
+		cb.InvokeRequested(PushedItems{resourceList: list, revision: 1})
+
+		// then
+		Expect(invoked).To(BeFalse())
+	})
+
+	It("should answer every request with dedup, even with an empty or unchanged list", func() {
+		// given
+		var pushed []PushedItems
+		cb := &ResourceChangedCallback{
+			Callback: func(items PushedItems) {
+				pushed = append(pushed, items)
+			},
+			Filters: []ResourceChangedEventFilter{onlyKey("app-1")},
+			Dedup:   true,
+		}
+		list := &core_mesh.TagRouteResourceList{}
+		Expect(list.AddItem(tagRoute("rule-2", "app-2"))).To(Succeed())
+
+		// when
+		cb.InvokeRequested(PushedItems{resourceList: list, revision: 1})
+		cb.InvokeRequested(PushedItems{resourceList: list, revision: 1})
+
+		// then
+		Expect(pushed).To(HaveLen(2))
+		Expect(pushed[0].ResourceList().GetItems()).To(BeEmpty())
+
+		// when an event does not change the subscribed resources
+		cb.Invoke(PushedItems{resourceList: list, revision: 2})
+
+		// then
+		Expect(pushed).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,208 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rule
+
+import (
+	"io"
+)
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/pkg/errors"
+
+	"google.golang.org/grpc/codes"
+
+	"google.golang.org/grpc/status"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/registry"
+	"github.com/apache/dubbo-kubernetes/pkg/dubbo/client"
+	"github.com/apache/dubbo-kubernetes/pkg/dubbo/pusher"
+)
+
+var log = core.Log.WithName("dubbo").WithName("server").WithName("rule")
+
+// RuleTypes are the resource types which are delivered by RuleSync.
+var RuleTypes = []core_model.ResourceType{
+	core_mesh.TagRouteType,
+	core_mesh.ConditionRouteType,
+	core_mesh.DynamicConfigType,
+}
+
+type RuleServer struct {
+	mesh_proto.RuleServiceServer
+
+	pusher pusher.Pusher
+}
+
+func NewRuleServer(pusher pusher.Pusher) *RuleServer {
+	return &RuleServer{
+		pusher: pusher,
+	}
+}
+
+func (r *RuleServer) RuleSync(stream mesh_proto.RuleService_RuleSyncServer) error {
+	mesh := core_model.DefaultMesh // todo: mesh
+	errChan := make(chan error, 1)
+	// sendErr never blocks once RuleSync has returned, the stream context is done by then.
+	sendErr := func(err error) {
+		select {
+		case errChan <- err:
+		case <-stream.Context().Done():
+		}
+	}
+
+	clientID := uuid.NewString()
+	ruleSyncStream := client.NewDubboSyncStream(stream)
+	// DubboSyncClient is to handle RuleSyncRequest from data plane
+	ruleSyncClient := client.NewDubboSyncClient(
+		log.WithName("client"),
+		clientID,
+		ruleSyncStream,
+		&client.Callbacks{
+			OnRuleSyncRequestReceived: func(request *mesh_proto.RuleSyncRequest) error {
+				// when received request, invoke callback.
+				// The keys of the request are subscribed already, answer with all the subscribed rules
+				// so that every response is the full view of the client and nothing is dropped by the SDK.
+				ruleType := core_model.ResourceType(request.GetRuleType())
+				r.pusher.InvokeCallback(
+					ruleType,
+					clientID,
+					request,
+					func(_ interface{}, resourceList core_model.ResourceList) core_model.ResourceList {
+						return filterRules(resourceList, mesh, ruleSyncStream.SubscribedRuleKeys(ruleType))
+					},
+				)
+				return nil
+			},
+		})
+
+	// callbacks are registered before receiving, so that the first request can be answered.
+	for _, ruleType := range RuleTypes {
+		ruleType := ruleType
+		r.pusher.AddDedupCallback(
+			ruleType,
+			ruleSyncClient.ClientID(),
+			func(items pusher.PushedItems) {
+				resourceList := items.ResourceList()
+				revision := items.Revision()
+
+				err := ruleSyncClient.Send(resourceList, revision)
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						log.Info("DubboSyncClient finished gracefully")
+						sendErr(nil)
+						return
+					}
+
+					log.Error(err, "send rule sync response failed", "ruleType", ruleType, "revision", revision)
+					sendErr(errors.Wrap(err, "DubboSyncClient send with an error"))
+				}
+			},
+			func(resourceList core_model.ResourceList) core_model.ResourceList {
+				if resourceList.GetItemType() != ruleType {
+					return nil
+				}
+
+				// only send rules which client subscribed
+				return filterRules(resourceList, mesh, ruleSyncStream.SubscribedRuleKeys(ruleType))
+			},
+		)
+	}
+
+	// in the end, remove callbacks of this client
+	defer func() {
+		for _, ruleType := range RuleTypes {
+			r.pusher.RemoveCallback(ruleType, ruleSyncClient.ClientID())
+		}
+	}()
+
+	go func() {
+		// Handle requests from client
+		err := ruleSyncClient.HandleReceive()
+		if errors.Is(err, io.EOF) {
+			log.Info("DubboSyncClient finished gracefully")
+			sendErr(nil)
+			return
+		}
+
+		log.Error(err, "DubboSyncClient finished with an error")
+		sendErr(errors.Wrap(err, "DubboSyncClient finished with an error"))
+	}()
+
+	for {
+		select {
+		case err := <-errChan:
+			if err == nil {
+				log.Info("RuleSync finished gracefully")
+				return nil
+			}
+
+			log.Error(err, "RuleSync finished with an error")
+			return status.Error(codes.Internal, err.Error())
+		case <-stream.Context().Done():
+			log.Info("RuleSync stream closed")
+			return nil
+		}
+	}
+}
+
+// filterRules returns the rules in the given mesh whose key is one of the given keys.
+// The key of a rule is the application name for application scope rules,
+// or the service key '{interface name}:{version}:{group}' for service scope rules.
+func filterRules(resourceList core_model.ResourceList, mesh string, keys []string) core_model.ResourceList {
+	expectedKeys := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		expectedKeys[key] = struct{}{}
+	}
+
+	newResourceList, err := registry.Global().NewList(resourceList.GetItemType())
+	if err != nil {
+		log.Error(err, "failed to create resource list", "ResourceType", resourceList.GetItemType())
+		return nil
+	}
+	for _, resource := range resourceList.GetItems() {
+		if resource.GetMeta().GetMesh() != mesh {
+			continue
+		}
+		if _, ok := expectedKeys[ruleKey(resource)]; ok {
+			_ = newResourceList.AddItem(resource)
+		}
+	}
+
+	return newResourceList
+}
+
+func ruleKey(resource core_model.Resource) string {
+	switch rule := resource.(type) {
+	case *core_mesh.TagRouteResource:
+		return rule.Spec.GetKey()
+	case *core_mesh.ConditionRouteResource:
+		return rule.Spec.GetKey()
+	case *core_mesh.DynamicConfigResource:
+		return rule.Spec.GetKey()
+	default:
+		return ""
+	}
+}
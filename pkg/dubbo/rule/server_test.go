@@ -0,0 +1,352 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rule
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/grpc"
+
+	"google.golang.org/protobuf/proto"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_manager "github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/dubbo/pusher"
+	"github.com/apache/dubbo-kubernetes/pkg/events"
+	resources_memory "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+)
+
+type ruleSyncServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	recvCh chan *mesh_proto.RuleSyncRequest
+	sentCh chan *mesh_proto.RuleSyncResponse
+}
+
+func (s *ruleSyncServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *ruleSyncServerStream) Send(response *mesh_proto.RuleSyncResponse) error {
+	s.sentCh <- response
+	return nil
+}
+
+func (s *ruleSyncServerStream) SendMsg(m interface{}) error {
+	return s.Send(m.(*mesh_proto.RuleSyncResponse))
+}
+
+func (s *ruleSyncServerStream) Recv() (*mesh_proto.RuleSyncRequest, error) {
+	request, more := <-s.recvCh
+	if !more {
+		return nil, io.EOF
+	}
+	return request, nil
+}
+
+func (s *ruleSyncServerStream) RecvMsg(m interface{}) error {
+	request, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	proto.Merge(m.(*mesh_proto.RuleSyncRequest), request)
+	return nil
+}
+
+var _ = Describe("filterRules", func() {
+	tagRoute := func(mesh, name, key string) *core_mesh.TagRouteResource {
+		return &core_mesh.TagRouteResource{
+			Meta: &test_model.ResourceMeta{Name: name, Mesh: mesh},
+			Spec: &mesh_proto.TagRoute{Key: key},
+		}
+	}
+
+	names := func(resourceList core_model.ResourceList) []string {
+		var result []string
+		for _, item := range resourceList.GetItems() {
+			result = append(result, item.GetMeta().GetName())
+		}
+		return result
+	}
+
+	It("should keep the rules of the given keys in the mesh", func() {
+		// given
+		resourceList := &core_mesh.TagRouteResourceList{}
+		Expect(resourceList.AddItem(tagRoute(core_model.DefaultMesh, "rule-1", "app-1"))).To(Succeed())
+		Expect(resourceList.AddItem(tagRoute(core_model.DefaultMesh, "rule-2", "app-2"))).To(Succeed())
+		Expect(resourceList.AddItem(tagRoute("other", "rule-3", "app-1"))).To(Succeed())
+
+		// when
+		filtered := filterRules(resourceList, core_model.DefaultMesh, []string{"app-1"})
+
+		// then
+		Expect(filtered.GetItemType()).To(Equal(core_mesh.TagRouteType))
+		Expect(names(filtered)).To(Equal([]string{"rule-1"}))
+	})
+
+	It("should match service scope rules by the service key", func() {
+		// given
+		resourceList := &core_mesh.ConditionRouteResourceList{}
+		Expect(resourceList.AddItem(&core_mesh.ConditionRouteResource{
+			Meta: &test_model.ResourceMeta{Name: "rule-1", Mesh: core_model.DefaultMesh},
+			Spec: &mesh_proto.ConditionRoute{Key: "org.apache.dubbo.GreetService:1.0.0:group"},
+		})).To(Succeed())
+
+		// when
+		filtered := filterRules(resourceList, core_model.DefaultMesh, []string{"org.apache.dubbo.GreetService:1.0.0:group"})
+
+		// then
+		Expect(names(filtered)).To(Equal([]string{"rule-1"}))
+	})
+
+	It("should return an empty list without keys", func() {
+		// given
+		resourceList := &core_mesh.DynamicConfigResourceList{}
+		Expect(resourceList.AddItem(&core_mesh.DynamicConfigResource{
+			Meta: &test_model.ResourceMeta{Name: "rule-1", Mesh: core_model.DefaultMesh},
+			Spec: &mesh_proto.DynamicConfig{Key: "app-1"},
+		})).To(Succeed())
+
+		// when
+		filtered := filterRules(resourceList, core_model.DefaultMesh, nil)
+
+		// then
+		Expect(filtered).ToNot(BeNil())
+		Expect(filtered.GetItems()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("RuleSync", func() {
+	var resManager core_manager.ResourceManager
+	var eventBus events.EventBus
+	var stream *ruleSyncServerStream
+	var stop chan struct{}
+	var cancel context.CancelFunc
+	var result chan error
+
+	createTagRoute := func(name, key string) {
+		Expect(resManager.Create(context.Background(), &core_mesh.TagRouteResource{
+			Spec: &mesh_proto.TagRoute{
+				Key:  key,
+				Tags: []*mesh_proto.Tag{{Name: "gray"}},
+			},
+		}, core_store.CreateByKey(name, core_model.DefaultMesh))).To(Succeed())
+		eventBus.Send(events.ResourceChangedEvent{Operation: events.Create, Type: core_mesh.TagRouteType})
+	}
+
+	deleteTagRoute := func(name string) {
+		Expect(resManager.Delete(context.Background(), core_mesh.NewTagRouteResource(), core_store.DeleteByKey(name, core_model.DefaultMesh))).To(Succeed())
+		eventBus.Send(events.ResourceChangedEvent{Operation: events.Delete, Type: core_mesh.TagRouteType})
+	}
+
+	keysOf := func(response *mesh_proto.RuleSyncResponse) []string {
+		var keys []string
+		for _, tagRoute := range response.GetTagRoutes() {
+			keys = append(keys, tagRoute.GetKey())
+		}
+		return keys
+	}
+
+	BeforeEach(func() {
+		resManager = core_manager.NewResourceManager(resources_memory.NewStore())
+		Expect(resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+
+		var err error
+		eventBus, err = events.NewEventBus(10)
+		Expect(err).ToNot(HaveOccurred())
+		rulePusher := pusher.NewPusher(resManager, eventBus, func() *time.Ticker {
+			return time.NewTicker(time.Hour)
+		}, RuleTypes)
+		stop = make(chan struct{})
+		go func(stop <-chan struct{}) {
+			_ = rulePusher.Start(stop)
+		}(stop)
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		stream = &ruleSyncServerStream{
+			ctx:    ctx,
+			recvCh: make(chan *mesh_proto.RuleSyncRequest, 1),
+			sentCh: make(chan *mesh_proto.RuleSyncResponse, 10),
+		}
+		server := NewRuleServer(rulePusher)
+		result = make(chan error, 1)
+		go func(stream *ruleSyncServerStream, result chan<- error) {
+			result <- server.RuleSync(stream)
+		}(stream, result)
+	})
+
+	AfterEach(func() {
+		cancel()
+		close(stop)
+	})
+
+	It("should push subscribed rules and their removal", func() {
+		// given
+		createTagRoute("rule-1", "app-1")
+		createTagRoute("rule-2", "app-2")
+
+		// when
+		stream.recvCh <- &mesh_proto.RuleSyncRequest{
+			RuleType: string(core_mesh.TagRouteType),
+			Keys:     []string{"app-1"},
+		}
+
+		// then
+		var response *mesh_proto.RuleSyncResponse
+		Eventually(stream.sentCh, "5s").Should(Receive(&response))
+		Expect(response.GetRuleType()).To(Equal(string(core_mesh.TagRouteType)))
+		Expect(keysOf(response)).To(Equal([]string{"app-1"}))
+
+		// when the subscribed rule is deleted while another rule is left
+		deleteTagRoute("rule-1")
+
+		// then an empty list is pushed
+		Eventually(stream.sentCh, "5s").Should(Receive(&response))
+		Expect(response.GetTagRoutes()).To(BeEmpty())
+
+		// when a rule which is not subscribed changes
+		createTagRoute("rule-3", "app-3")
+
+		// then nothing is pushed
+		Consistently(stream.sentCh, "200ms").ShouldNot(Receive())
+	})
+
+	It("should answer a subscription without rules with an empty list", func() {
+		// given
+		createTagRoute("rule-2", "app-2")
+
+		// when
+		stream.recvCh <- &mesh_proto.RuleSyncRequest{
+			RuleType: string(core_mesh.TagRouteType),
+			Keys:     []string{"app-1"},
+		}
+
+		// then
+		var response *mesh_proto.RuleSyncResponse
+		Eventually(stream.sentCh, "5s").Should(Receive(&response))
+		Expect(response.GetRuleType()).To(Equal(string(core_mesh.TagRouteType)))
+		Expect(response.GetTagRoutes()).To(BeEmpty())
+
+		// when the subscribed rule is created
+		createTagRoute("rule-1", "app-1")
+
+		// then it is pushed
+		Eventually(stream.sentCh, "5s").Should(Receive(&response))
+		Expect(keysOf(response)).To(Equal([]string{"app-1"}))
+	})
+
+	It("should replace the subscribed keys on every request", func() {
+		// given
+		createTagRoute("rule-1", "app-1")
+		createTagRoute("rule-2", "app-2")
+		stream.recvCh <- &mesh_proto.RuleSyncRequest{
+			RuleType: string(core_mesh.TagRouteType),
+			Keys:     []string{"app-1"},
+		}
+		var response *mesh_proto.RuleSyncResponse
+		Eventually(stream.sentCh, "5s").Should(Receive(&response))
+		Expect(keysOf(response)).To(Equal([]string{"app-1"}))
+
+		// when
+		stream.recvCh <- &mesh_proto.RuleSyncRequest{
+			Nonce:    response.GetNonce(),
+			RuleType: string(core_mesh.TagRouteType),
+			Keys:     []string{"app-2"},
+		}
+
+		// then
+		Eventually(stream.sentCh, "5s").Should(Receive(&response))
+		Expect(keysOf(response)).To(Equal([]string{"app-2"}))
+
+		// when the client unsubscribes
+		stream.recvCh <- &mesh_proto.RuleSyncRequest{
+			Nonce:    response.GetNonce(),
+			RuleType: string(core_mesh.TagRouteType),
+		}
+
+		// then
+		Eventually(stream.sentCh, "5s").Should(Receive(&response))
+		Expect(response.GetTagRoutes()).To(BeEmpty())
+
+		// when a rule which was subscribed before changes
+		deleteTagRoute("rule-1")
+
+		// then nothing is pushed
+		Consistently(stream.sentCh, "200ms").ShouldNot(Receive())
+	})
+
+	It("should answer an unknown rule type with an error and keep the stream open", func() {
+		// given
+		createTagRoute("rule-1", "app-1")
+
+		// when
+		stream.recvCh <- &mesh_proto.RuleSyncRequest{
+			RuleType: "ServiceRoute",
+			Keys:     []string{"app-1"},
+		}
+
+		// then
+		var response *mesh_proto.RuleSyncResponse
+		Eventually(stream.sentCh, "5s").Should(Receive(&response))
+		Expect(response.GetRuleType()).To(Equal("ServiceRoute"))
+		Expect(response.GetErrorDetail()).To(Equal(`unknown rule type "ServiceRoute" in rule sync request`))
+		Consistently(result, "200ms").ShouldNot(Receive())
+
+		// when
+		stream.recvCh <- &mesh_proto.RuleSyncRequest{
+			RuleType: string(core_mesh.TagRouteType),
+			Keys:     []string{"app-1"},
+		}
+
+		// then
+		Eventually(stream.sentCh, "5s").Should(Receive(&response))
+		Expect(response.GetErrorDetail()).To(BeEmpty())
+		Expect(keysOf(response)).To(Equal([]string{"app-1"}))
+	})
+
+	It("should finish when the client closes the stream", func() {
+		// when
+		close(stream.recvCh)
+
+		// then
+		Eventually(result, "5s").Should(Receive(BeNil()))
+	})
+
+	It("should finish when the stream context is done", func() {
+		// when
+		cancel()
+
+		// then
+		Eventually(result, "5s").Should(Receive(BeNil()))
+	})
+})
@@ -218,23 +218,7 @@ func (s *SnpServer) MappingSync(stream mesh_proto.ServiceNameMappingService_Mapp
 			}
 
 			// only send Mapping which client subscribed
-			newResourceList := &core_mesh.MeshResourceList{}
-			for _, resource := range resourceList.GetItems() {
-				expected := false
-				for _, interfaceName := range mappingSyncStream.SubscribedInterfaceNames() {
-					if interfaceName == resource.GetMeta().GetName() && mesh == resource.GetMeta().GetMesh() {
-						expected = true
-						break
-					}
-				}
-
-				if expected {
-					// find
-					_ = newResourceList.AddItem(resource)
-				}
-			}
-
-			return newResourceList
+			return filterSubscribedMappings(mesh, mappingSyncStream.SubscribedInterfaceNames(), resourceList)
 		},
 	)
 
@@ -255,6 +239,25 @@ func (s *SnpServer) MappingSync(stream mesh_proto.ServiceNameMappingService_Mapp
 	}
 }
 
+// filterSubscribedMappings keeps the Mappings of the mesh whose interface name the client subscribed to.
+// Resource names are a k8s-safe rewrite of the interface name, so the match is done on the spec.
+func filterSubscribedMappings(mesh string, interfaceNames []string, resourceList core_model.ResourceList) core_model.ResourceList {
+	newResourceList := &core_mesh.MappingResourceList{}
+	for _, resource := range resourceList.GetItems() {
+		mapping, ok := resource.(*core_mesh.MappingResource)
+		if !ok || mesh != mapping.GetMeta().GetMesh() {
+			continue
+		}
+		for _, interfaceName := range interfaceNames {
+			if interfaceName == mapping.Spec.GetInterfaceName() {
+				_ = newResourceList.AddItem(mapping)
+				break
+			}
+		}
+	}
+	return newResourceList
+}
+
 func (s *SnpServer) debounce(stopCh <-chan struct{}, pushFn func(m *RegisterRequest)) {
 	ch := s.queue
 	var timeChan <-chan time.Time
@@ -0,0 +1,106 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package servicemapping
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/util/rmkey"
+)
+
+var _ = Describe("filterSubscribedMappings", func() {
+	mapping := func(mesh, interfaceName string) *core_mesh.MappingResource {
+		return &core_mesh.MappingResource{
+			Meta: &test_model.ResourceMeta{
+				Name: rmkey.GenerateMappingResourceKey(interfaceName, "dubbo-system"),
+				Mesh: mesh,
+			},
+			Spec: &mesh_proto.Mapping{
+				InterfaceName:    interfaceName,
+				ApplicationNames: []string{"app"},
+			},
+		}
+	}
+
+	names := func(resourceList core_model.ResourceList) []string {
+		var result []string
+		for _, item := range resourceList.(*core_mesh.MappingResourceList).Items {
+			result = append(result, item.Spec.InterfaceName)
+		}
+		return result
+	}
+
+	It("should match subscriptions on the interface name of the spec", func() {
+		// given
+		resourceList := &core_mesh.MappingResourceList{}
+		Expect(resourceList.AddItem(mapping(core_model.DefaultMesh, "org.apache.dubbo.GreetService"))).To(Succeed())
+		Expect(resourceList.AddItem(mapping(core_model.DefaultMesh, "org.apache.dubbo.DemoService"))).To(Succeed())
+
+		// when
+		filtered := filterSubscribedMappings(core_model.DefaultMesh, []string{"org.apache.dubbo.GreetService"}, resourceList)
+
+		// then
+		Expect(names(filtered)).To(Equal([]string{"org.apache.dubbo.GreetService"}))
+	})
+
+	It("should match mappings whose resource name is a rewrite of the interface name", func() {
+		// given the name the kubernetes store gives a Mapping, which matching on the resource name missed
+		greet := mapping(core_model.DefaultMesh, "org.apache.dubbo.GreetService")
+		Expect(greet.GetMeta().GetName()).To(Equal("org-apache-dubbo-greetservice.dubbo-system"))
+		resourceList := &core_mesh.MappingResourceList{}
+		Expect(resourceList.AddItem(greet)).To(Succeed())
+
+		// when
+		filtered := filterSubscribedMappings(core_model.DefaultMesh, []string{"org.apache.dubbo.GreetService"}, resourceList)
+
+		// then
+		Expect(names(filtered)).To(Equal([]string{"org.apache.dubbo.GreetService"}))
+	})
+
+	It("should skip mappings of other meshes", func() {
+		// given
+		resourceList := &core_mesh.MappingResourceList{}
+		Expect(resourceList.AddItem(mapping("other", "org.apache.dubbo.GreetService"))).To(Succeed())
+
+		// when
+		filtered := filterSubscribedMappings(core_model.DefaultMesh, []string{"org.apache.dubbo.GreetService"}, resourceList)
+
+		// then
+		Expect(filtered.GetItems()).To(BeEmpty())
+	})
+
+	It("should return nothing without subscriptions", func() {
+		// given
+		resourceList := &core_mesh.MappingResourceList{}
+		Expect(resourceList.AddItem(mapping(core_model.DefaultMesh, "org.apache.dubbo.GreetService"))).To(Succeed())
+
+		// when
+		filtered := filterSubscribedMappings(core_model.DefaultMesh, nil, resourceList)
+
+		// then
+		Expect(filtered.GetItems()).To(BeEmpty())
+	})
+})
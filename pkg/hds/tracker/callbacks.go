@@ -173,7 +173,8 @@ func (t *tracker) OnEndpointHealthResponse(streamID xds.StreamID, resp *envoy_se
 			continue
 		}
 		status := clusterHealth.LocalityEndpointsHealth[0].EndpointsHealth[0].HealthStatus
-		health := status == envoy_core.HealthStatus_HEALTHY || status == envoy_core.HealthStatus_UNKNOWN
+		// degraded endpoints still serve the requests, e.g. an HTTP health check responded with x-envoy-degraded
+		health := status == envoy_core.HealthStatus_HEALTHY || status == envoy_core.HealthStatus_UNKNOWN || status == envoy_core.HealthStatus_DEGRADED
 
 		if clusterHealth.ClusterName == names.GetEnvoyAdminClusterName() {
 			envoyHealth = health
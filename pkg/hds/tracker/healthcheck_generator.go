@@ -25,6 +25,7 @@ import (
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_service_health "github.com/envoyproxy/go-control-plane/envoy/service/health/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -42,6 +43,15 @@ import (
 	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy/names"
 )
 
+const (
+	// dubboHeartbeatRequest is a hex encoded heartbeat event of the Dubbo protocol, which every Dubbo server echoes:
+	// magic 0xdabb, flags of a two-way event request serialized with hessian2, request id 1 and a null body.
+	dubboHeartbeatRequest = "dabbe2000000000000000001000000014e"
+	// dubboHeartbeatResponse is a hex encoded beginning of the response to dubboHeartbeatRequest:
+	// magic 0xdabb, flags of an event response serialized with hessian2 and the OK status.
+	dubboHeartbeatResponse = "dabb2214"
+)
+
 type SnapshotGenerator struct {
 	config                  *dp_server.HdsConfig
 	readOnlyResourceManager manager.ReadOnlyResourceManager
@@ -89,7 +99,7 @@ func (g *SnapshotGenerator) GenerateSnapshot(ctx context.Context, node *envoy_co
 		}
 
 		var interval *durationpb.Duration
-		if serviceProbe.Timeout == nil {
+		if serviceProbe.Interval == nil {
 			interval = util_proto.Duration(g.config.CheckDefaults.Interval.Duration)
 		} else {
 			interval = serviceProbe.Interval
@@ -109,6 +119,15 @@ func (g *SnapshotGenerator) GenerateSnapshot(ctx context.Context, node *envoy_co
 			unhealthyThreshold = serviceProbe.UnhealthyThreshold
 		}
 
+		healthCheck := &envoy_core.HealthCheck{
+			Timeout:            timeout,
+			Interval:           interval,
+			HealthyThreshold:   healthyThreshold,
+			UnhealthyThreshold: unhealthyThreshold,
+			NoTrafficInterval:  util_proto.Duration(g.config.CheckDefaults.NoTrafficInterval.Duration),
+		}
+		g.configureHealthChecker(healthCheck, mesh.ParseProtocol(inbound.GetProtocol()))
+
 		hc := &envoy_service_health.ClusterHealthCheck{
 			ClusterName: names.GetLocalClusterName(intf.WorkloadPort),
 			LocalityEndpoints: []*envoy_service_health.LocalityEndpoints{{
@@ -125,18 +144,7 @@ func (g *SnapshotGenerator) GenerateSnapshot(ctx context.Context, node *envoy_co
 					},
 				}},
 			}},
-			HealthChecks: []*envoy_core.HealthCheck{
-				{
-					Timeout:            timeout,
-					Interval:           interval,
-					HealthyThreshold:   healthyThreshold,
-					UnhealthyThreshold: unhealthyThreshold,
-					NoTrafficInterval:  util_proto.Duration(g.config.CheckDefaults.NoTrafficInterval.Duration),
-					HealthChecker: &envoy_core.HealthCheck_TcpHealthCheck_{
-						TcpHealthCheck: &envoy_core.HealthCheck_TcpHealthCheck{},
-					},
-				},
-			},
+			HealthChecks: []*envoy_core.HealthCheck{healthCheck},
 		}
 
 		healthChecks = append(healthChecks, hc)
@@ -150,6 +158,44 @@ func (g *SnapshotGenerator) GenerateSnapshot(ctx context.Context, node *envoy_co
 	return cache.NewSnapshot("", hcs), nil
 }
 
+// configureHealthChecker picks the health checker by the protocol of the inbound, so a provider
+// which accepts connections but can't handle the requests is not considered healthy.
+func (g *SnapshotGenerator) configureHealthChecker(healthCheck *envoy_core.HealthCheck, protocol mesh.Protocol) {
+	switch {
+	// Triple servers implement the gRPC health checking protocol
+	case protocol == mesh.ProtocolGRPC || protocol == mesh.ProtocolTriple:
+		healthCheck.HealthChecker = &envoy_core.HealthCheck_GrpcHealthCheck_{
+			GrpcHealthCheck: &envoy_core.HealthCheck_GrpcHealthCheck{},
+		}
+	// without the path there is nothing to request, so the HTTP inbounds fall back to the TCP check
+	case (protocol == mesh.ProtocolHTTP || protocol == mesh.ProtocolHTTP2) && g.config.CheckDefaults.HttpPath != "":
+		httpHealthCheck := &envoy_core.HealthCheck_HttpHealthCheck{
+			Path: g.config.CheckDefaults.HttpPath,
+		}
+		if protocol == mesh.ProtocolHTTP2 {
+			httpHealthCheck.CodecClientType = envoy_type.CodecClientType_HTTP2
+		}
+		healthCheck.HealthChecker = &envoy_core.HealthCheck_HttpHealthCheck_{
+			HttpHealthCheck: httpHealthCheck,
+		}
+	case protocol == mesh.ProtocolDubbo:
+		healthCheck.HealthChecker = &envoy_core.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: &envoy_core.HealthCheck_TcpHealthCheck{
+				Send: &envoy_core.HealthCheck_Payload{
+					Payload: &envoy_core.HealthCheck_Payload_Text{Text: dubboHeartbeatRequest},
+				},
+				Receive: []*envoy_core.HealthCheck_Payload{{
+					Payload: &envoy_core.HealthCheck_Payload_Text{Text: dubboHeartbeatResponse},
+				}},
+			},
+		}
+	default:
+		healthCheck.HealthChecker = &envoy_core.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: &envoy_core.HealthCheck_TcpHealthCheck{},
+		}
+	}
+}
+
 // envoyHealthCheck builds a HC for Envoy itself so when Envoy is in draining state HDS can report that DP is offline
 func (g *SnapshotGenerator) envoyHealthCheck(port uint32) *envoy_service_health.ClusterHealthCheck {
 	return &envoy_service_health.ClusterHealthCheck{
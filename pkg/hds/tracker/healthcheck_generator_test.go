@@ -143,6 +143,95 @@ networking:
         tcp: {}
       tags:
         dubbo.io/service: backend
+`,
+			hdsConfig: &dp_server.HdsConfig{
+				Interval: config_types.Duration{Duration: 8 * time.Second},
+				Enabled:  true,
+				CheckDefaults: &dp_server.HdsCheck{
+					Interval:           config_types.Duration{Duration: 1 * time.Second},
+					NoTrafficInterval:  config_types.Duration{Duration: 2 * time.Second},
+					Timeout:            config_types.Duration{Duration: 3 * time.Second},
+					HealthyThreshold:   4,
+					UnhealthyThreshold: 5,
+				},
+			},
+		}),
+		Entry("should generate HealthCheckSpecifier with health checkers by protocol", testCase{
+			goldenFile: "hds.4.golden.yaml",
+			dataplane: `
+networking:
+  address: 10.20.0.1
+  inbound:
+    - port: 9000
+      servicePort: 8080
+      serviceProbe: {}
+      tags:
+        dubbo.io/service: web
+        dubbo.io/protocol: http
+    - port: 9001
+      servicePort: 50051
+      serviceProbe: {}
+      tags:
+        dubbo.io/service: greeter
+        dubbo.io/protocol: tri
+    - port: 9002
+      servicePort: 20880
+      serviceProbe: {}
+      tags:
+        dubbo.io/service: backend
+        dubbo.io/protocol: dubbo
+`,
+			hdsConfig: &dp_server.HdsConfig{
+				Interval: config_types.Duration{Duration: 8 * time.Second},
+				Enabled:  true,
+				CheckDefaults: &dp_server.HdsCheck{
+					Interval:           config_types.Duration{Duration: 1 * time.Second},
+					NoTrafficInterval:  config_types.Duration{Duration: 2 * time.Second},
+					Timeout:            config_types.Duration{Duration: 3 * time.Second},
+					HealthyThreshold:   4,
+					UnhealthyThreshold: 5,
+					HttpPath:           "/health",
+				},
+			},
+		}),
+		Entry("should generate HealthCheckSpecifier with TCP checks of HTTP inbounds without the path", testCase{
+			goldenFile: "hds.5.golden.yaml",
+			dataplane: `
+networking:
+  address: 10.20.0.1
+  inbound:
+    - port: 9000
+      servicePort: 8080
+      serviceProbe: {}
+      tags:
+        dubbo.io/service: web
+        dubbo.io/protocol: http
+`,
+			hdsConfig: &dp_server.HdsConfig{
+				Interval: config_types.Duration{Duration: 8 * time.Second},
+				Enabled:  true,
+				CheckDefaults: &dp_server.HdsCheck{
+					Interval:           config_types.Duration{Duration: 1 * time.Second},
+					NoTrafficInterval:  config_types.Duration{Duration: 2 * time.Second},
+					Timeout:            config_types.Duration{Duration: 3 * time.Second},
+					HealthyThreshold:   4,
+					UnhealthyThreshold: 5,
+				},
+			},
+		}),
+		Entry("should generate HealthCheckSpecifier with the interval of the probe", testCase{
+			goldenFile: "hds.6.golden.yaml",
+			dataplane: `
+networking:
+  address: 10.20.0.1
+  inbound:
+    - port: 9000
+      servicePort: 80
+      serviceProbe:
+        interval: 10s
+        tcp: {}
+      tags:
+        dubbo.io/service: backend
 `,
 			hdsConfig: &dp_server.HdsConfig{
 				Interval: config_types.Duration{Duration: 8 * time.Second},
@@ -0,0 +1,91 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builtin
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/url"
+	"time"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
+	util_tls "github.com/apache/dubbo-kubernetes/pkg/tls"
+)
+
+const (
+	DefaultRsaBits              = 2048
+	DefaultAllowedClockSkew     = 10 * time.Second
+	DefaultCACertValidityPeriod = 10 * 365 * 24 * time.Hour
+)
+
+func newRootCa(mesh string, rsaBits int, certExpiration time.Duration) (*core_ca.KeyPair, error) {
+	if rsaBits == 0 {
+		rsaBits = DefaultRsaBits
+	}
+	key, err := rsa.GenerateKey(rand.Reader, rsaBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate a private key")
+	}
+	cert, err := newCACert(key, mesh, certExpiration)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate X509 certificate")
+	}
+	return util_tls.ToKeyPair(key, cert)
+}
+
+func newCACert(signer crypto.Signer, trustDomain string, expiration time.Duration) ([]byte, error) {
+	domain, err := spiffeid.TrustDomainFromString(trustDomain)
+	if err != nil {
+		return nil, err
+	}
+	uri := domain.ID().URL()
+	now := core.Now()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0),
+		Subject: pkix.Name{
+			Organization:       []string{"Dubbo"},
+			OrganizationalUnit: []string{"Mesh"},
+			CommonName:         trustDomain,
+		},
+		URIs:      []*url.URL{uri},
+		NotBefore: now.Add(-DefaultAllowedClockSkew),
+		NotAfter:  now.Add(expiration),
+		KeyUsage: x509.KeyUsageCertSign |
+			x509.KeyUsageCRLSign |
+			x509.KeyUsageDigitalSignature |
+			x509.KeyUsageKeyAgreement |
+			x509.KeyUsageKeyEncipherment,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		PublicKey:             signer.Public(),
+	}
+	return x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+}
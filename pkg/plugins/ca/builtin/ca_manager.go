@@ -0,0 +1,189 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	system_proto "github.com/apache/dubbo-kubernetes/api/system/v1alpha1"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
+	ca_issuer "github.com/apache/dubbo-kubernetes/pkg/core/ca/issuer"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_system "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/system"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+type builtinCaManager struct {
+	secretManager manager.ResourceManager
+}
+
+func NewBuiltinCaManager(secretManager manager.ResourceManager) core_ca.Manager {
+	return &builtinCaManager{
+		secretManager: secretManager,
+	}
+}
+
+var _ core_ca.Manager = &builtinCaManager{}
+
+func (b *builtinCaManager) EnsureBackends(ctx context.Context, mesh string, backends []*mesh_proto.CertificateAuthorityBackend) error {
+	for _, backend := range backends {
+		_, err := b.getCa(ctx, mesh, backend.Name)
+		if err == nil {
+			continue
+		}
+		if !core_store.IsResourceNotFound(err) {
+			return err
+		}
+		if err := b.create(ctx, mesh, backend); err != nil {
+			return errors.Wrapf(err, "failed to create CA for mesh %q and backend %q", mesh, backend.Name)
+		}
+	}
+	return nil
+}
+
+func (b *builtinCaManager) ValidateBackend(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
+	verr := validators.ValidationError{}
+	cfg := &mesh_proto.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+		verr.AddViolation("", "could not convert backend config: "+err.Error())
+		return &verr
+	}
+	if expiration := cfg.GetCaCert().GetExpiration(); expiration != "" {
+		if _, err := core_mesh.ParseDuration(expiration); err != nil {
+			verr.AddViolationAt(validators.RootedAt("caCert").Field("expiration"), "has to be a valid format")
+		}
+	}
+	return verr.OrNil()
+}
+
+func (b *builtinCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
+	return []string{
+		certSecretResKey(mesh, backend.Name).Name,
+		keySecretResKey(mesh, backend.Name).Name,
+	}, nil
+}
+
+func (b *builtinCaManager) create(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
+	cfg := &mesh_proto.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+		return errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+
+	expiration := DefaultCACertValidityPeriod
+	if cfg.GetCaCert().GetExpiration() != "" {
+		duration, err := core_mesh.ParseDuration(cfg.GetCaCert().GetExpiration())
+		if err != nil {
+			return err
+		}
+		expiration = duration
+	}
+
+	keyPair, err := newRootCa(mesh, int(cfg.GetCaCert().GetRSAbits().GetValue()), expiration)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate a Root CA cert for Mesh %q", mesh)
+	}
+
+	certSecret := &core_system.SecretResource{
+		Spec: &system_proto.Secret{
+			Data: util_proto.Bytes(keyPair.CertPEM),
+		},
+	}
+	if err := b.secretManager.Create(ctx, certSecret, core_store.CreateBy(certSecretResKey(mesh, backend.Name))); err != nil {
+		return err
+	}
+
+	keySecret := &core_system.SecretResource{
+		Spec: &system_proto.Secret{
+			Data: util_proto.Bytes(keyPair.KeyPEM),
+		},
+	}
+	if err := b.secretManager.Create(ctx, keySecret, core_store.CreateBy(keySecretResKey(mesh, backend.Name))); err != nil {
+		return err
+	}
+	return nil
+}
+
+func certSecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Name: fmt.Sprintf("%s.ca-builtin-cert-%s", mesh, backendName), // we add mesh as a prefix to have uniqueness of Secret names on K8S
+	}
+}
+
+func keySecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Name: fmt.Sprintf("%s.ca-builtin-key-%s", mesh, backendName), // we add mesh as a prefix to have uniqueness of Secret names on K8S
+	}
+}
+
+func (b *builtinCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
+	ca, err := b.getCa(ctx, mesh, backend.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return []core_ca.Cert{ca.CertPEM}, nil
+}
+
+func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (core_ca.KeyPair, error) {
+	ca, err := b.getCa(ctx, mesh, backend.Name)
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+
+	var opts []ca_issuer.CertOptsFn
+	if backend.GetDpCert().GetRotation().GetExpiration() != "" {
+		duration, err := core_mesh.ParseDuration(backend.GetDpCert().GetRotation().GetExpiration())
+		if err != nil {
+			return core_ca.KeyPair{}, err
+		}
+		opts = append(opts, ca_issuer.WithExpirationTime(duration))
+	}
+	keyPair, err := ca_issuer.NewWorkloadCert(ca, mesh, tags, opts...)
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to generate a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend.Name)
+	}
+	return *keyPair, nil
+}
+
+func (b *builtinCaManager) getCa(ctx context.Context, mesh string, backendName string) (core_ca.KeyPair, error) {
+	certSecret := core_system.NewSecretResource()
+	if err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(certSecretResKey(mesh, backendName))); err != nil {
+		return core_ca.KeyPair{}, err
+	}
+
+	keySecret := core_system.NewSecretResource()
+	if err := b.secretManager.Get(ctx, keySecret, core_store.GetBy(keySecretResKey(mesh, backendName))); err != nil {
+		return core_ca.KeyPair{}, err
+	}
+
+	return core_ca.KeyPair{
+		CertPEM: certSecret.Spec.Data.Value,
+		KeyPEM:  keySecret.Spec.Data.Value,
+	}, nil
+}
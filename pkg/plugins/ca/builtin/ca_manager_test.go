@@ -0,0 +1,209 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builtin_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
+	core_system "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/system"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/ca/builtin"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+var _ = Describe("Builtin CA Manager", func() {
+	var secretManager manager.ResourceManager
+	var caManager core_ca.Manager
+
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now()
+		core.Now = func() time.Time {
+			return now
+		}
+		secretManager = manager.NewResourceManager(memory.NewStore())
+		caManager = builtin.NewBuiltinCaManager(secretManager)
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	Context("EnsureBackends", func() {
+		It("should create a CA", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&mesh_proto.BuiltinCertificateAuthorityConfig{
+					CaCert: &mesh_proto.BuiltinCertificateAuthorityConfig_CaCert{
+						RSAbits:    util_proto.UInt32(uint32(2048)),
+						Expiration: "1m",
+					},
+				}),
+			}
+
+			// when
+			err := caManager.EnsureBackends(context.Background(), mesh, []*mesh_proto.CertificateAuthorityBackend{backend})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			secretRes := core_system.NewSecretResource()
+			err = secretManager.Get(context.Background(), secretRes, core_store.GetBy(core_model.ResourceKey{Name: "default.ca-builtin-cert-builtin-1"}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secretRes.Spec.Data.Value).ToNot(BeEmpty())
+
+			block, _ := pem.Decode(secretRes.Spec.Data.Value)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.IsCA).To(BeTrue())
+			Expect(cert.URIs[0].String()).To(Equal("spiffe://default"))
+			Expect(cert.NotAfter).To(Equal(now.UTC().Truncate(time.Second).Add(1 * time.Minute)))
+
+			secretRes = core_system.NewSecretResource()
+			err = secretManager.Get(context.Background(), secretRes, core_store.GetBy(core_model.ResourceKey{Name: "default.ca-builtin-key-builtin-1"}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secretRes.Spec.Data.Value).ToNot(BeEmpty())
+		})
+
+		It("should not recreate an existing CA", func() {
+			// given
+			mesh := "default"
+			backends := []*mesh_proto.CertificateAuthorityBackend{{
+				Name: "builtin-1",
+				Type: "builtin",
+			}}
+			Expect(caManager.EnsureBackends(context.Background(), mesh, backends)).To(Succeed())
+			before, err := caManager.GetRootCert(context.Background(), mesh, backends[0])
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = caManager.EnsureBackends(context.Background(), mesh, backends)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			after, err := caManager.GetRootCert(context.Background(), mesh, backends[0])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(after).To(Equal(before))
+		})
+	})
+
+	Context("UsedSecrets", func() {
+		It("should return the secrets of the CA", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+
+			// when
+			secrets, err := caManager.UsedSecrets("default", backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(ConsistOf("default.ca-builtin-cert-builtin-1", "default.ca-builtin-key-builtin-1"))
+		})
+	})
+
+	Context("GenerateDataplaneCert", func() {
+		It("should generate a dataplane cert with the rotation expiration", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				DpCert: &mesh_proto.CertificateAuthorityBackend_DpCert{
+					Rotation: &mesh_proto.CertificateAuthorityBackend_DpCert_Rotation{
+						Expiration: "1h",
+					},
+				},
+			}
+			Expect(caManager.EnsureBackends(context.Background(), mesh, []*mesh_proto.CertificateAuthorityBackend{backend})).To(Succeed())
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, mesh_proto.MultiValueTagSet{
+				mesh_proto.ServiceTag: map[string]bool{
+					"web": true,
+				},
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
+			Expect(cert.NotAfter).To(Equal(now.UTC().Truncate(time.Second).Add(1 * time.Hour)))
+		})
+
+		It("should fail when the CA does not exist", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend, mesh_proto.MultiValueTagSet{})
+
+			// then
+			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "builtin-1": Resource not found: type="Secret" name="default.ca-builtin-cert-builtin-1" mesh=""`))
+		})
+	})
+
+	Context("ValidateBackend", func() {
+		It("should reject an invalid expiration", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&mesh_proto.BuiltinCertificateAuthorityConfig{
+					CaCert: &mesh_proto.BuiltinCertificateAuthorityConfig_CaCert{
+						Expiration: "1x",
+					},
+				}),
+			}
+
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			Expect(err).To(MatchError("caCert.expiration: has to be a valid format"))
+		})
+	})
+})
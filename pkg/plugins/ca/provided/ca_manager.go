@@ -0,0 +1,150 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provided
+
+import (
+	"context"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
+	ca_issuer "github.com/apache/dubbo-kubernetes/pkg/core/ca/issuer"
+	"github.com/apache/dubbo-kubernetes/pkg/core/datasource"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+type providedCaManager struct {
+	dataSourceLoader datasource.Loader
+}
+
+var _ core_ca.Manager = &providedCaManager{}
+
+func NewProvidedCaManager(dataSourceLoader datasource.Loader) core_ca.Manager {
+	return &providedCaManager{
+		dataSourceLoader: dataSourceLoader,
+	}
+}
+
+func (p *providedCaManager) ValidateBackend(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
+	verr := validators.ValidationError{}
+
+	cfg := &mesh_proto.ProvidedCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+		verr.AddViolation("", "could not convert backend config: "+err.Error())
+		return &verr
+	}
+
+	if cfg.GetCert() == nil {
+		verr.AddViolation("cert", "has to be defined")
+	}
+	if cfg.GetKey() == nil {
+		verr.AddViolation("key", "has to be defined")
+	}
+	if verr.HasViolations() {
+		return verr.OrNil()
+	}
+
+	pair, err := p.getCa(ctx, mesh, cfg)
+	if err != nil {
+		verr.AddViolation("cert", err.Error())
+		verr.AddViolation("key", err.Error())
+		return verr.OrNil()
+	}
+	if err := validateCaCert(pair); err != nil {
+		verr.AddError("", *err.(*validators.ValidationError))
+	}
+	return verr.OrNil()
+}
+
+func (p *providedCaManager) getCa(ctx context.Context, mesh string, cfg *mesh_proto.ProvidedCertificateAuthorityConfig) (core_ca.KeyPair, error) {
+	key, err := p.dataSourceLoader.Load(ctx, mesh, cfg.GetKey())
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not load key")
+	}
+	cert, err := p.dataSourceLoader.Load(ctx, mesh, cfg.GetCert())
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not load cert")
+	}
+	return core_ca.KeyPair{
+		CertPEM: cert,
+		KeyPEM:  key,
+	}, nil
+}
+
+func (p *providedCaManager) EnsureBackends(ctx context.Context, mesh string, backends []*mesh_proto.CertificateAuthorityBackend) error {
+	return nil // Cert and Key are created by user and pointed in the configuration which is validated first
+}
+
+func (p *providedCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
+	cfg := &mesh_proto.ProvidedCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to ProvidedCertificateAuthorityConfig")
+	}
+	var secrets []string
+	if cfg.GetCert().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetCert().GetSecret())
+	}
+	if cfg.GetKey().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetKey().GetSecret())
+	}
+	return secrets, nil
+}
+
+func (p *providedCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
+	cfg := &mesh_proto.ProvidedCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to ProvidedCertificateAuthorityConfig")
+	}
+	rootCa, err := p.dataSourceLoader.Load(ctx, mesh, cfg.GetCert())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load CA cert")
+	}
+	return []core_ca.Cert{rootCa}, nil
+}
+
+func (p *providedCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (core_ca.KeyPair, error) {
+	cfg := &mesh_proto.ProvidedCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not convert backend config to ProvidedCertificateAuthorityConfig")
+	}
+	ca, err := p.getCa(ctx, mesh, cfg)
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+
+	var opts []ca_issuer.CertOptsFn
+	if backend.GetDpCert().GetRotation().GetExpiration() != "" {
+		duration, err := core_mesh.ParseDuration(backend.GetDpCert().GetRotation().GetExpiration())
+		if err != nil {
+			return core_ca.KeyPair{}, err
+		}
+		opts = append(opts, ca_issuer.WithExpirationTime(duration))
+	}
+	keyPair, err := ca_issuer.NewWorkloadCert(ca, mesh, tags, opts...)
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to generate a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend.Name)
+	}
+	return *keyPair, nil
+}
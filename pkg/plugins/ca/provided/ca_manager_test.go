@@ -0,0 +1,156 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package provided_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	system_proto "github.com/apache/dubbo-kubernetes/api/system/v1alpha1"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
+	"github.com/apache/dubbo-kubernetes/pkg/core/datasource"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/ca/provided"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	util_tls "github.com/apache/dubbo-kubernetes/pkg/tls"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+var _ = Describe("Provided CA Manager", func() {
+	var caManager core_ca.Manager
+	var rootCa *util_tls.KeyPair
+
+	inline := func(data []byte) *system_proto.DataSource {
+		return &system_proto.DataSource{
+			Type: &system_proto.DataSource_InlineString{InlineString: string(data)},
+		}
+	}
+
+	backendFor := func(cert, key []byte) *mesh_proto.CertificateAuthorityBackend {
+		cfg := &mesh_proto.ProvidedCertificateAuthorityConfig{}
+		if cert != nil {
+			cfg.Cert = inline(cert)
+		}
+		if key != nil {
+			cfg.Key = inline(key)
+		}
+		return &mesh_proto.CertificateAuthorityBackend{
+			Name: "provided-1",
+			Type: "provided",
+			Conf: util_proto.MustToStruct(cfg),
+		}
+	}
+
+	BeforeEach(func() {
+		caManager = provided.NewProvidedCaManager(datasource.NewDataSourceLoader(manager.NewResourceManager(memory.NewStore())))
+
+		var err error
+		rootCa, err = util_tls.GenerateCA(util_tls.ECDSAKeyType, pkix.Name{CommonName: "default"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("ValidateBackend", func() {
+		It("should accept a CA certificate", func() {
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backendFor(rootCa.CertPEM, rootCa.KeyPEM))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should require the cert and the key", func() {
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backendFor(nil, nil))
+
+			// then
+			Expect(err).To(MatchError("cert: has to be defined; key: has to be defined"))
+		})
+
+		It("should reject a key that does not match the certificate", func() {
+			// given
+			otherCa, err := util_tls.GenerateCA(util_tls.ECDSAKeyType, pkix.Name{CommonName: "other"})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = caManager.ValidateBackend(context.Background(), "default", backendFor(rootCa.CertPEM, otherCa.KeyPEM))
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("cert: not a valid TLS key pair")))
+		})
+
+		It("should reject a certificate that is not a CA", func() {
+			// given
+			block, _ := pem.Decode(rootCa.CertPEM)
+			parent, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			keyBlock, _ := pem.Decode(rootCa.KeyPEM)
+			parentKey, err := util_tls.ParsePrivateKey(keyBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			leaf, err := util_tls.NewCert(*parent, parentKey.(crypto.Signer), util_tls.ServerCertType, util_tls.ECDSAKeyType, "backend")
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = caManager.ValidateBackend(context.Background(), "default", backendFor(leaf.CertPEM, leaf.KeyPEM))
+
+			// then
+			Expect(err).To(MatchError(
+				"cert[0]: basic constraint 'CA' must be set to 'true' (see X509-SVID: 4.1. Basic Constraints); " +
+					"cert[0]: key usage extension 'keyCertSign' must be set (see X509-SVID: 4.3. Key Usage)",
+			))
+		})
+	})
+
+	Context("GenerateDataplaneCert", func() {
+		It("should issue a workload certificate signed by the provided CA", func() {
+			// given
+			backend := backendFor(rootCa.CertPEM, rootCa.KeyPEM)
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend, mesh_proto.MultiValueTagSet{
+				mesh_proto.ServiceTag: map[string]bool{
+					"web": true,
+				},
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			roots := x509.NewCertPool()
+			Expect(roots.AppendCertsFromPEM(rootCa.CertPEM)).To(BeTrue())
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
+			_, err = cert.Verify(x509.VerifyOptions{
+				Roots:     roots,
+				KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,57 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provided
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	util_tls "github.com/apache/dubbo-kubernetes/pkg/tls"
+)
+
+// validateCaCert checks that the key pair is valid and that the certificate may be used to sign workload certificates.
+func validateCaCert(signingPair util_tls.KeyPair) error {
+	verr := validators.ValidationError{}
+	tlsKeyPair, err := tls.X509KeyPair(signingPair.CertPEM, signingPair.KeyPEM)
+	if err != nil {
+		verr.AddViolation("cert", errors.Wrap(err, "not a valid TLS key pair").Error())
+		return verr.OrNil()
+	}
+	for i, certificate := range tlsKeyPair.Certificate {
+		path := validators.RootedAt("cert").Index(i)
+		cert, err := x509.ParseCertificate(certificate)
+		if err != nil {
+			verr.AddViolationAt(path, "not a valid x509 certificate")
+			continue
+		}
+		if i == 0 && !cert.IsCA {
+			verr.AddViolationAt(path, "basic constraint 'CA' must be set to 'true' (see X509-SVID: 4.1. Basic Constraints)")
+		}
+		if i == 0 && cert.KeyUsage&x509.KeyUsageCertSign == 0 {
+			verr.AddViolationAt(path, "key usage extension 'keyCertSign' must be set (see X509-SVID: 4.3. Key Usage)")
+		}
+	}
+	return verr.OrNil()
+}
@@ -32,38 +32,56 @@ import (
 	leader_mysql "github.com/apache/dubbo-kubernetes/pkg/plugins/leader/mysql"
 )
 
+// InMemoryDsn selects an in-memory SQLite database instead of a mysql server.
+// Its state is lost on restart, so it is only meant for tests and local development.
+const InMemoryDsn = ":memory:"
+
 func ConnectToDb(cfg mysql.MysqlStoreConfig) (*gorm.DB, error) {
 	dsn := cfg.MysqlDsn
+	if dsn == "" {
+		return nil, errors.New("mysql DSN is not configured")
+	}
 	var db *gorm.DB
 	var err error
-	if dsn == "" {
-		db, err = gorm.Open(sqlite_driver.Open(":memory:"), &gorm.Config{})
+	if dsn == InMemoryDsn {
+		db, err = gorm.Open(sqlite_driver.Open(InMemoryDsn), &gorm.Config{})
 	} else {
 		db, err = gorm.Open(mysql_driver.Open(dsn), &gorm.Config{})
 	}
 	if err != nil {
 		return nil, err
 	}
-
-	initErr := db.AutoMigrate(
-		&leader_mysql.DistributedLock{},
-	)
-	if initErr != nil {
-		return nil, initErr
-	}
 	rawDB, err := db.DB()
 	if err != nil {
 		return nil, err
 	}
+
+	if dsn == InMemoryDsn {
+		// every connection to ":memory:" opens a separate database,
+		// so keep exactly one connection alive for the whole lifetime of the pool.
+		// This has to happen before anything touches the database.
+		rawDB.SetMaxOpenConns(1)
+		rawDB.SetMaxIdleConns(1)
+		rawDB.SetConnMaxLifetime(0)
+		rawDB.SetConnMaxIdleTime(0)
+	} else {
+		rawDB.SetMaxOpenConns(cfg.MaxOpenConnections)
+		rawDB.SetMaxIdleConns(cfg.MaxIdleConnections)
+		rawDB.SetConnMaxLifetime(cfg.MaxLifeTime)
+		rawDB.SetConnMaxIdleTime(cfg.MaxIdleTime)
+	}
+
 	// check connection to DB, Open() does not check it.
 	if err := rawDB.Ping(); err != nil {
 		return nil, errors.Wrap(err, "cannot connect to DB")
 	}
 
-	rawDB.SetMaxOpenConns(cfg.MaxOpenConnections)
-	rawDB.SetMaxIdleConns(cfg.MaxIdleConnections)
-	rawDB.SetConnMaxLifetime(cfg.MaxLifeTime)
-	rawDB.SetConnMaxIdleTime(cfg.MaxIdleTime)
+	initErr := db.AutoMigrate(
+		&leader_mysql.DistributedLock{},
+	)
+	if initErr != nil {
+		return nil, initErr
+	}
 
 	return db, nil
 }
@@ -0,0 +1,55 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mysql_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	config_mysql "github.com/apache/dubbo-kubernetes/pkg/config/plugins/resources/mysql"
+	common_mysql "github.com/apache/dubbo-kubernetes/pkg/plugins/common/mysql"
+	leader_mysql "github.com/apache/dubbo-kubernetes/pkg/plugins/leader/mysql"
+)
+
+var _ = Describe("ConnectToDb", func() {
+	It("should reject an empty DSN", func() {
+		// when
+		_, err := common_mysql.ConnectToDb(config_mysql.MysqlStoreConfig{})
+
+		// then
+		Expect(err).To(MatchError("mysql DSN is not configured"))
+	})
+
+	It("should migrate the in-memory database that is used afterwards", func() {
+		// when
+		db, err := common_mysql.ConnectToDb(config_mysql.MysqlStoreConfig{
+			MysqlDsn:           common_mysql.InMemoryDsn,
+			MaxOpenConnections: 10,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		rawDB, err := db.DB()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rawDB.Stats().MaxOpenConnections).To(Equal(1))
+		Expect(db.Migrator().HasTable(&leader_mysql.DistributedLock{})).To(BeTrue())
+		Expect(db.Create(&leader_mysql.DistributedLock{Id: "leader"}).Error).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,133 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	matcher_validators "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers/validators"
+)
+
+func (r *CircuitBreakerResource) validate() error {
+	var verr validators.ValidationError
+	path := validators.RootedAt("spec")
+	verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(r.Spec.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+		SupportedKinds: []common_api.TargetRefKind{
+			common_api.Mesh,
+			common_api.MeshSubset,
+			common_api.MeshService,
+			common_api.MeshServiceSubset,
+		},
+	}))
+	if len(r.Spec.To) == 0 {
+		verr.AddViolationAt(path.Field("to"), validators.MustNotBeEmpty)
+	}
+	verr.AddErrorAt(path, validateTo(r.Spec.To))
+	return verr.OrNil()
+}
+
+func validateTo(to []To) validators.ValidationError {
+	var verr validators.ValidationError
+	for idx, toItem := range to {
+		path := validators.RootedAt("to").Index(idx)
+		verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(toItem.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+			SupportedKinds: []common_api.TargetRefKind{
+				common_api.Mesh,
+				common_api.MeshService,
+			},
+		}))
+		verr.AddErrorAt(path.Field("default"), validateDefault(toItem.Default))
+	}
+	return verr
+}
+
+func validateDefault(conf Conf) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if conf.ConnectionLimits == nil && conf.OutlierDetection == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("connectionLimits", "outlierDetection"))
+	}
+	if conf.ConnectionLimits != nil {
+		verr.AddErrorAt(path.Field("connectionLimits"), validateConnectionLimits(*conf.ConnectionLimits))
+	}
+	if conf.OutlierDetection != nil {
+		verr.AddErrorAt(path.Field("outlierDetection"), validateOutlierDetection(*conf.OutlierDetection))
+	}
+	return verr
+}
+
+func validateConnectionLimits(limits ConnectionLimits) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if limits.MaxConnections == nil && limits.MaxConnectionPools == nil && limits.MaxPendingRequests == nil &&
+		limits.MaxRetries == nil && limits.MaxRequests == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("maxConnections", "maxConnectionPools", "maxPendingRequests", "maxRetries", "maxRequests"))
+	}
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("maxConnections"), limits.MaxConnections))
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("maxConnectionPools"), limits.MaxConnectionPools))
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("maxPendingRequests"), limits.MaxPendingRequests))
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("maxRetries"), limits.MaxRetries))
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("maxRequests"), limits.MaxRequests))
+	return verr
+}
+
+func validateOutlierDetection(outlierDetection OutlierDetection) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if outlierDetection.Disabled != nil && *outlierDetection.Disabled {
+		return verr
+	}
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("interval"), outlierDetection.Interval))
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("baseEjectionTime"), outlierDetection.BaseEjectionTime))
+	verr.Add(validators.ValidateUInt32PercentageOrNil(path.Field("maxEjectionPercent"), outlierDetection.MaxEjectionPercent))
+
+	detectors := outlierDetection.Detectors
+	if detectors == nil {
+		verr.AddViolationAt(path.Field("detectors"), validators.MustBeDefined)
+		return verr
+	}
+	detectorsPath := path.Field("detectors")
+	if detectors.TotalFailures == nil && detectors.GatewayFailures == nil && detectors.LocalOriginFailures == nil &&
+		detectors.SuccessRate == nil && detectors.FailurePercentage == nil {
+		verr.AddViolationAt(detectorsPath, validators.MustHaveAtLeastOne("totalFailures", "gatewayFailures", "localOriginFailures", "successRate", "failurePercentage"))
+	}
+	if detectors.TotalFailures != nil {
+		verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(detectorsPath.Field("totalFailures").Field("consecutive"), detectors.TotalFailures.Consecutive))
+	}
+	if detectors.GatewayFailures != nil {
+		verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(detectorsPath.Field("gatewayFailures").Field("consecutive"), detectors.GatewayFailures.Consecutive))
+	}
+	if detectors.LocalOriginFailures != nil {
+		localOriginPath := detectorsPath.Field("localOriginFailures")
+		if outlierDetection.SplitExternalAndLocalErrors == nil || !*outlierDetection.SplitExternalAndLocalErrors {
+			verr.AddViolationAt(localOriginPath, "can only be set when splitExternalAndLocalErrors is true")
+		}
+		verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(localOriginPath.Field("consecutive"), detectors.LocalOriginFailures.Consecutive))
+	}
+	if detectors.SuccessRate != nil {
+		verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(detectorsPath.Field("successRate").Field("minimumHosts"), detectors.SuccessRate.MinimumHosts))
+		verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(detectorsPath.Field("successRate").Field("requestVolume"), detectors.SuccessRate.RequestVolume))
+	}
+	if detectors.FailurePercentage != nil {
+		failurePercentagePath := detectorsPath.Field("failurePercentage")
+		verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(failurePercentagePath.Field("minimumHosts"), detectors.FailurePercentage.MinimumHosts))
+		verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(failurePercentagePath.Field("requestVolume"), detectors.FailurePercentage.RequestVolume))
+		verr.Add(validators.ValidateUInt32PercentageOrNil(failurePercentagePath.Field("threshold"), detectors.FailurePercentage.Threshold))
+	}
+	return verr
+}
@@ -0,0 +1,139 @@
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	_ "embed"
+	"fmt"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+//go:embed schema.yaml
+var rawSchema []byte
+
+func init() {
+	var schema spec.Schema
+	if err := yaml.Unmarshal(rawSchema, &schema); err != nil {
+		panic(err)
+	}
+	rawSchema = nil
+	CircuitBreakerResourceTypeDescriptor.Schema = &schema
+}
+
+const (
+	CircuitBreakerType model.ResourceType = "CircuitBreaker"
+)
+
+var _ model.Resource = &CircuitBreakerResource{}
+
+type CircuitBreakerResource struct {
+	Meta model.ResourceMeta
+	Spec *CircuitBreaker
+}
+
+func NewCircuitBreakerResource() *CircuitBreakerResource {
+	return &CircuitBreakerResource{
+		Spec: &CircuitBreaker{},
+	}
+}
+
+func (t *CircuitBreakerResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *CircuitBreakerResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *CircuitBreakerResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *CircuitBreakerResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*CircuitBreaker)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &CircuitBreaker{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *CircuitBreakerResource) Descriptor() model.ResourceTypeDescriptor {
+	return CircuitBreakerResourceTypeDescriptor
+}
+
+func (t *CircuitBreakerResource) Validate() error {
+	if v, ok := interface{}(t).(interface{ validate() error }); !ok {
+		return nil
+	} else {
+		return v.validate()
+	}
+}
+
+var _ model.ResourceList = &CircuitBreakerResourceList{}
+
+type CircuitBreakerResourceList struct {
+	Items      []*CircuitBreakerResource
+	Pagination model.Pagination
+}
+
+func (l *CircuitBreakerResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *CircuitBreakerResourceList) GetItemType() model.ResourceType {
+	return CircuitBreakerType
+}
+
+func (l *CircuitBreakerResourceList) NewItem() model.Resource {
+	return NewCircuitBreakerResource()
+}
+
+func (l *CircuitBreakerResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*CircuitBreakerResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*CircuitBreakerResource)(nil), r)
+	}
+}
+
+func (l *CircuitBreakerResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *CircuitBreakerResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var CircuitBreakerResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                CircuitBreakerType,
+	Resource:            NewCircuitBreakerResource(),
+	ResourceList:        &CircuitBreakerResourceList{},
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag | model.ZoneToGlobalFlag,
+	WsPath:              "circuitbreakers",
+	DubboctlArg:         "circuitbreaker",
+	DubboctlListArg:     "circuitbreakers",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	IsExperimental:      false,
+	SingularDisplayName: "Circuit Breaker",
+	PluralDisplayName:   "Circuit Breakers",
+	IsPluginOriginated:  true,
+}
@@ -0,0 +1,76 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker/api/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers"
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	policies_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/xds"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	clusters_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/clusters/v3"
+)
+
+var _ core_plugins.PolicyPlugin = &plugin{}
+
+type plugin struct{}
+
+func NewPlugin() core_plugins.Plugin {
+	return &plugin{}
+}
+
+func (p plugin) MatchedPolicies(dataplane *core_mesh.DataplaneResource, resources xds_context.Resources) (core_xds.TypedMatchingPolicies, error) {
+	return matchers.MatchedPolicies(api.CircuitBreakerType, dataplane, resources)
+}
+
+func (p plugin) Apply(rs *core_xds.ResourceSet, ctx xds_context.Context, proxy *core_xds.Proxy) error {
+	if proxy.Dataplane == nil {
+		return nil
+	}
+	policies, ok := proxy.Policies.Dynamic[api.CircuitBreakerType]
+	if !ok {
+		return nil
+	}
+
+	clusters := policies_xds.GatherClusters(rs)
+	targetedClusters := policies_xds.GatherTargetedClusters(
+		proxy.Dataplane.Spec.GetNetworking().GetOutbound(),
+		clusters.OutboundSplit,
+		clusters.Outbound,
+	)
+
+	for cluster, serviceName := range targetedClusters {
+		rule := policies.ToRules.Rules.Compute(core_rules.MeshService(serviceName))
+		if rule == nil {
+			continue
+		}
+		conf := rule.Conf.(api.Conf)
+		circuitBreaker := clusters_v3.CircuitBreakerConfigurer{ConnectionLimits: conf.ConnectionLimits}
+		if err := circuitBreaker.Configure(cluster); err != nil {
+			return err
+		}
+		outlierDetection := clusters_v3.OutlierDetectionConfigurer{OutlierDetection: conf.OutlierDetection}
+		if err := outlierDetection.Configure(cluster); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1_test
+
+import (
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker/api/v1alpha1"
+	plugin "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker/plugin/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	"github.com/apache/dubbo-kubernetes/pkg/test/resources/samples"
+	test_xds "github.com/apache/dubbo-kubernetes/pkg/test/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+)
+
+var _ = Describe("CircuitBreaker", func() {
+	type testCase struct {
+		policies   []*api.CircuitBreakerResource
+		goldenFile string
+	}
+
+	DescribeTable("should apply the circuit breakers to the resources of the proxy",
+		func(given testCase) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.CircuitBreakerResourceList{Items: given.policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(test_xds.ResourcesYAML(rs)).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+		},
+		Entry("outbound", testCase{
+			policies: []*api.CircuitBreakerResource{
+				test_xds.Policy(api.NewCircuitBreakerResource, "mesh-limits", &api.CircuitBreaker{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+						Default: api.Conf{
+							ConnectionLimits: &api.ConnectionLimits{
+								MaxConnections:     pointer.To[uint32](1024),
+								MaxPendingRequests: pointer.To[uint32](128),
+							},
+						},
+					}},
+				}),
+				test_xds.Policy(api.NewCircuitBreakerResource, "web-to-backend", &api.CircuitBreaker{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"},
+						Default: api.Conf{
+							ConnectionLimits: &api.ConnectionLimits{
+								MaxRequests: pointer.To[uint32](256),
+								MaxRetries:  pointer.To[uint32](3),
+							},
+							OutlierDetection: &api.OutlierDetection{
+								Interval:           &k8s.Duration{Duration: 5 * time.Second},
+								BaseEjectionTime:   &k8s.Duration{Duration: 30 * time.Second},
+								MaxEjectionPercent: pointer.To[uint32](20),
+								Detectors: &api.Detectors{
+									TotalFailures: &api.DetectorTotalFailures{Consecutive: pointer.To[uint32](5)},
+									SuccessRate: &api.DetectorSuccessRate{
+										MinimumHosts:            pointer.To[uint32](3),
+										RequestVolume:           pointer.To[uint32](10),
+										StandardDeviationFactor: pointer.To[uint32](1900),
+									},
+								},
+							},
+						},
+					}},
+				}),
+			},
+			goldenFile: "circuitbreaker.outbound.golden.yaml",
+		}),
+	)
+
+	DescribeTable("should leave the resources of the proxy untouched",
+		func(policies []*api.CircuitBreakerResource) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+			untouched, err := test_xds.ResourceSet(xdsCtx, dataplane)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.CircuitBreakerResourceList{Items: policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).To(test_xds.MatchResources(untouched))
+		},
+		Entry("outbound with disabled outlier detection", []*api.CircuitBreakerResource{
+			test_xds.Policy(api.NewCircuitBreakerResource, "mesh-outlier-detection", &api.CircuitBreaker{
+				TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+				To: []api.To{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						OutlierDetection: &api.OutlierDetection{
+							Disabled: pointer.To(true),
+							Detectors: &api.Detectors{
+								GatewayFailures: &api.DetectorGatewayFailures{Consecutive: pointer.To[uint32](3)},
+							},
+						},
+					},
+				}},
+			}),
+		}),
+		Entry("targetRef mismatch", []*api.CircuitBreakerResource{
+			test_xds.Policy(api.NewCircuitBreakerResource, "other", &api.CircuitBreaker{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "other"},
+				To: []api.To{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						ConnectionLimits: &api.ConnectionLimits{MaxConnections: pointer.To[uint32](1)},
+					},
+				}},
+			}),
+		}),
+	)
+})
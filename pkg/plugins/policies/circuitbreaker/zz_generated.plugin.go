@@ -0,0 +1,16 @@
+package circuitbreaker
+
+import (
+	api_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker/api/v1alpha1"
+	k8s_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker/k8s/v1alpha1"
+	plugin_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker/plugin/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core"
+)
+
+func init() {
+	core.Register(
+		api_v1alpha1.CircuitBreakerResourceTypeDescriptor,
+		k8s_v1alpha1.AddToScheme,
+		plugin_v1alpha1.NewPlugin(),
+	)
+}
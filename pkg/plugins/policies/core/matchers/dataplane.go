@@ -18,12 +18,108 @@
 package matchers
 
 import (
+	"sort"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
 	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
 	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
 	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
 )
 
+// MatchedPolicies matches the policies of the given type selecting the data plane proxy by the top-level targetRef
+// and builds the rules for its inbounds and outbounds.
 func MatchedPolicies(rType core_model.ResourceType, dpp *core_mesh.DataplaneResource, resource xds_context.Resources) (core_xds.TypedMatchingPolicies, error) {
-	return core_xds.TypedMatchingPolicies{}, nil
+	policies := resource.ListOrEmpty(rType)
+
+	matchedPoliciesByInbound := map[core_rules.InboundListener][]core_model.Resource{}
+	var dpPolicies []core_model.Resource
+
+	for _, policy := range policies.GetItems() {
+		spec, ok := policy.GetSpec().(core_model.Policy)
+		if !ok {
+			continue
+		}
+		selectedInbounds := inboundsSelectedByTargetRef(spec.GetTargetRef(), dpp)
+		if len(selectedInbounds) == 0 {
+			continue
+		}
+		dpPolicies = append(dpPolicies, policy)
+		for _, inbound := range selectedInbounds {
+			matchedPoliciesByInbound[inbound] = append(matchedPoliciesByInbound[inbound], policy)
+		}
+	}
+
+	sort.Stable(ByTargetRef(dpPolicies))
+	for _, ps := range matchedPoliciesByInbound {
+		sort.Stable(ByTargetRef(ps))
+	}
+
+	fr, err := core_rules.BuildFromRules(matchedPoliciesByInbound)
+	if err != nil {
+		return core_xds.TypedMatchingPolicies{}, err
+	}
+	tr, err := core_rules.BuildToRules(dpPolicies)
+	if err != nil {
+		return core_xds.TypedMatchingPolicies{}, err
+	}
+	sr, err := core_rules.BuildSingleItemRules(dpPolicies)
+	if err != nil {
+		return core_xds.TypedMatchingPolicies{}, err
+	}
+
+	return core_xds.TypedMatchingPolicies{
+		Type:              rType,
+		DataplanePolicies: dpPolicies,
+		FromRules:         fr,
+		ToRules:           tr,
+		SingleItemRules:   sr,
+	}, nil
 }
+
+// inboundsSelectedByTargetRef returns the inbounds of the data plane proxy selected by the top-level targetRef.
+func inboundsSelectedByTargetRef(tr common_api.TargetRef, dpp *core_mesh.DataplaneResource) []core_rules.InboundListener {
+	var selected []core_rules.InboundListener
+	networking := dpp.Spec.GetNetworking()
+	for i, iface := range networking.GetInboundInterfaces() {
+		tags := networking.GetInbound()[i].GetTags()
+		var matches bool
+		switch tr.Kind {
+		case common_api.Mesh:
+			matches = true
+		case common_api.MeshSubset:
+			matches = mesh_proto.TagSelector(tr.Tags).Matches(tags)
+		case common_api.MeshService:
+			matches = tags[mesh_proto.ServiceTag] == tr.Name
+		case common_api.MeshServiceSubset:
+			matches = tags[mesh_proto.ServiceTag] == tr.Name && mesh_proto.TagSelector(tr.Tags).Matches(tags)
+		}
+		if matches {
+			selected = append(selected, core_rules.InboundListener{
+				Address: iface.DataplaneIP,
+				Port:    iface.DataplanePort,
+			})
+		}
+	}
+	return selected
+}
+
+// ByTargetRef sorts policies from the least to the most specific top-level targetRef.
+// When the kinds are equal, the policy with the lexicographically smaller name goes last, so it takes precedence.
+type ByTargetRef []core_model.Resource
+
+func (b ByTargetRef) Len() int { return len(b) }
+
+func (b ByTargetRef) Less(i, j int) bool {
+	r1, r2 := b[i].GetSpec().(core_model.Policy).GetTargetRef(), b[j].GetSpec().(core_model.Policy).GetTargetRef()
+	if r1.Kind != r2.Kind {
+		return r1.Kind.Less(r2.Kind)
+	}
+	return b[i].GetMeta().GetName() > b[j].GetMeta().GetName()
+}
+
+func (b ByTargetRef) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
@@ -0,0 +1,175 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package matchers_test
+
+import (
+	"sort"
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers"
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	timeout_api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/api/v1alpha1"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+)
+
+func timeoutPolicy(name string, targetRef common_api.TargetRef, connectionTimeout time.Duration) *timeout_api.TimeoutResource {
+	policy := timeout_api.NewTimeoutResource()
+	policy.SetMeta(&test_model.ResourceMeta{Name: name, Mesh: core_model.DefaultMesh})
+	Expect(policy.SetSpec(&timeout_api.Timeout{
+		TargetRef: targetRef,
+		From: []timeout_api.From{{
+			TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+			Default:   timeout_api.Conf{ConnectionTimeout: &k8s.Duration{Duration: connectionTimeout}},
+		}},
+		To: []timeout_api.To{{
+			TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+			Default:   timeout_api.Conf{ConnectionTimeout: &k8s.Duration{Duration: connectionTimeout}},
+		}},
+	})).To(Succeed())
+	return policy
+}
+
+func names(policies []core_model.Resource) []string {
+	var result []string
+	for _, policy := range policies {
+		result = append(result, policy.GetMeta().GetName())
+	}
+	return result
+}
+
+var _ = Describe("MatchedPolicies", func() {
+	dataplane := &core_mesh.DataplaneResource{
+		Meta: &test_model.ResourceMeta{Name: "backend-1", Mesh: core_model.DefaultMesh},
+		Spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+					{
+						Port: 20880,
+						Tags: map[string]string{mesh_proto.ServiceTag: "backend", "version": "v1"},
+					},
+					{
+						Port: 20881,
+						Tags: map[string]string{mesh_proto.ServiceTag: "backend-admin", "version": "v1"},
+					},
+				},
+			},
+		},
+	}
+	backendInbound := core_rules.InboundListener{Address: "192.168.0.1", Port: 20880}
+	adminInbound := core_rules.InboundListener{Address: "192.168.0.1", Port: 20881}
+
+	resourcesWith := func(policies ...*timeout_api.TimeoutResource) xds_context.Resources {
+		resources := xds_context.NewResources()
+		resources.MeshLocalResources[timeout_api.TimeoutType] = &timeout_api.TimeoutResourceList{Items: policies}
+		return resources
+	}
+
+	It("should match the policies by the top-level targetRef", func() {
+		// given
+		resources := resourcesWith(
+			timeoutPolicy("backend-v1", common_api.TargetRef{
+				Kind: common_api.MeshServiceSubset,
+				Name: "backend",
+				Tags: map[string]string{"version": "v1"},
+			}, 4*time.Second),
+			timeoutPolicy("backend-v2", common_api.TargetRef{
+				Kind: common_api.MeshServiceSubset,
+				Name: "backend",
+				Tags: map[string]string{"version": "v2"},
+			}, 5*time.Second),
+			timeoutPolicy("backend", common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"}, 3*time.Second),
+			timeoutPolicy("other", common_api.TargetRef{Kind: common_api.MeshService, Name: "other"}, 6*time.Second),
+			timeoutPolicy("v1", common_api.TargetRef{
+				Kind: common_api.MeshSubset,
+				Tags: map[string]string{"version": "v1"},
+			}, 2*time.Second),
+			timeoutPolicy("mesh-wide", common_api.TargetRef{Kind: common_api.Mesh}, time.Second),
+		)
+
+		// when
+		policies, err := matchers.MatchedPolicies(timeout_api.TimeoutType, dataplane, resources)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policies.Type).To(Equal(timeout_api.TimeoutType))
+		Expect(names(policies.DataplanePolicies)).To(Equal([]string{"mesh-wide", "v1", "backend", "backend-v1"}))
+
+		Expect(policies.FromRules.Rules).To(HaveLen(2))
+		Expect(policies.FromRules.Rules[backendInbound][0].Conf).To(Equal(timeout_api.Conf{
+			ConnectionTimeout: &k8s.Duration{Duration: 4 * time.Second},
+		}))
+		Expect(policies.FromRules.Rules[adminInbound][0].Conf).To(Equal(timeout_api.Conf{
+			ConnectionTimeout: &k8s.Duration{Duration: 2 * time.Second},
+		}))
+
+		Expect(policies.ToRules.Rules).To(HaveLen(1))
+		Expect(policies.ToRules.Rules[0].Conf).To(Equal(timeout_api.Conf{
+			ConnectionTimeout: &k8s.Duration{Duration: 4 * time.Second},
+		}))
+	})
+
+	It("should not match any policy when the targetRef selects other proxies", func() {
+		// given
+		resources := resourcesWith(
+			timeoutPolicy("other", common_api.TargetRef{Kind: common_api.MeshService, Name: "other"}, time.Second),
+		)
+
+		// when
+		policies, err := matchers.MatchedPolicies(timeout_api.TimeoutType, dataplane, resources)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policies.DataplanePolicies).To(BeEmpty())
+		Expect(policies.FromRules.Rules).To(BeEmpty())
+		Expect(policies.ToRules.Rules).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ByTargetRef", func() {
+	It("should sort the policies from the least to the most specific", func() {
+		// given
+		policies := []core_model.Resource{
+			timeoutPolicy("service-subset", common_api.TargetRef{Kind: common_api.MeshServiceSubset, Name: "backend"}, time.Second),
+			timeoutPolicy("service-b", common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"}, time.Second),
+			timeoutPolicy("mesh", common_api.TargetRef{Kind: common_api.Mesh}, time.Second),
+			timeoutPolicy("service-a", common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"}, time.Second),
+			timeoutPolicy("subset", common_api.TargetRef{Kind: common_api.MeshSubset}, time.Second),
+		}
+
+		// when
+		sort.Stable(matchers.ByTargetRef(policies))
+
+		// then
+		Expect(names(policies)).To(Equal([]string{"mesh", "subset", "service-b", "service-a", "service-subset"}))
+	})
+})
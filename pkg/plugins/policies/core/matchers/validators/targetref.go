@@ -0,0 +1,92 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+)
+
+type ValidateTargetRefOpts struct {
+	SupportedKinds []common_api.TargetRefKind
+}
+
+// ValidateTargetRef checks that the targetRef uses one of the supported kinds and
+// sets only the fields that are allowed for its kind.
+func ValidateTargetRef(ref common_api.TargetRef, opts *ValidateTargetRefOpts) validators.ValidationError {
+	verr := validators.ValidationError{}
+	if ref.Kind == "" {
+		verr.AddViolation("kind", validators.MustBeDefined)
+		return verr
+	}
+	if !contains(opts.SupportedKinds, ref.Kind) {
+		verr.AddViolation("kind", fmt.Sprintf("value is not supported, supported kinds: %s", joinKinds(opts.SupportedKinds)))
+		return verr
+	}
+	if ref.Mesh != "" {
+		verr.AddViolation("mesh", validators.MustNotBeSet)
+	}
+
+	switch ref.Kind {
+	case common_api.Mesh:
+		if ref.Name != "" {
+			verr.AddViolation("name", fmt.Sprintf("%s with kind %q", validators.MustNotBeSet, ref.Kind))
+		}
+		if len(ref.Tags) != 0 {
+			verr.AddViolation("tags", fmt.Sprintf("%s with kind %q", validators.MustNotBeSet, ref.Kind))
+		}
+	case common_api.MeshSubset:
+		if ref.Name != "" {
+			verr.AddViolation("name", fmt.Sprintf("%s with kind %q", validators.MustNotBeSet, ref.Kind))
+		}
+	case common_api.MeshService:
+		if ref.Name == "" {
+			verr.AddViolation("name", validators.MustBeDefined)
+		}
+		if len(ref.Tags) != 0 {
+			verr.AddViolation("tags", fmt.Sprintf("%s with kind %q", validators.MustNotBeSet, ref.Kind))
+		}
+	case common_api.MeshServiceSubset:
+		if ref.Name == "" {
+			verr.AddViolation("name", validators.MustBeDefined)
+		}
+	}
+	return verr
+}
+
+func contains(kinds []common_api.TargetRefKind, kind common_api.TargetRefKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func joinKinds(kinds []common_api.TargetRefKind) string {
+	var names []string
+	for _, k := range kinds {
+		names = append(names, string(k))
+	}
+	return strings.Join(names, ",")
+}
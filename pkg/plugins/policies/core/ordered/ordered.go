@@ -21,4 +21,13 @@ import (
 	"github.com/apache/dubbo-kubernetes/pkg/core/plugins"
 )
 
-var Policies = []plugins.PluginName{}
+var Policies = []plugins.PluginName{
+	"timeout",
+	"retry",
+	"circuitbreaker",
+	"ratelimit",
+	"faultinjection",
+	"loadbalancer",
+	"trafficlog",
+	"traffictrace",
+}
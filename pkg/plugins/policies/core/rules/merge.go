@@ -0,0 +1,71 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rules
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+import (
+	jsonpatch "github.com/evanphx/json-patch/v5"
+
+	"github.com/pkg/errors"
+)
+
+import (
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+// MergeConfs merges the configurations with JSON Merge Patch (RFC 7386). Configurations
+// are applied in order, so fields set by the latter ones override the fields of the former ones,
+// while the fields not set by the latter ones are preserved.
+func MergeConfs(confs []interface{}) (interface{}, error) {
+	if len(confs) == 0 {
+		return nil, nil
+	}
+
+	confType := reflect.TypeOf(confs[0])
+	result := reflect.New(confType).Interface()
+	resultBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, conf := range confs {
+		if reflect.TypeOf(conf) != confType {
+			return nil, errors.Errorf("could not merge configurations of different types %s and %s", confType, reflect.TypeOf(conf))
+		}
+		confBytes, err := json.Marshal(conf)
+		if err != nil {
+			return nil, err
+		}
+		resultBytes, err = jsonpatch.MergePatch(resultBytes, confBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := json.Unmarshal(resultBytes, result); err != nil {
+		return nil, err
+	}
+	if t, ok := result.(core_model.TransformDefaultAfterMerge); ok {
+		t.Transform()
+	}
+	return reflect.ValueOf(result).Elem().Interface(), nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rules_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	. "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+)
+
+type mergeConf struct {
+	Name   *string            `json:"name,omitempty"`
+	Items  []string           `json:"items,omitempty"`
+	Labels map[string]*string `json:"labels,omitempty"`
+	Total  int                `json:"total,omitempty"`
+}
+
+// transformedConf counts its items once the confs are merged.
+type transformedConf struct {
+	Items []string `json:"items,omitempty"`
+	Count int      `json:"-"`
+}
+
+func (c *transformedConf) Transform() {
+	c.Count = len(c.Items)
+}
+
+var _ = Describe("MergeConfs", func() {
+	type testCase struct {
+		confs    []interface{}
+		expected interface{}
+	}
+
+	DescribeTable("should merge the confs with JSON merge patch",
+		func(given testCase) {
+			// when
+			merged, err := MergeConfs(given.confs)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(merged).To(Equal(given.expected))
+		},
+		Entry("fields not set later are preserved", testCase{
+			confs: []interface{}{
+				mergeConf{Name: pointer.To("first"), Total: 1},
+				mergeConf{Total: 2},
+			},
+			expected: mergeConf{Name: pointer.To("first"), Total: 2},
+		}),
+		Entry("lists are replaced, not appended", testCase{
+			confs: []interface{}{
+				mergeConf{Items: []string{"a", "b"}},
+				mergeConf{Items: []string{"c"}},
+			},
+			expected: mergeConf{Items: []string{"c"}},
+		}),
+		Entry("maps are merged and null values remove the keys", testCase{
+			confs: []interface{}{
+				mergeConf{Labels: map[string]*string{"team": pointer.To("dubbo"), "env": pointer.To("dev")}},
+				mergeConf{Labels: map[string]*string{"env": nil, "zone": pointer.To("zone-1")}},
+			},
+			expected: mergeConf{Labels: map[string]*string{"team": pointer.To("dubbo"), "zone": pointer.To("zone-1")}},
+		}),
+		Entry("the merged conf is transformed", testCase{
+			confs: []interface{}{
+				transformedConf{Items: []string{"a", "b"}},
+			},
+			expected: transformedConf{Items: []string{"a", "b"}, Count: 2},
+		}),
+	)
+
+	It("should return nil without confs", func() {
+		// when
+		merged, err := MergeConfs(nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(merged).To(BeNil())
+	})
+
+	It("should fail on confs of different types", func() {
+		// when
+		_, err := MergeConfs([]interface{}{mergeConf{}, transformedConf{}})
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not merge configurations of different types")))
+	})
+})
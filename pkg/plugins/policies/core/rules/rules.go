@@ -20,9 +20,15 @@ package rules
 import (
 	"encoding"
 	"fmt"
+	"sort"
 )
 
 import (
+	"github.com/pkg/errors"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
 	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
 	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
 )
@@ -174,6 +180,9 @@ func SubsetFromTags(tags map[string]string) Subset {
 	for k, v := range tags {
 		subset = append(subset, Tag{Key: k, Value: v})
 	}
+	sort.Slice(subset, func(i, j int) bool {
+		return subset[i].Key < subset[j].Key
+	})
 	return subset
 }
 
@@ -207,3 +216,260 @@ type Rule struct {
 }
 
 type Rules []*Rule
+
+// Compute returns the configuration for the given subset. Rules are sorted from the most
+// specific to the least specific, so the first rule whose subset contains the given one wins.
+func (rs Rules) Compute(sub Subset) *Rule {
+	for _, rule := range rs {
+		if rule.Subset.IsSubset(sub) {
+			return rule
+		}
+	}
+	return nil
+}
+
+func BuildPolicyItemsWithMeta(items []core_model.PolicyItem, meta core_model.ResourceMeta) []PolicyItemWithMeta {
+	var result []PolicyItemWithMeta
+	for _, item := range items {
+		result = append(result, PolicyItemWithMeta{
+			PolicyItem:   item,
+			ResourceMeta: meta,
+		})
+	}
+	return result
+}
+
+// BuildFromRules builds the rules for every inbound of the data plane proxy out of the 'from' lists
+// of the matched policies. Policies have to be sorted from the least to the most specific targetRef.
+func BuildFromRules(matchedPoliciesByInbound map[InboundListener][]core_model.Resource) (FromRules, error) {
+	rulesByInbound := map[InboundListener]Rules{}
+	for inbound, policies := range matchedPoliciesByInbound {
+		var fromList []PolicyItemWithMeta
+		for _, p := range policies {
+			policyWithFrom, ok := p.GetSpec().(core_model.PolicyWithFromList)
+			if !ok {
+				return FromRules{}, nil
+			}
+			fromList = append(fromList, BuildPolicyItemsWithMeta(policyWithFrom.GetFromList(), p.GetMeta())...)
+		}
+		rules, err := BuildRules(fromList)
+		if err != nil {
+			return FromRules{}, err
+		}
+		rulesByInbound[inbound] = rules
+	}
+	return FromRules{Rules: rulesByInbound}, nil
+}
+
+// BuildToRules builds the rules for the outbounds of the data plane proxy out of the 'to' lists
+// of the matched policies. Policies have to be sorted from the least to the most specific targetRef.
+func BuildToRules(matchedPolicies []core_model.Resource) (ToRules, error) {
+	var toList []PolicyItemWithMeta
+	for _, p := range matchedPolicies {
+		policyWithTo, ok := p.GetSpec().(core_model.PolicyWithToList)
+		if !ok {
+			return ToRules{}, nil
+		}
+		toList = append(toList, BuildPolicyItemsWithMeta(policyWithTo.GetToList(), p.GetMeta())...)
+	}
+	rules, err := BuildRules(toList)
+	if err != nil {
+		return ToRules{}, err
+	}
+	return ToRules{Rules: rules}, nil
+}
+
+// BuildSingleItemRules builds the rules of the policies that configure the data plane proxy
+// as a whole. Policies have to be sorted from the least to the most specific targetRef.
+func BuildSingleItemRules(matchedPolicies []core_model.Resource) (SingleItemRules, error) {
+	var items []PolicyItemWithMeta
+	for _, p := range matchedPolicies {
+		policyWithSingleItem, ok := p.GetSpec().(core_model.PolicyWithSingleItem)
+		if !ok {
+			return SingleItemRules{}, nil
+		}
+		items = append(items, PolicyItemWithMeta{
+			PolicyItem:   policyWithSingleItem.GetPolicyItem(),
+			ResourceMeta: p.GetMeta(),
+		})
+	}
+	rules, err := BuildRules(items)
+	if err != nil {
+		return SingleItemRules{}, err
+	}
+	return SingleItemRules{Rules: rules}, nil
+}
+
+// BuildRules creates a list of rules with negations sorted by the number of positive tags.
+// If rules with negative tags are filtered out then the order becomes 'most specific to less specific'.
+// Filtering out of negative rules could be useful for XDS generators that don't have a way to represent negations.
+//
+// When the policies have the following 'to' items:
+//
+//	to:
+//	  - targetRef: {kind: MeshService, name: backend}
+//	    default: {connectionTimeout: 10s}
+//	  - targetRef: {kind: MeshServiceSubset, name: backend, tags: {version: v1}}
+//	    default: {idleTimeout: 1h}
+//
+// the rules are computed for every combination of the tags used in the targetRefs,
+// so 'backend' with 'version: v1' gets both connectionTimeout and idleTimeout, while
+// 'backend' with any other version gets only connectionTimeout.
+func BuildRules(list []PolicyItemWithMeta) (Rules, error) {
+	rules := Rules{}
+
+	// 1. Convert list of rules into the list of subsets
+	var subsets []Subset
+	for _, item := range list {
+		ss, err := asSubset(item.GetTargetRef())
+		if err != nil {
+			return nil, err
+		}
+		subsets = append(subsets, ss)
+	}
+
+	// 2. Create a flat list of tags
+	var tags []Tag
+	uniqueKeys := map[string]struct{}{}
+	for _, ss := range subsets {
+		for _, t := range ss {
+			key := fmt.Sprintf("%s=%s", t.Key, t.Value)
+			if _, ok := uniqueKeys[key]; !ok {
+				tags = append(tags, t)
+				uniqueKeys[key] = struct{}{}
+			}
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Key != tags[j].Key {
+			return tags[i].Key < tags[j].Key
+		}
+		return tags[i].Value < tags[j].Value
+	})
+
+	// 3. Iterate over all possible combinations with negations
+	iter := NewSubsetIter(tags)
+	for {
+		ss := iter.Next()
+		if ss == nil {
+			break
+		}
+		// 4. For each combination determine a configuration
+		var confs []interface{}
+		var origins []core_model.ResourceMeta
+		for i := range list {
+			if subsets[i].IsSubset(ss) {
+				confs = append(confs, list[i].GetDefault())
+				origins = append(origins, list[i].ResourceMeta)
+			}
+		}
+		if len(confs) == 0 {
+			continue
+		}
+		merged, err := MergeConfs(confs)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &Rule{
+			Subset: ss,
+			Conf:   merged,
+			Origin: origins,
+		})
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Subset.NumPositive() > rules[j].Subset.NumPositive()
+	})
+
+	return rules, nil
+}
+
+func asSubset(tr common_api.TargetRef) (Subset, error) {
+	switch tr.Kind {
+	case common_api.Mesh:
+		return MeshSubset(), nil
+	case common_api.MeshSubset:
+		return SubsetFromTags(tr.Tags), nil
+	case common_api.MeshService:
+		return MeshService(tr.Name), nil
+	case common_api.MeshServiceSubset:
+		return append(MeshService(tr.Name), SubsetFromTags(tr.Tags)...), nil
+	default:
+		return nil, errors.Errorf("can't represent %s as tags", tr.Kind)
+	}
+}
+
+// SubsetIter iterates over all combinations of the tags with and without negations.
+// Combinations that can't select any proxy, like {version: v1, version: v2}, are skipped.
+type SubsetIter struct {
+	current  []Tag
+	finished bool
+}
+
+func NewSubsetIter(tags []Tag) *SubsetIter {
+	return &SubsetIter{
+		current: tags,
+	}
+}
+
+// Next returns the next subset of the partition. When reaches the end Next returns 'nil'
+func (c *SubsetIter) Next() Subset {
+	if c.finished {
+		return nil
+	}
+	for {
+		hasNext := c.next()
+		if !hasNext {
+			c.finished = true
+			return c.simplified()
+		}
+		if result := c.simplified(); result != nil {
+			return result
+		}
+	}
+}
+
+func (c *SubsetIter) next() bool {
+	for idx := 0; idx < len(c.current); idx++ {
+		if c.current[idx].Not {
+			c.current[idx].Not = false
+		} else {
+			c.current[idx].Not = true
+			return true
+		}
+	}
+	return false
+}
+
+// simplified returns the current combination without redundant negations,
+// or nil when the combination contains different positive values of the same tag.
+func (c *SubsetIter) simplified() Subset {
+	ssByKey := map[string]Subset{}
+	var keys []string
+	for _, t := range c.current {
+		if _, ok := ssByKey[t.Key]; !ok {
+			keys = append(keys, t.Key)
+		}
+		ssByKey[t.Key] = append(ssByKey[t.Key], Tag{Key: t.Key, Value: t.Value, Not: t.Not})
+	}
+
+	result := Subset{}
+	for _, key := range keys {
+		ss := ssByKey[key]
+		var positive []Tag
+		for _, t := range ss {
+			if !t.Not {
+				positive = append(positive, t)
+			}
+		}
+		switch len(positive) {
+		case 0:
+			result = append(result, ss...)
+		case 1:
+			result = append(result, positive[0])
+		default:
+			return nil
+		}
+	}
+	return result
+}
@@ -0,0 +1,321 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rules_test
+
+import (
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	. "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	timeout_api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/api/v1alpha1"
+	traffictrace_api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/traffictrace/api/v1alpha1"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+)
+
+func duration(d time.Duration) *k8s.Duration {
+	return &k8s.Duration{Duration: d}
+}
+
+func timeoutPolicy(name string, spec *timeout_api.Timeout) core_model.Resource {
+	policy := timeout_api.NewTimeoutResource()
+	policy.SetMeta(&test_model.ResourceMeta{Name: name, Mesh: core_model.DefaultMesh})
+	Expect(policy.SetSpec(spec)).To(Succeed())
+	return policy
+}
+
+var (
+	meshRef    = common_api.TargetRef{Kind: common_api.Mesh}
+	backendRef = common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"}
+	backendV1  = common_api.TargetRef{
+		Kind: common_api.MeshServiceSubset,
+		Name: "backend",
+		Tags: map[string]string{"version": "v1"},
+	}
+)
+
+var _ = Describe("Rules", func() {
+	Describe("BuildToRules", func() {
+		It("should merge the confs of the subsets from the most to the least specific", func() {
+			// given
+			policies := []core_model.Resource{
+				timeoutPolicy("backend", &timeout_api.Timeout{
+					TargetRef: meshRef,
+					To: []timeout_api.To{{
+						TargetRef: backendRef,
+						Default:   timeout_api.Conf{ConnectionTimeout: duration(10 * time.Second)},
+					}},
+				}),
+				timeoutPolicy("backend-v1", &timeout_api.Timeout{
+					TargetRef: meshRef,
+					To: []timeout_api.To{{
+						TargetRef: backendV1,
+						Default:   timeout_api.Conf{IdleTimeout: duration(time.Hour)},
+					}},
+				}),
+			}
+
+			// when
+			toRules, err := BuildToRules(policies)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(toRules.Rules).To(HaveLen(2))
+			Expect(toRules.Rules[0].Subset).To(Equal(Subset{
+				{Key: mesh_proto.ServiceTag, Value: "backend"},
+				{Key: "version", Value: "v1"},
+			}))
+			Expect(toRules.Rules[0].Conf).To(Equal(timeout_api.Conf{
+				ConnectionTimeout: duration(10 * time.Second),
+				IdleTimeout:       duration(time.Hour),
+			}))
+			Expect(toRules.Rules[0].Origin).To(HaveLen(2))
+			Expect(toRules.Rules[1].Subset).To(Equal(Subset{
+				{Key: mesh_proto.ServiceTag, Value: "backend"},
+				{Key: "version", Value: "v1", Not: true},
+			}))
+			Expect(toRules.Rules[1].Conf).To(Equal(timeout_api.Conf{
+				ConnectionTimeout: duration(10 * time.Second),
+			}))
+			Expect(toRules.Rules[1].Origin).To(HaveLen(1))
+			Expect(toRules.Rules[1].Origin[0].GetName()).To(Equal("backend"))
+		})
+
+		It("should let the policies that come later override the earlier ones", func() {
+			// given
+			policies := []core_model.Resource{
+				timeoutPolicy("mesh-wide", &timeout_api.Timeout{
+					TargetRef: meshRef,
+					To: []timeout_api.To{{
+						TargetRef: meshRef,
+						Default: timeout_api.Conf{
+							ConnectionTimeout: duration(time.Second),
+							IdleTimeout:       duration(time.Hour),
+						},
+					}},
+				}),
+				timeoutPolicy("backend", &timeout_api.Timeout{
+					TargetRef: backendRef,
+					To: []timeout_api.To{{
+						TargetRef: meshRef,
+						Default:   timeout_api.Conf{ConnectionTimeout: duration(2 * time.Second)},
+					}},
+				}),
+			}
+
+			// when
+			toRules, err := BuildToRules(policies)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(toRules.Rules).To(HaveLen(1))
+			Expect(toRules.Rules[0].Subset).To(BeEmpty())
+			Expect(toRules.Rules[0].Conf).To(Equal(timeout_api.Conf{
+				ConnectionTimeout: duration(2 * time.Second),
+				IdleTimeout:       duration(time.Hour),
+			}))
+		})
+
+		It("should fail on a targetRef that can't be represented as tags", func() {
+			// given
+			policies := []core_model.Resource{
+				timeoutPolicy("unknown", &timeout_api.Timeout{
+					TargetRef: meshRef,
+					To: []timeout_api.To{{
+						TargetRef: common_api.TargetRef{Kind: "MeshGateway"},
+					}},
+				}),
+			}
+
+			// when
+			_, err := BuildToRules(policies)
+
+			// then
+			Expect(err).To(MatchError("can't represent MeshGateway as tags"))
+		})
+	})
+
+	Describe("BuildFromRules", func() {
+		It("should build the rules of every inbound separately", func() {
+			// given
+			meshWide := timeoutPolicy("mesh-wide", &timeout_api.Timeout{
+				TargetRef: meshRef,
+				From: []timeout_api.From{{
+					TargetRef: meshRef,
+					Default:   timeout_api.Conf{IdleTimeout: duration(time.Hour)},
+				}},
+			})
+			fromWeb := timeoutPolicy("from-web", &timeout_api.Timeout{
+				TargetRef: backendRef,
+				From: []timeout_api.From{{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					Default:   timeout_api.Conf{IdleTimeout: duration(time.Minute)},
+				}},
+			})
+			backendInbound := InboundListener{Address: "192.168.0.1", Port: 20880}
+			otherInbound := InboundListener{Address: "192.168.0.1", Port: 20881}
+
+			// when
+			fromRules, err := BuildFromRules(map[InboundListener][]core_model.Resource{
+				backendInbound: {meshWide, fromWeb},
+				otherInbound:   {meshWide},
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fromRules.Rules[otherInbound]).To(HaveLen(1))
+			Expect(fromRules.Rules[otherInbound][0].Conf).To(Equal(timeout_api.Conf{IdleTimeout: duration(time.Hour)}))
+
+			backendRules := fromRules.Rules[backendInbound]
+			Expect(backendRules.Compute(MeshService("web")).Conf).To(Equal(timeout_api.Conf{IdleTimeout: duration(time.Minute)}))
+			Expect(backendRules.Compute(MeshService("frontend")).Conf).To(Equal(timeout_api.Conf{IdleTimeout: duration(time.Hour)}))
+		})
+	})
+
+	Describe("BuildSingleItemRules", func() {
+		It("should merge the confs of the policies selecting the proxy", func() {
+			// given
+			meshWide := traffictrace_api.NewTrafficTraceResource()
+			meshWide.SetMeta(&test_model.ResourceMeta{Name: "mesh-wide", Mesh: core_model.DefaultMesh})
+			Expect(meshWide.SetSpec(&traffictrace_api.TrafficTrace{
+				TargetRef: meshRef,
+				Default: traffictrace_api.Conf{
+					Backend:  pointer.To("zipkin"),
+					Disabled: pointer.To(false),
+				},
+			})).To(Succeed())
+			backend := traffictrace_api.NewTrafficTraceResource()
+			backend.SetMeta(&test_model.ResourceMeta{Name: "backend", Mesh: core_model.DefaultMesh})
+			Expect(backend.SetSpec(&traffictrace_api.TrafficTrace{
+				TargetRef: backendRef,
+				Default: traffictrace_api.Conf{
+					Disabled: pointer.To(true),
+				},
+			})).To(Succeed())
+
+			// when
+			singleItemRules, err := BuildSingleItemRules([]core_model.Resource{meshWide, backend})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(singleItemRules.Rules).To(HaveLen(1))
+			Expect(singleItemRules.Rules[0].Subset).To(BeEmpty())
+			Expect(singleItemRules.Rules[0].Conf).To(Equal(traffictrace_api.Conf{
+				Backend:  pointer.To("zipkin"),
+				Disabled: pointer.To(true),
+			}))
+		})
+
+		It("should not build rules for the policies with lists", func() {
+			// when
+			singleItemRules, err := BuildSingleItemRules([]core_model.Resource{
+				timeoutPolicy("mesh-wide", &timeout_api.Timeout{TargetRef: meshRef}),
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(singleItemRules.Rules).To(BeEmpty())
+		})
+	})
+
+	Describe("Compute", func() {
+		rules := Rules{
+			{Subset: Subset{{Key: mesh_proto.ServiceTag, Value: "backend"}, {Key: "version", Value: "v1"}}, Conf: "backend-v1"},
+			{Subset: Subset{{Key: mesh_proto.ServiceTag, Value: "backend"}, {Key: "version", Value: "v1", Not: true}}, Conf: "backend"},
+			{Subset: Subset{{Key: mesh_proto.ServiceTag, Value: "backend", Not: true}}, Conf: "others"},
+		}
+
+		DescribeTable("should pick the first rule containing the subset",
+			func(tags map[string]string, expected interface{}) {
+				// when
+				rule := rules.Compute(SubsetFromTags(tags))
+
+				// then
+				if expected == nil {
+					Expect(rule).To(BeNil())
+				} else {
+					Expect(rule.Conf).To(Equal(expected))
+				}
+			},
+			Entry("the most specific subset", map[string]string{mesh_proto.ServiceTag: "backend", "version": "v1"}, "backend-v1"),
+			Entry("a negated tag", map[string]string{mesh_proto.ServiceTag: "backend", "version": "v2"}, "backend"),
+			Entry("another service", map[string]string{mesh_proto.ServiceTag: "web"}, "others"),
+			Entry("a subset not covered by any rule", map[string]string{mesh_proto.ServiceTag: "backend"}, nil),
+		)
+	})
+
+	Describe("SubsetIter", func() {
+		collect := func(tags []Tag) []Subset {
+			var subsets []Subset
+			iter := NewSubsetIter(tags)
+			for {
+				ss := iter.Next()
+				if ss == nil {
+					return subsets
+				}
+				subsets = append(subsets, ss)
+			}
+		}
+
+		It("should return the empty subset when there are no tags", func() {
+			Expect(collect(nil)).To(Equal([]Subset{{}}))
+		})
+
+		It("should return every combination of the negations", func() {
+			// when
+			subsets := collect([]Tag{
+				{Key: "app", Value: "backend"},
+				{Key: "version", Value: "v1"},
+			})
+
+			// then
+			Expect(subsets).To(ConsistOf(
+				Subset{{Key: "app", Value: "backend"}, {Key: "version", Value: "v1"}},
+				Subset{{Key: "app", Value: "backend", Not: true}, {Key: "version", Value: "v1"}},
+				Subset{{Key: "app", Value: "backend"}, {Key: "version", Value: "v1", Not: true}},
+				Subset{{Key: "app", Value: "backend", Not: true}, {Key: "version", Value: "v1", Not: true}},
+			))
+		})
+
+		It("should skip the combinations with different positive values of the same tag", func() {
+			// when
+			subsets := collect([]Tag{
+				{Key: "version", Value: "v1"},
+				{Key: "version", Value: "v2"},
+			})
+
+			// then
+			Expect(subsets).To(ConsistOf(
+				Subset{{Key: "version", Value: "v1"}},
+				Subset{{Key: "version", Value: "v2"}},
+				Subset{{Key: "version", Value: "v1", Not: true}, {Key: "version", Value: "v2", Not: true}},
+			))
+		})
+	})
+})
@@ -0,0 +1,52 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tags"
+)
+
+// SubsetHeaderMatchers matches the requests of the clients from the subset by the
+// tags header set by their proxies, negated tags of the subset are matched by the
+// inverted matchers. An empty subset matches all the requests.
+func SubsetHeaderMatchers(subset core_rules.Subset) []*envoy_route.HeaderMatcher {
+	var matchers []*envoy_route.HeaderMatcher
+	for _, tag := range subset {
+		matchers = append(matchers, &envoy_route.HeaderMatcher{
+			Name: tags.TagsHeaderName,
+			HeaderMatchSpecifier: &envoy_route.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher.StringMatcher{
+					MatchPattern: &envoy_type_matcher.StringMatcher_SafeRegex{
+						SafeRegex: &envoy_type_matcher.RegexMatcher{
+							Regex: tags.MatchingRegex(mesh_proto.SingleValueTagSet{tag.Key: tag.Value}),
+						},
+					},
+				},
+			},
+			InvertMatch: tag.Not,
+		})
+	}
+	return matchers
+}
@@ -0,0 +1,91 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// +kubebuilder:object:generate=true
+package v1alpha1
+
+import (
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+)
+
+// FaultInjection
+// +dubbo:policy:singular_display_name=Fault Injection
+type FaultInjection struct {
+	// TargetRef is a reference to the resource the policy takes an effect on.
+	// The resource could be either a real store object or virtual resource
+	// defined inplace.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// From list makes a match between clients and corresponding configurations
+	From []From `json:"from,omitempty"`
+}
+
+type From struct {
+	// TargetRef is a reference to the resource that represents a group of
+	// clients.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// Default is a configuration specific to the group of clients referenced in
+	// 'targetRef'
+	Default Conf `json:"default,omitempty"`
+}
+
+type Conf struct {
+	// Http is a list of faults injected into the HTTP, gRPC and Triple requests
+	// of the clients
+	Http *[]FaultInjectionConf `json:"http,omitempty"`
+}
+
+type FaultInjectionConf struct {
+	// Abort defines a configuration of not delivering requests to the provider
+	// and responding with the given status instead
+	Abort *AbortConf `json:"abort,omitempty"`
+	// Delay defines a configuration of delaying the requests
+	Delay *DelayConf `json:"delay,omitempty"`
+	// ResponseBandwidth defines a configuration of limiting the bandwidth
+	// of the responses
+	ResponseBandwidth *ResponseBandwidthConf `json:"responseBandwidth,omitempty"`
+}
+
+type AbortConf struct {
+	// HttpStatus is the HTTP status code returned to the aborted requests
+	HttpStatus *int32 `json:"httpStatus,omitempty"`
+	// GrpcStatus is the gRPC status code returned to the aborted gRPC and Triple requests
+	GrpcStatus *uint32 `json:"grpcStatus,omitempty"`
+	// Percentage of the requests which are aborted, either an integer or a string
+	// holding a decimal number, e.g. "0.5"
+	Percentage intstr.IntOrString `json:"percentage"`
+}
+
+type DelayConf struct {
+	// Value is the duration of the delay
+	Value k8s.Duration `json:"value"`
+	// Percentage of the requests which are delayed, either an integer or a string
+	// holding a decimal number, e.g. "0.5"
+	Percentage intstr.IntOrString `json:"percentage"`
+}
+
+type ResponseBandwidthConf struct {
+	// Limit is the bandwidth limit of the responses in kbps, Mbps or Gbps, e.g. "10kbps"
+	Limit string `json:"limit"`
+	// Percentage of the requests which responses are limited, either an integer
+	// or a string holding a decimal number, e.g. "0.5"
+	Percentage intstr.IntOrString `json:"percentage"`
+}
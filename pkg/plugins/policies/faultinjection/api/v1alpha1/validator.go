@@ -0,0 +1,103 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	matcher_validators "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers/validators"
+)
+
+func (r *FaultInjectionResource) validate() error {
+	var verr validators.ValidationError
+	path := validators.RootedAt("spec")
+	verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(r.Spec.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+		SupportedKinds: []common_api.TargetRefKind{
+			common_api.Mesh,
+			common_api.MeshSubset,
+			common_api.MeshService,
+			common_api.MeshServiceSubset,
+		},
+	}))
+	if len(r.Spec.From) == 0 {
+		verr.AddViolationAt(path.Field("from"), validators.MustNotBeEmpty)
+	}
+	verr.AddErrorAt(path, validateFrom(r.Spec.From))
+	return verr.OrNil()
+}
+
+func validateFrom(from []From) validators.ValidationError {
+	var verr validators.ValidationError
+	for idx, fromItem := range from {
+		path := validators.RootedAt("from").Index(idx)
+		verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(fromItem.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+			SupportedKinds: []common_api.TargetRefKind{
+				common_api.Mesh,
+				common_api.MeshSubset,
+				common_api.MeshService,
+				common_api.MeshServiceSubset,
+			},
+		}))
+		verr.AddErrorAt(path.Field("default"), validateDefault(fromItem.Default))
+	}
+	return verr
+}
+
+func validateDefault(conf Conf) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if conf.Http == nil {
+		verr.AddViolationAt(path.Field("http"), validators.MustBeDefined)
+		return verr
+	}
+	for idx, fault := range *conf.Http {
+		verr.AddErrorAt(path.Field("http").Index(idx), validateFault(fault))
+	}
+	return verr
+}
+
+func validateFault(fault FaultInjectionConf) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if fault.Abort == nil && fault.Delay == nil && fault.ResponseBandwidth == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("abort", "delay", "responseBandwidth"))
+	}
+	if abort := fault.Abort; abort != nil {
+		abortPath := path.Field("abort")
+		switch {
+		case (abort.HttpStatus == nil) == (abort.GrpcStatus == nil):
+			verr.AddViolationAt(abortPath, validators.MustHaveExactlyOneOf("abort", "httpStatus", "grpcStatus"))
+		case abort.HttpStatus != nil:
+			verr.Add(validators.ValidateStatusCode(abortPath.Field("httpStatus"), *abort.HttpStatus))
+		case *abort.GrpcStatus > 16:
+			verr.AddViolationAt(abortPath.Field("grpcStatus"), "must be in inclusive range [0, 16]")
+		}
+		verr.Add(validators.ValidatePercentage(abortPath.Field("percentage"), abort.Percentage))
+	}
+	if delay := fault.Delay; delay != nil {
+		delayPath := path.Field("delay")
+		verr.Add(validators.ValidateDurationGreaterThanZero(delayPath.Field("value"), delay.Value))
+		verr.Add(validators.ValidatePercentage(delayPath.Field("percentage"), delay.Percentage))
+	}
+	if bandwidth := fault.ResponseBandwidth; bandwidth != nil {
+		bandwidthPath := path.Field("responseBandwidth")
+		verr.Add(validators.ValidateBandwidth(bandwidthPath.Field("limit"), bandwidth.Limit))
+		verr.Add(validators.ValidatePercentage(bandwidthPath.Field("percentage"), bandwidth.Percentage))
+	}
+	return verr
+}
@@ -0,0 +1,139 @@
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	_ "embed"
+	"fmt"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+//go:embed schema.yaml
+var rawSchema []byte
+
+func init() {
+	var schema spec.Schema
+	if err := yaml.Unmarshal(rawSchema, &schema); err != nil {
+		panic(err)
+	}
+	rawSchema = nil
+	FaultInjectionResourceTypeDescriptor.Schema = &schema
+}
+
+const (
+	FaultInjectionType model.ResourceType = "FaultInjection"
+)
+
+var _ model.Resource = &FaultInjectionResource{}
+
+type FaultInjectionResource struct {
+	Meta model.ResourceMeta
+	Spec *FaultInjection
+}
+
+func NewFaultInjectionResource() *FaultInjectionResource {
+	return &FaultInjectionResource{
+		Spec: &FaultInjection{},
+	}
+}
+
+func (t *FaultInjectionResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *FaultInjectionResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *FaultInjectionResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *FaultInjectionResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*FaultInjection)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &FaultInjection{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *FaultInjectionResource) Descriptor() model.ResourceTypeDescriptor {
+	return FaultInjectionResourceTypeDescriptor
+}
+
+func (t *FaultInjectionResource) Validate() error {
+	if v, ok := interface{}(t).(interface{ validate() error }); !ok {
+		return nil
+	} else {
+		return v.validate()
+	}
+}
+
+var _ model.ResourceList = &FaultInjectionResourceList{}
+
+type FaultInjectionResourceList struct {
+	Items      []*FaultInjectionResource
+	Pagination model.Pagination
+}
+
+func (l *FaultInjectionResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *FaultInjectionResourceList) GetItemType() model.ResourceType {
+	return FaultInjectionType
+}
+
+func (l *FaultInjectionResourceList) NewItem() model.Resource {
+	return NewFaultInjectionResource()
+}
+
+func (l *FaultInjectionResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*FaultInjectionResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*FaultInjectionResource)(nil), r)
+	}
+}
+
+func (l *FaultInjectionResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *FaultInjectionResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var FaultInjectionResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                FaultInjectionType,
+	Resource:            NewFaultInjectionResource(),
+	ResourceList:        &FaultInjectionResourceList{},
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag | model.ZoneToGlobalFlag,
+	WsPath:              "faultinjections",
+	DubboctlArg:         "faultinjection",
+	DubboctlListArg:     "faultinjections",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	IsExperimental:      false,
+	SingularDisplayName: "Fault Injection",
+	PluralDisplayName:   "Fault Injections",
+	IsPluginOriginated:  true,
+}
@@ -0,0 +1,104 @@
+// Generated by tools/policy-gen
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	policy "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection/api/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/metadata"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=dubbo,scope=Namespaced
+type FaultInjection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the Dubbo FaultInjection resource.
+	// +kubebuilder:validation:Optional
+	Spec *policy.FaultInjection `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type FaultInjectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FaultInjection `json:"items"`
+}
+
+func (cb *FaultInjection) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *FaultInjection) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *FaultInjection) GetMesh() string {
+	if mesh, ok := cb.ObjectMeta.Labels[metadata.DubboMeshLabel]; ok {
+		return mesh
+	} else {
+		return core_model.DefaultMesh
+	}
+}
+
+func (cb *FaultInjection) SetMesh(mesh string) {
+	if cb.ObjectMeta.Labels == nil {
+		cb.ObjectMeta.Labels = map[string]string{}
+	}
+	cb.ObjectMeta.Labels[metadata.DubboMeshLabel] = mesh
+}
+
+func (cb *FaultInjection) GetSpec() (core_model.ResourceSpec, error) {
+	return cb.Spec, nil
+}
+
+func (cb *FaultInjection) SetSpec(spec core_model.ResourceSpec) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*policy.FaultInjection); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = spec.(*policy.FaultInjection)
+}
+
+func (cb *FaultInjection) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *FaultInjectionList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	SchemeBuilder.Register(&FaultInjection{}, &FaultInjectionList{})
+	registry.RegisterObjectType(&policy.FaultInjection{}, &FaultInjection{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "FaultInjection",
+		},
+	})
+	registry.RegisterListType(&policy.FaultInjection{}, &FaultInjectionList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "FaultInjectionList",
+		},
+	})
+}
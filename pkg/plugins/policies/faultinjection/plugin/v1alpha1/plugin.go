@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers"
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	policies_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/xds"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection/api/v1alpha1"
+	plugin_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection/plugin/xds"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+)
+
+var _ core_plugins.PolicyPlugin = &plugin{}
+
+type plugin struct{}
+
+func NewPlugin() core_plugins.Plugin {
+	return &plugin{}
+}
+
+func (p plugin) MatchedPolicies(dataplane *core_mesh.DataplaneResource, resources xds_context.Resources) (core_xds.TypedMatchingPolicies, error) {
+	return matchers.MatchedPolicies(api.FaultInjectionType, dataplane, resources)
+}
+
+func (p plugin) Apply(rs *core_xds.ResourceSet, ctx xds_context.Context, proxy *core_xds.Proxy) error {
+	if proxy.Dataplane == nil {
+		return nil
+	}
+	policies, ok := proxy.Policies.Dynamic[api.FaultInjectionType]
+	if !ok {
+		return nil
+	}
+
+	listeners := policies_xds.GatherListeners(rs)
+
+	for _, iface := range proxy.Dataplane.Spec.GetNetworking().GetInboundInterfaces() {
+		key := core_rules.InboundListener{
+			Address: iface.DataplaneIP,
+			Port:    iface.DataplanePort,
+		}
+		rules, ok := policies.FromRules.Rules[key]
+		if !ok {
+			continue
+		}
+		configurer := plugin_xds.Configurer{Rules: rules}
+		if err := configurer.ConfigureListener(listeners.Inbound[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1_test
+
+import (
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection/api/v1alpha1"
+	plugin "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection/plugin/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	"github.com/apache/dubbo-kubernetes/pkg/test/resources/samples"
+	test_xds "github.com/apache/dubbo-kubernetes/pkg/test/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+)
+
+var _ = Describe("FaultInjection", func() {
+	type testCase struct {
+		policies   []*api.FaultInjectionResource
+		goldenFile string
+	}
+
+	DescribeTable("should inject the faults into the resources of the proxy",
+		func(given testCase) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.FaultInjectionResourceList{Items: given.policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(test_xds.ResourcesYAML(rs)).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+		},
+		Entry("inbound", testCase{
+			policies: []*api.FaultInjectionResource{
+				test_xds.Policy(api.NewFaultInjectionResource, "web-faults", &api.FaultInjection{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					From: []api.From{
+						{
+							TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+							Default: api.Conf{
+								Http: &[]api.FaultInjectionConf{{
+									Delay: &api.DelayConf{
+										Value:      k8s.Duration{Duration: 5 * time.Second},
+										Percentage: intstr.FromString("0.5"),
+									},
+								}},
+							},
+						},
+						{
+							TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "frontend"},
+							Default: api.Conf{
+								Http: &[]api.FaultInjectionConf{{
+									Abort: &api.AbortConf{
+										HttpStatus: pointer.To[int32](503),
+										Percentage: intstr.FromInt(10),
+									},
+									ResponseBandwidth: &api.ResponseBandwidthConf{
+										Limit:      "100kbps",
+										Percentage: intstr.FromInt(100),
+									},
+								}},
+							},
+						},
+					},
+				}),
+			},
+			goldenFile: "faultinjection.inbound.golden.yaml",
+		}),
+	)
+
+	DescribeTable("should leave the resources of the proxy untouched",
+		func(policies []*api.FaultInjectionResource) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+			untouched, err := test_xds.ResourceSet(xdsCtx, dataplane)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.FaultInjectionResourceList{Items: policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).To(test_xds.MatchResources(untouched))
+		},
+		Entry("targetRef mismatch", []*api.FaultInjectionResource{
+			test_xds.Policy(api.NewFaultInjectionResource, "other", &api.FaultInjection{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "other"},
+				From: []api.From{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						Http: &[]api.FaultInjectionConf{{
+							Abort: &api.AbortConf{
+								GrpcStatus: pointer.To[uint32](14),
+								Percentage: intstr.FromInt(100),
+							},
+						}},
+					},
+				}},
+			}),
+		}),
+	)
+})
@@ -0,0 +1,154 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_common_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoy_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+import (
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	policies_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/xds"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection/api/v1alpha1"
+	util_k8s "github.com/apache/dubbo-kubernetes/pkg/util/k8s"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	listeners_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners/v3"
+)
+
+const filterName = "envoy.filters.http.fault"
+
+// Configurer inserts the fault filters of the from rules into the HTTP connection
+// managers of an inbound listener. Every rule gets its own filters, which are only
+// active for the requests of the clients from the subset of the rule, recognized
+// by the tags header set by their proxies.
+type Configurer struct {
+	Rules core_rules.Rules
+}
+
+func (c *Configurer) ConfigureListener(listener *envoy_listener.Listener) error {
+	if listener == nil {
+		return nil
+	}
+	for _, filterChain := range listener.FilterChains {
+		if err := listeners_v3.UpdateHTTPConnectionManager(filterChain, c.configureHcm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Configurer) configureHcm(hcm *envoy_hcm.HttpConnectionManager) error {
+	var filters []*envoy_hcm.HttpFilter
+	for _, rule := range c.Rules {
+		conf := rule.Conf.(api.Conf)
+		if conf.Http == nil {
+			continue
+		}
+		for _, fault := range *conf.Http {
+			filter, err := faultFilter(fault, rule.Subset)
+			if err != nil {
+				return err
+			}
+			filters = append(filters, filter)
+		}
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+	return policies_xds.InsertHTTPFiltersBeforeRouter(hcm, filters...)
+}
+
+func faultFilter(fault api.FaultInjectionConf, subset core_rules.Subset) (*envoy_hcm.HttpFilter, error) {
+	config := &envoy_fault.HTTPFault{
+		Headers: policies_xds.SubsetHeaderMatchers(subset),
+	}
+	if abort := fault.Abort; abort != nil {
+		percentage, err := toFractionalPercent(abort.Percentage)
+		if err != nil {
+			return nil, err
+		}
+		config.Abort = &envoy_fault.FaultAbort{
+			Percentage: percentage,
+		}
+		if abort.HttpStatus != nil {
+			config.Abort.ErrorType = &envoy_fault.FaultAbort_HttpStatus{
+				HttpStatus: uint32(*abort.HttpStatus),
+			}
+		} else {
+			config.Abort.ErrorType = &envoy_fault.FaultAbort_GrpcStatus{
+				GrpcStatus: *abort.GrpcStatus,
+			}
+		}
+	}
+	if delay := fault.Delay; delay != nil {
+		percentage, err := toFractionalPercent(delay.Percentage)
+		if err != nil {
+			return nil, err
+		}
+		config.Delay = &envoy_common_fault.FaultDelay{
+			FaultDelaySecifier: &envoy_common_fault.FaultDelay_FixedDelay{
+				FixedDelay: util_proto.Duration(delay.Value.Duration),
+			},
+			Percentage: percentage,
+		}
+	}
+	if bandwidth := fault.ResponseBandwidth; bandwidth != nil {
+		percentage, err := toFractionalPercent(bandwidth.Percentage)
+		if err != nil {
+			return nil, err
+		}
+		limitKbps, err := listeners_v3.ConvertBandwidthToKbps(bandwidth.Limit)
+		if err != nil {
+			return nil, err
+		}
+		config.ResponseRateLimit = &envoy_common_fault.FaultRateLimit{
+			LimitType: &envoy_common_fault.FaultRateLimit_FixedLimit_{
+				FixedLimit: &envoy_common_fault.FaultRateLimit_FixedLimit{
+					LimitKbps: limitKbps,
+				},
+			},
+			Percentage: percentage,
+		}
+	}
+	pbst, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_hcm.HttpFilter{
+		Name: filterName,
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: pbst,
+		},
+	}, nil
+}
+
+func toFractionalPercent(percentage intstr.IntOrString) (*envoy_type.FractionalPercent, error) {
+	value, err := util_k8s.ParsePercentage(percentage)
+	if err != nil {
+		return nil, err
+	}
+	return listeners_v3.ConvertPercentage(wrapperspb.Double(value)), nil
+}
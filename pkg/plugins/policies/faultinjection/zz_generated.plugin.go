@@ -0,0 +1,16 @@
+package faultinjection
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core"
+	api_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection/api/v1alpha1"
+	k8s_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection/k8s/v1alpha1"
+	plugin_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection/plugin/v1alpha1"
+)
+
+func init() {
+	core.Register(
+		api_v1alpha1.FaultInjectionResourceTypeDescriptor,
+		k8s_v1alpha1.AddToScheme,
+		plugin_v1alpha1.NewPlugin(),
+	)
+}
@@ -0,0 +1,12 @@
+package policies
+
+import (
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/faultinjection"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/loadbalancer"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/ratelimit"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/retry"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/trafficlog"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/traffictrace"
+)
@@ -0,0 +1,112 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// +kubebuilder:object:generate=true
+package v1alpha1
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+)
+
+// LoadBalancer
+// +dubbo:policy:singular_display_name=Load Balancer
+type LoadBalancer struct {
+	// TargetRef is a reference to the resource the policy takes an effect on.
+	// The resource could be either a real store object or virtual resource
+	// defined inplace.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// To list makes a match between the consumed services and corresponding configurations
+	To []To `json:"to,omitempty"`
+}
+
+type To struct {
+	// TargetRef is a reference to the resource that represents a group of
+	// destinations.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// Default is a configuration specific to the group of destinations referenced in
+	// 'targetRef'
+	Default Conf `json:"default,omitempty"`
+}
+
+type Conf struct {
+	// Type is the Dubbo load balancing strategy used to pick the provider
+	Type *Strategy `json:"type,omitempty"`
+	// LeastActive defines a configuration of the leastactive strategy
+	LeastActive *LeastActive `json:"leastActive,omitempty"`
+	// ConsistentHash defines a configuration of the consistenthash strategy
+	ConsistentHash *ConsistentHash `json:"consistentHash,omitempty"`
+	// LocalityAwareness defines whether the locality weights of the providers are
+	// taken into account when the locality aware load balancing is enabled in the Mesh.
+	// Providers are always picked from the highest priority locality first.
+	LocalityAwareness *LocalityAwareness `json:"localityAwareness,omitempty"`
+}
+
+type LeastActive struct {
+	// ChoiceCount is the number of random providers compared when picking the one
+	// with the least active requests. Default is 2.
+	ChoiceCount *uint32 `json:"choiceCount,omitempty"`
+}
+
+type ConsistentHash struct {
+	// MinRingSize is the minimum number of entries of the hash ring. Default is 1024.
+	MinRingSize *uint32 `json:"minRingSize,omitempty"`
+	// MaxRingSize is the maximum number of entries of the hash ring. Default is 8M.
+	MaxRingSize *uint32 `json:"maxRingSize,omitempty"`
+	// HashPolicies is a list of the request properties the hash is computed from.
+	// If not set, the requests are hashed on the address of the consumer.
+	HashPolicies *[]HashPolicy `json:"hashPolicies,omitempty"`
+}
+
+type HashPolicy struct {
+	// Type of the request property the hash is computed from. Attachment and Header
+	// are only available for the HTTP and Triple traffic.
+	Type HashPolicyType `json:"type"`
+	// Name of the attachment or of the header
+	Name string `json:"name,omitempty"`
+	// Terminal stops the evaluation of the following hash policies when this one
+	// produced a hash
+	Terminal *bool `json:"terminal,omitempty"`
+}
+
+type LocalityAwareness struct {
+	// Disabled turns off the locality weighted load balancing
+	Disabled *bool `json:"disabled,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=random;roundrobin;leastactive;consistenthash;shortestresponse
+type Strategy string
+
+var (
+	Random                 Strategy = "random"
+	RoundRobin             Strategy = "roundrobin"
+	LeastActiveStrategy    Strategy = "leastactive"
+	ConsistentHashStrategy Strategy = "consistenthash"
+	ShortestResponse       Strategy = "shortestresponse"
+)
+
+var AllStrategies = []Strategy{Random, RoundRobin, LeastActiveStrategy, ConsistentHashStrategy, ShortestResponse}
+
+// +kubebuilder:validation:Enum=Attachment;Header;SourceIP
+type HashPolicyType string
+
+var (
+	AttachmentType HashPolicyType = "Attachment"
+	HeaderType     HashPolicyType = "Header"
+	SourceIPType   HashPolicyType = "SourceIP"
+)
+
+var AllHashPolicyTypes = []HashPolicyType{AttachmentType, HeaderType, SourceIPType}
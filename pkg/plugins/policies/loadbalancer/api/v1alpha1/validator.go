@@ -0,0 +1,107 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	matcher_validators "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers/validators"
+)
+
+func (r *LoadBalancerResource) validate() error {
+	var verr validators.ValidationError
+	path := validators.RootedAt("spec")
+	verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(r.Spec.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+		SupportedKinds: []common_api.TargetRefKind{
+			common_api.Mesh,
+			common_api.MeshSubset,
+			common_api.MeshService,
+			common_api.MeshServiceSubset,
+		},
+	}))
+	if len(r.Spec.To) == 0 {
+		verr.AddViolationAt(path.Field("to"), validators.MustNotBeEmpty)
+	}
+	verr.AddErrorAt(path, validateTo(r.Spec.To))
+	return verr.OrNil()
+}
+
+func validateTo(to []To) validators.ValidationError {
+	var verr validators.ValidationError
+	for idx, toItem := range to {
+		path := validators.RootedAt("to").Index(idx)
+		verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(toItem.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+			SupportedKinds: []common_api.TargetRefKind{
+				common_api.Mesh,
+				common_api.MeshService,
+			},
+		}))
+		verr.AddErrorAt(path.Field("default"), validateDefault(toItem.Default))
+	}
+	return verr
+}
+
+func validateDefault(conf Conf) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if conf.Type == nil && conf.LeastActive == nil && conf.ConsistentHash == nil && conf.LocalityAwareness == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("type", "leastActive", "consistentHash", "localityAwareness"))
+	}
+	if conf.Type != nil && !slices.Contains(AllStrategies, *conf.Type) {
+		verr.AddViolationAt(path.Field("type"), fmt.Sprintf("unknown load balancing strategy %q", *conf.Type))
+	}
+	if conf.LeastActive != nil && conf.LeastActive.ChoiceCount != nil {
+		verr.Add(validators.ValidateIntegerGreaterThan(path.Field("leastActive").Field("choiceCount"), *conf.LeastActive.ChoiceCount, 1))
+	}
+	if conf.ConsistentHash != nil {
+		verr.AddErrorAt(path.Field("consistentHash"), validateConsistentHash(*conf.ConsistentHash))
+	}
+	return verr
+}
+
+func validateConsistentHash(consistentHash ConsistentHash) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("minRingSize"), consistentHash.MinRingSize))
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("maxRingSize"), consistentHash.MaxRingSize))
+	if consistentHash.MinRingSize != nil && consistentHash.MaxRingSize != nil && *consistentHash.MaxRingSize < *consistentHash.MinRingSize {
+		verr.AddViolationAt(path.Field("maxRingSize"), "must be greater than or equal to minRingSize")
+	}
+	if consistentHash.HashPolicies == nil {
+		return verr
+	}
+	for idx, hashPolicy := range *consistentHash.HashPolicies {
+		hashPolicyPath := path.Field("hashPolicies").Index(idx)
+		switch hashPolicy.Type {
+		case AttachmentType, HeaderType:
+			verr.Add(validators.ValidateStringDefined(hashPolicyPath.Field("name"), hashPolicy.Name))
+		case SourceIPType:
+			if hashPolicy.Name != "" {
+				verr.AddViolationAt(hashPolicyPath.Field("name"), fmt.Sprintf("must not be set when type is %s", SourceIPType))
+			}
+		default:
+			verr.AddViolationAt(hashPolicyPath.Field("type"), fmt.Sprintf("unknown hash policy type %q", hashPolicy.Type))
+		}
+	}
+	return verr
+}
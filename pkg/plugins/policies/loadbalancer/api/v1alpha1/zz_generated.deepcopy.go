@@ -0,0 +1,178 @@
+//go:build !ignore_autogenerated
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Conf) DeepCopyInto(out *Conf) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(Strategy)
+		**out = **in
+	}
+	if in.LeastActive != nil {
+		in, out := &in.LeastActive, &out.LeastActive
+		*out = new(LeastActive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConsistentHash != nil {
+		in, out := &in.ConsistentHash, &out.ConsistentHash
+		*out = new(ConsistentHash)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalityAwareness != nil {
+		in, out := &in.LocalityAwareness, &out.LocalityAwareness
+		*out = new(LocalityAwareness)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Conf.
+func (in *Conf) DeepCopy() *Conf {
+	if in == nil {
+		return nil
+	}
+	out := new(Conf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsistentHash) DeepCopyInto(out *ConsistentHash) {
+	*out = *in
+	if in.MinRingSize != nil {
+		in, out := &in.MinRingSize, &out.MinRingSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRingSize != nil {
+		in, out := &in.MaxRingSize, &out.MaxRingSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.HashPolicies != nil {
+		in, out := &in.HashPolicies, &out.HashPolicies
+		*out = new([]HashPolicy)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]HashPolicy, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsistentHash.
+func (in *ConsistentHash) DeepCopy() *ConsistentHash {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsistentHash)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashPolicy) DeepCopyInto(out *HashPolicy) {
+	*out = *in
+	if in.Terminal != nil {
+		in, out := &in.Terminal, &out.Terminal
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashPolicy.
+func (in *HashPolicy) DeepCopy() *HashPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HashPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeastActive) DeepCopyInto(out *LeastActive) {
+	*out = *in
+	if in.ChoiceCount != nil {
+		in, out := &in.ChoiceCount, &out.ChoiceCount
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeastActive.
+func (in *LeastActive) DeepCopy() *LeastActive {
+	if in == nil {
+		return nil
+	}
+	out := new(LeastActive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]To, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
+func (in *LoadBalancer) DeepCopy() *LoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalityAwareness) DeepCopyInto(out *LocalityAwareness) {
+	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalityAwareness.
+func (in *LocalityAwareness) DeepCopy() *LocalityAwareness {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalityAwareness)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *To) DeepCopyInto(out *To) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	in.Default.DeepCopyInto(&out.Default)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new To.
+func (in *To) DeepCopy() *To {
+	if in == nil {
+		return nil
+	}
+	out := new(To)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,139 @@
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	_ "embed"
+	"fmt"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+//go:embed schema.yaml
+var rawSchema []byte
+
+func init() {
+	var schema spec.Schema
+	if err := yaml.Unmarshal(rawSchema, &schema); err != nil {
+		panic(err)
+	}
+	rawSchema = nil
+	LoadBalancerResourceTypeDescriptor.Schema = &schema
+}
+
+const (
+	LoadBalancerType model.ResourceType = "LoadBalancer"
+)
+
+var _ model.Resource = &LoadBalancerResource{}
+
+type LoadBalancerResource struct {
+	Meta model.ResourceMeta
+	Spec *LoadBalancer
+}
+
+func NewLoadBalancerResource() *LoadBalancerResource {
+	return &LoadBalancerResource{
+		Spec: &LoadBalancer{},
+	}
+}
+
+func (t *LoadBalancerResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *LoadBalancerResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *LoadBalancerResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *LoadBalancerResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*LoadBalancer)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &LoadBalancer{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *LoadBalancerResource) Descriptor() model.ResourceTypeDescriptor {
+	return LoadBalancerResourceTypeDescriptor
+}
+
+func (t *LoadBalancerResource) Validate() error {
+	if v, ok := interface{}(t).(interface{ validate() error }); !ok {
+		return nil
+	} else {
+		return v.validate()
+	}
+}
+
+var _ model.ResourceList = &LoadBalancerResourceList{}
+
+type LoadBalancerResourceList struct {
+	Items      []*LoadBalancerResource
+	Pagination model.Pagination
+}
+
+func (l *LoadBalancerResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *LoadBalancerResourceList) GetItemType() model.ResourceType {
+	return LoadBalancerType
+}
+
+func (l *LoadBalancerResourceList) NewItem() model.Resource {
+	return NewLoadBalancerResource()
+}
+
+func (l *LoadBalancerResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*LoadBalancerResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*LoadBalancerResource)(nil), r)
+	}
+}
+
+func (l *LoadBalancerResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *LoadBalancerResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var LoadBalancerResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                LoadBalancerType,
+	Resource:            NewLoadBalancerResource(),
+	ResourceList:        &LoadBalancerResourceList{},
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag | model.ZoneToGlobalFlag,
+	WsPath:              "loadbalancers",
+	DubboctlArg:         "loadbalancer",
+	DubboctlListArg:     "loadbalancers",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	IsExperimental:      false,
+	SingularDisplayName: "Load Balancer",
+	PluralDisplayName:   "Load Balancers",
+	IsPluginOriginated:  true,
+}
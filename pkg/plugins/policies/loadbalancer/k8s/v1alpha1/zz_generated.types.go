@@ -0,0 +1,104 @@
+// Generated by tools/policy-gen
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	policy "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/loadbalancer/api/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/metadata"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=dubbo,scope=Namespaced
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the Dubbo LoadBalancer resource.
+	// +kubebuilder:validation:Optional
+	Spec *policy.LoadBalancer `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
+
+func (cb *LoadBalancer) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *LoadBalancer) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *LoadBalancer) GetMesh() string {
+	if mesh, ok := cb.ObjectMeta.Labels[metadata.DubboMeshLabel]; ok {
+		return mesh
+	} else {
+		return core_model.DefaultMesh
+	}
+}
+
+func (cb *LoadBalancer) SetMesh(mesh string) {
+	if cb.ObjectMeta.Labels == nil {
+		cb.ObjectMeta.Labels = map[string]string{}
+	}
+	cb.ObjectMeta.Labels[metadata.DubboMeshLabel] = mesh
+}
+
+func (cb *LoadBalancer) GetSpec() (core_model.ResourceSpec, error) {
+	return cb.Spec, nil
+}
+
+func (cb *LoadBalancer) SetSpec(spec core_model.ResourceSpec) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*policy.LoadBalancer); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = spec.(*policy.LoadBalancer)
+}
+
+func (cb *LoadBalancer) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *LoadBalancerList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancer{}, &LoadBalancerList{})
+	registry.RegisterObjectType(&policy.LoadBalancer{}, &LoadBalancer{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "LoadBalancer",
+		},
+	})
+	registry.RegisterListType(&policy.LoadBalancer{}, &LoadBalancerList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "LoadBalancerList",
+		},
+	})
+}
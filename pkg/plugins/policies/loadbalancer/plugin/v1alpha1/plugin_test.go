@@ -0,0 +1,135 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/loadbalancer/api/v1alpha1"
+	plugin "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/loadbalancer/plugin/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	"github.com/apache/dubbo-kubernetes/pkg/test/resources/samples"
+	test_xds "github.com/apache/dubbo-kubernetes/pkg/test/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+)
+
+var _ = Describe("LoadBalancer", func() {
+	type testCase struct {
+		protocol   core_mesh.Protocol
+		policies   []*api.LoadBalancerResource
+		goldenFile string
+	}
+
+	DescribeTable("should apply the load balancing strategies to the resources of the proxy",
+		func(given testCase) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(given.protocol, "backend")
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.LoadBalancerResourceList{Items: given.policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(test_xds.ResourcesYAML(rs)).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+		},
+		Entry("outbound Triple with consistenthash", testCase{
+			protocol: core_mesh.ProtocolTriple,
+			policies: []*api.LoadBalancerResource{
+				test_xds.Policy(api.NewLoadBalancerResource, "mesh-round-robin", &api.LoadBalancer{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+						Default: api.Conf{
+							Type: pointer.To(api.RoundRobin),
+						},
+					}},
+				}),
+				test_xds.Policy(api.NewLoadBalancerResource, "web-to-backend", &api.LoadBalancer{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"},
+						Default: api.Conf{
+							Type: pointer.To(api.ConsistentHashStrategy),
+							ConsistentHash: &api.ConsistentHash{
+								MinRingSize: pointer.To[uint32](64),
+								MaxRingSize: pointer.To[uint32](1024),
+								HashPolicies: &[]api.HashPolicy{
+									{Type: api.AttachmentType, Name: "User-Id", Terminal: pointer.To(true)},
+									{Type: api.SourceIPType},
+								},
+							},
+						},
+					}},
+				}),
+			},
+			goldenFile: "loadbalancer.outbound-consistenthash.golden.yaml",
+		}),
+		Entry("outbound TCP with leastactive", testCase{
+			protocol: core_mesh.ProtocolTCP,
+			policies: []*api.LoadBalancerResource{
+				test_xds.Policy(api.NewLoadBalancerResource, "mesh-least-active", &api.LoadBalancer{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+						Default: api.Conf{
+							Type:        pointer.To(api.LeastActiveStrategy),
+							LeastActive: &api.LeastActive{ChoiceCount: pointer.To[uint32](3)},
+						},
+					}},
+				}),
+			},
+			goldenFile: "loadbalancer.outbound-leastactive.golden.yaml",
+		}),
+	)
+
+	DescribeTable("should leave the resources of the proxy untouched",
+		func(policies []*api.LoadBalancerResource) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+			untouched, err := test_xds.ResourceSet(xdsCtx, dataplane)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.LoadBalancerResourceList{Items: policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).To(test_xds.MatchResources(untouched))
+		},
+		Entry("targetRef mismatch", []*api.LoadBalancerResource{
+			test_xds.Policy(api.NewLoadBalancerResource, "other", &api.LoadBalancer{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "other"},
+				To: []api.To{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						Type: pointer.To(api.Random),
+					},
+				}},
+			}),
+		}),
+	)
+})
@@ -0,0 +1,122 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	"strings"
+)
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+)
+
+import (
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/loadbalancer/api/v1alpha1"
+	listeners_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners/v3"
+)
+
+// HashPolicyConfigurer applies the hash policies of the consistenthash strategy to the
+// routes and the TCP proxies of an outbound listener. TCP proxies can only hash on
+// the address of the consumer, so the other hash policies are skipped for them.
+type HashPolicyConfigurer struct {
+	Conf api.Conf
+}
+
+func (c *HashPolicyConfigurer) ConfigureListener(listener *envoy_listener.Listener) error {
+	if listener == nil || c.Conf.Type == nil || *c.Conf.Type != api.ConsistentHashStrategy {
+		return nil
+	}
+	hashPolicies := c.hashPolicies()
+	for _, filterChain := range listener.FilterChains {
+		if err := listeners_v3.UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+			for _, virtualHost := range hcm.GetRouteConfig().GetVirtualHosts() {
+				for _, route := range virtualHost.GetRoutes() {
+					if action := route.GetRoute(); action != nil {
+						action.HashPolicy = routeHashPolicies(hashPolicies)
+					}
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := listeners_v3.UpdateTCPProxy(filterChain, func(proxy *envoy_tcp.TcpProxy) error {
+			proxy.HashPolicy = tcpHashPolicies(hashPolicies)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *HashPolicyConfigurer) hashPolicies() []api.HashPolicy {
+	if c.Conf.ConsistentHash == nil || c.Conf.ConsistentHash.HashPolicies == nil {
+		return []api.HashPolicy{{Type: api.SourceIPType}}
+	}
+	return *c.Conf.ConsistentHash.HashPolicies
+}
+
+func routeHashPolicies(hashPolicies []api.HashPolicy) []*envoy_route.RouteAction_HashPolicy {
+	var result []*envoy_route.RouteAction_HashPolicy
+	for _, hashPolicy := range hashPolicies {
+		routeHashPolicy := &envoy_route.RouteAction_HashPolicy{
+			Terminal: hashPolicy.Terminal != nil && *hashPolicy.Terminal,
+		}
+		switch hashPolicy.Type {
+		case api.AttachmentType:
+			// Triple carries the attachments of the invocations as lower-cased headers
+			routeHashPolicy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_Header_{
+				Header: &envoy_route.RouteAction_HashPolicy_Header{
+					HeaderName: strings.ToLower(hashPolicy.Name),
+				},
+			}
+		case api.HeaderType:
+			routeHashPolicy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_Header_{
+				Header: &envoy_route.RouteAction_HashPolicy_Header{
+					HeaderName: hashPolicy.Name,
+				},
+			}
+		case api.SourceIPType:
+			routeHashPolicy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &envoy_route.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			}
+		}
+		result = append(result, routeHashPolicy)
+	}
+	return result
+}
+
+func tcpHashPolicies(hashPolicies []api.HashPolicy) []*envoy_type.HashPolicy {
+	for _, hashPolicy := range hashPolicies {
+		if hashPolicy.Type == api.SourceIPType {
+			return []*envoy_type.HashPolicy{{
+				PolicySpecifier: &envoy_type.HashPolicy_SourceIp_{
+					SourceIp: &envoy_type.HashPolicy_SourceIp{},
+				},
+			}}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// +kubebuilder:object:generate=true
+package v1alpha1
+
+import (
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+)
+
+// RateLimit
+// +dubbo:policy:singular_display_name=Rate Limit
+type RateLimit struct {
+	// TargetRef is a reference to the resource the policy takes an effect on.
+	// The resource could be either a real store object or virtual resource
+	// defined inplace.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// From list makes a match between clients and corresponding configurations
+	From []From `json:"from,omitempty"`
+}
+
+type From struct {
+	// TargetRef is a reference to the resource that represents a group of
+	// clients.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// Default is a configuration specific to the group of clients referenced in
+	// 'targetRef'
+	Default Conf `json:"default,omitempty"`
+}
+
+type Conf struct {
+	// Local defines a configuration of the local rate limiting, which is enforced
+	// by every proxy of the provider on its own
+	Local *Local `json:"local,omitempty"`
+}
+
+type Local struct {
+	// HTTP defines a configuration of the rate limiting of HTTP and Triple requests
+	HTTP *LocalHTTP `json:"http,omitempty"`
+	// TCP defines a configuration of the rate limiting of TCP connections
+	TCP *LocalTCP `json:"tcp,omitempty"`
+}
+
+type LocalHTTP struct {
+	// Disabled turns off the rate limiting of the requests, which is useful
+	// to opt out the clients from the limits defined by a less specific policy
+	Disabled *bool `json:"disabled,omitempty"`
+	// RequestRate defines how many requests are allowed in the given interval
+	RequestRate *Rate `json:"requestRate,omitempty"`
+	// OnRateLimit defines the response to the requests which are rate limited
+	OnRateLimit *OnRateLimit `json:"onRateLimit,omitempty"`
+	// Methods is a list of Dubbo/Triple methods with their own request rate,
+	// which takes precedence over RequestRate
+	Methods []Method `json:"methods,omitempty"`
+}
+
+type LocalTCP struct {
+	// Disabled turns off the rate limiting of the connections
+	Disabled *bool `json:"disabled,omitempty"`
+	// ConnectionRate defines how many connections are allowed in the given interval
+	ConnectionRate *Rate `json:"connectionRate,omitempty"`
+}
+
+type Rate struct {
+	// Num is the number of requests or connections allowed in the interval
+	Num uint32 `json:"num"`
+	// Interval of the token bucket refill, must be at least 50ms
+	Interval k8s.Duration `json:"interval"`
+}
+
+type OnRateLimit struct {
+	// Status is the HTTP status code returned to the rate limited requests.
+	// Default is 429.
+	Status *uint32 `json:"status,omitempty"`
+	// Headers are added to the responses to the rate limited requests
+	Headers *HeaderModifier `json:"headers,omitempty"`
+}
+
+type HeaderModifier struct {
+	// Add appends the headers to the response, keeping the existing values
+	Add []HeaderKeyValue `json:"add,omitempty"`
+	// Set adds the headers to the response, overwriting the existing values
+	Set []HeaderKeyValue `json:"set,omitempty"`
+}
+
+type HeaderKeyValue struct {
+	// Name of the header
+	Name string `json:"name"`
+	// Value of the header
+	Value string `json:"value"`
+}
+
+type Method struct {
+	// Interface is the fully qualified name of the Dubbo interface, e.g. org.apache.dubbo.demo.GreetService
+	Interface string `json:"interface"`
+	// Name of the method of the interface
+	Name string `json:"name"`
+	// RequestRate defines how many requests to the method are allowed in the given interval
+	RequestRate *Rate `json:"requestRate,omitempty"`
+}
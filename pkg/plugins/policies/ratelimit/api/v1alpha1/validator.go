@@ -0,0 +1,155 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"time"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	matcher_validators "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers/validators"
+)
+
+// minInterval is the minimal fill interval of the token bucket accepted by Envoy
+const minInterval = 50 * time.Millisecond
+
+func (r *RateLimitResource) validate() error {
+	var verr validators.ValidationError
+	path := validators.RootedAt("spec")
+	verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(r.Spec.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+		SupportedKinds: []common_api.TargetRefKind{
+			common_api.Mesh,
+			common_api.MeshSubset,
+			common_api.MeshService,
+			common_api.MeshServiceSubset,
+		},
+	}))
+	if len(r.Spec.From) == 0 {
+		verr.AddViolationAt(path.Field("from"), validators.MustNotBeEmpty)
+	}
+	verr.AddErrorAt(path, validateFrom(r.Spec.From))
+	return verr.OrNil()
+}
+
+func validateFrom(from []From) validators.ValidationError {
+	var verr validators.ValidationError
+	for idx, fromItem := range from {
+		path := validators.RootedAt("from").Index(idx)
+		verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(fromItem.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+			SupportedKinds: []common_api.TargetRefKind{
+				common_api.Mesh,
+				common_api.MeshService,
+			},
+		}))
+		verr.AddErrorAt(path.Field("default"), validateDefault(fromItem.GetTargetRef(), fromItem.Default))
+	}
+	return verr
+}
+
+func validateDefault(targetRef common_api.TargetRef, conf Conf) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if conf.Local == nil {
+		verr.AddViolationAt(path.Field("local"), validators.MustBeDefined)
+		return verr
+	}
+	path = path.Field("local")
+	if conf.Local.HTTP == nil && conf.Local.TCP == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("http", "tcp"))
+	}
+	if conf.Local.HTTP != nil {
+		verr.AddErrorAt(path.Field("http"), validateLocalHTTP(*conf.Local.HTTP))
+	}
+	if conf.Local.TCP != nil {
+		// connections can't be told apart by the client before they are accepted
+		if targetRef.Kind != common_api.Mesh {
+			verr.AddViolationAt(path.Field("tcp"), "is only supported when targetRef.kind is Mesh")
+		}
+		verr.AddErrorAt(path.Field("tcp"), validateLocalTCP(*conf.Local.TCP))
+	}
+	return verr
+}
+
+func validateLocalHTTP(http LocalHTTP) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if http.Disabled != nil && *http.Disabled {
+		return verr
+	}
+	if http.RequestRate == nil && len(http.Methods) == 0 {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("requestRate", "methods"))
+	}
+	if http.RequestRate != nil {
+		verr.AddErrorAt(path.Field("requestRate"), validateRate(*http.RequestRate))
+	}
+	if onRateLimit := http.OnRateLimit; onRateLimit != nil {
+		if onRateLimit.Status != nil {
+			verr.Add(validators.ValidateStatusCode(path.Field("onRateLimit").Field("status"), int32(*onRateLimit.Status)))
+		}
+		if headers := onRateLimit.Headers; headers != nil {
+			headersPath := path.Field("onRateLimit").Field("headers")
+			verr.AddErrorAt(headersPath.Field("add"), validateHeaders(headers.Add))
+			verr.AddErrorAt(headersPath.Field("set"), validateHeaders(headers.Set))
+		}
+	}
+	for idx, method := range http.Methods {
+		methodPath := path.Field("methods").Index(idx)
+		verr.Add(validators.ValidateStringDefined(methodPath.Field("interface"), method.Interface))
+		verr.Add(validators.ValidateStringDefined(methodPath.Field("name"), method.Name))
+		if method.RequestRate == nil {
+			verr.AddViolationAt(methodPath.Field("requestRate"), validators.MustBeDefined)
+		} else {
+			verr.AddErrorAt(methodPath.Field("requestRate"), validateRate(*method.RequestRate))
+		}
+	}
+	return verr
+}
+
+func validateLocalTCP(tcp LocalTCP) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if tcp.Disabled != nil && *tcp.Disabled {
+		return verr
+	}
+	if tcp.ConnectionRate == nil {
+		verr.AddViolationAt(path.Field("connectionRate"), validators.MustBeDefined)
+	} else {
+		verr.AddErrorAt(path.Field("connectionRate"), validateRate(*tcp.ConnectionRate))
+	}
+	return verr
+}
+
+func validateRate(rate Rate) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	verr.Add(validators.ValidateIntegerGreaterThan(path.Field("num"), rate.Num, 0))
+	if rate.Interval.Duration < minInterval {
+		verr.AddViolationAt(path.Field("interval"), "must be greater than or equal to "+minInterval.String())
+	}
+	return verr
+}
+
+func validateHeaders(headers []HeaderKeyValue) validators.ValidationError {
+	var verr validators.ValidationError
+	for idx, header := range headers {
+		verr.Add(validators.ValidateStringDefined(validators.Root().Index(idx).Field("name"), header.Name))
+	}
+	return verr
+}
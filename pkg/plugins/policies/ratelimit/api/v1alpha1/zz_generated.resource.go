@@ -0,0 +1,139 @@
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	_ "embed"
+	"fmt"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+//go:embed schema.yaml
+var rawSchema []byte
+
+func init() {
+	var schema spec.Schema
+	if err := yaml.Unmarshal(rawSchema, &schema); err != nil {
+		panic(err)
+	}
+	rawSchema = nil
+	RateLimitResourceTypeDescriptor.Schema = &schema
+}
+
+const (
+	RateLimitType model.ResourceType = "RateLimit"
+)
+
+var _ model.Resource = &RateLimitResource{}
+
+type RateLimitResource struct {
+	Meta model.ResourceMeta
+	Spec *RateLimit
+}
+
+func NewRateLimitResource() *RateLimitResource {
+	return &RateLimitResource{
+		Spec: &RateLimit{},
+	}
+}
+
+func (t *RateLimitResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *RateLimitResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *RateLimitResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *RateLimitResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*RateLimit)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &RateLimit{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *RateLimitResource) Descriptor() model.ResourceTypeDescriptor {
+	return RateLimitResourceTypeDescriptor
+}
+
+func (t *RateLimitResource) Validate() error {
+	if v, ok := interface{}(t).(interface{ validate() error }); !ok {
+		return nil
+	} else {
+		return v.validate()
+	}
+}
+
+var _ model.ResourceList = &RateLimitResourceList{}
+
+type RateLimitResourceList struct {
+	Items      []*RateLimitResource
+	Pagination model.Pagination
+}
+
+func (l *RateLimitResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *RateLimitResourceList) GetItemType() model.ResourceType {
+	return RateLimitType
+}
+
+func (l *RateLimitResourceList) NewItem() model.Resource {
+	return NewRateLimitResource()
+}
+
+func (l *RateLimitResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*RateLimitResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*RateLimitResource)(nil), r)
+	}
+}
+
+func (l *RateLimitResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *RateLimitResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var RateLimitResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                RateLimitType,
+	Resource:            NewRateLimitResource(),
+	ResourceList:        &RateLimitResourceList{},
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag | model.ZoneToGlobalFlag,
+	WsPath:              "ratelimits",
+	DubboctlArg:         "ratelimit",
+	DubboctlListArg:     "ratelimits",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	IsExperimental:      false,
+	SingularDisplayName: "Rate Limit",
+	PluralDisplayName:   "Rate Limits",
+	IsPluginOriginated:  true,
+}
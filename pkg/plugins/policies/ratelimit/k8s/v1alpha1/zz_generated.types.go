@@ -0,0 +1,104 @@
+// Generated by tools/policy-gen
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	policy "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/ratelimit/api/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/metadata"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=dubbo,scope=Namespaced
+type RateLimit struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the Dubbo RateLimit resource.
+	// +kubebuilder:validation:Optional
+	Spec *policy.RateLimit `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type RateLimitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RateLimit `json:"items"`
+}
+
+func (cb *RateLimit) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *RateLimit) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *RateLimit) GetMesh() string {
+	if mesh, ok := cb.ObjectMeta.Labels[metadata.DubboMeshLabel]; ok {
+		return mesh
+	} else {
+		return core_model.DefaultMesh
+	}
+}
+
+func (cb *RateLimit) SetMesh(mesh string) {
+	if cb.ObjectMeta.Labels == nil {
+		cb.ObjectMeta.Labels = map[string]string{}
+	}
+	cb.ObjectMeta.Labels[metadata.DubboMeshLabel] = mesh
+}
+
+func (cb *RateLimit) GetSpec() (core_model.ResourceSpec, error) {
+	return cb.Spec, nil
+}
+
+func (cb *RateLimit) SetSpec(spec core_model.ResourceSpec) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*policy.RateLimit); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = spec.(*policy.RateLimit)
+}
+
+func (cb *RateLimit) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *RateLimitList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	SchemeBuilder.Register(&RateLimit{}, &RateLimitList{})
+	registry.RegisterObjectType(&policy.RateLimit{}, &RateLimit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "RateLimit",
+		},
+	})
+	registry.RegisterListType(&policy.RateLimit{}, &RateLimitList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "RateLimitList",
+		},
+	})
+}
@@ -0,0 +1,168 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1_test
+
+import (
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/ratelimit/api/v1alpha1"
+	plugin "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/ratelimit/plugin/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	"github.com/apache/dubbo-kubernetes/pkg/test/resources/builders"
+	test_xds "github.com/apache/dubbo-kubernetes/pkg/test/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+)
+
+var _ = Describe("RateLimit", func() {
+	type testCase struct {
+		protocol   core_mesh.Protocol
+		policies   []*api.RateLimitResource
+		goldenFile string
+	}
+
+	rate := func(num uint32, interval time.Duration) *api.Rate {
+		return &api.Rate{Num: num, Interval: k8s.Duration{Duration: interval}}
+	}
+
+	dataplaneOf := func(protocol core_mesh.Protocol) *core_mesh.DataplaneResource {
+		return builders.Dataplane().
+			WithName("web-01").
+			WithAddress("192.168.0.2").
+			WithInboundOfTags(mesh_proto.ServiceTag, "web", mesh_proto.ProtocolTag, string(protocol)).
+			AddOutboundToService("backend").
+			Build()
+	}
+
+	DescribeTable("should apply the rate limits to the resources of the proxy",
+		func(given testCase) {
+			// given
+			dataplane := dataplaneOf(given.protocol)
+			xdsCtx := test_xds.Context(given.protocol, "backend")
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.RateLimitResourceList{Items: given.policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(test_xds.ResourcesYAML(rs)).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+		},
+		Entry("inbound HTTP", testCase{
+			protocol: core_mesh.ProtocolHTTP,
+			policies: []*api.RateLimitResource{
+				test_xds.Policy(api.NewRateLimitResource, "web-rate-limit", &api.RateLimit{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					From: []api.From{
+						{
+							TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+							Default: api.Conf{
+								Local: &api.Local{
+									HTTP: &api.LocalHTTP{
+										RequestRate: rate(100, time.Second),
+										OnRateLimit: &api.OnRateLimit{
+											Status: pointer.To[uint32](503),
+											Headers: &api.HeaderModifier{
+												Set: []api.HeaderKeyValue{{Name: "x-rate-limited", Value: "true"}},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "frontend"},
+							Default: api.Conf{
+								Local: &api.Local{
+									HTTP: &api.LocalHTTP{
+										RequestRate: rate(10, time.Second),
+										Methods: []api.Method{{
+											Interface:   "org.apache.dubbo.GreetService",
+											Name:        "greet",
+											RequestRate: rate(1, time.Second),
+										}},
+									},
+								},
+							},
+						},
+					},
+				}),
+			},
+			goldenFile: "ratelimit.inbound-http.golden.yaml",
+		}),
+		Entry("inbound TCP", testCase{
+			protocol: core_mesh.ProtocolTCP,
+			policies: []*api.RateLimitResource{
+				test_xds.Policy(api.NewRateLimitResource, "mesh-rate-limit", &api.RateLimit{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					From: []api.From{{
+						TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+						Default: api.Conf{
+							Local: &api.Local{
+								TCP: &api.LocalTCP{ConnectionRate: rate(50, time.Second)},
+							},
+						},
+					}},
+				}),
+			},
+			goldenFile: "ratelimit.inbound-tcp.golden.yaml",
+		}),
+	)
+
+	DescribeTable("should leave the resources of the proxy untouched",
+		func(policies []*api.RateLimitResource) {
+			// given
+			dataplane := dataplaneOf(core_mesh.ProtocolHTTP)
+			xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+			untouched, err := test_xds.ResourceSet(xdsCtx, dataplane)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.RateLimitResourceList{Items: policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).To(test_xds.MatchResources(untouched))
+		},
+		Entry("targetRef mismatch", []*api.RateLimitResource{
+			test_xds.Policy(api.NewRateLimitResource, "other", &api.RateLimit{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "other"},
+				From: []api.From{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						Local: &api.Local{
+							HTTP: &api.LocalHTTP{RequestRate: rate(1, time.Second)},
+						},
+					},
+				}},
+			}),
+		}),
+	)
+})
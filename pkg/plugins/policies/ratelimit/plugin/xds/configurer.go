@@ -0,0 +1,252 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	"fmt"
+)
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_http_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_network_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+import (
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	policies_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/xds"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/ratelimit/api/v1alpha1"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	util_xds "github.com/apache/dubbo-kubernetes/pkg/util/xds"
+	listeners_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners/v3"
+	routes_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/routes/v3"
+)
+
+const (
+	httpFilterName    = "envoy.filters.http.local_ratelimit"
+	networkFilterName = "envoy.filters.network.local_ratelimit"
+	tcpProxyName      = "envoy.filters.network.tcp_proxy"
+)
+
+// Configurer applies the local rate limits of the from rules to an inbound listener.
+// HTTP and Triple requests are limited per route: the routes of the listener are
+// cloned for every group of clients and method which has its own limit, and the
+// clients are recognized by the tags header set by their proxies. TCP connections
+// are limited by a network filter in front of the TCP proxy.
+type Configurer struct {
+	Rules core_rules.Rules
+}
+
+func (c *Configurer) ConfigureListener(listener *envoy_listener.Listener) error {
+	if listener == nil {
+		return nil
+	}
+	for _, filterChain := range listener.FilterChains {
+		if err := listeners_v3.UpdateHTTPConnectionManager(filterChain, c.configureHcm); err != nil {
+			return err
+		}
+		if err := c.configureTcp(filterChain, listener.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Configurer) configureHcm(hcm *envoy_hcm.HttpConnectionManager) error {
+	limited := false
+	for _, virtualHost := range hcm.GetRouteConfig().GetVirtualHosts() {
+		var routes []*envoy_route.Route
+		for _, rule := range c.Rules {
+			http := httpConf(rule.Conf.(api.Conf))
+			if http == nil {
+				continue
+			}
+			headers := policies_xds.SubsetHeaderMatchers(rule.Subset)
+			for _, route := range virtualHost.GetRoutes() {
+				if route.GetRoute() == nil {
+					continue
+				}
+				for _, method := range http.Methods {
+					limitedRoute, err := limitRoute(route, headers, *method.RequestRate, http.OnRateLimit)
+					if err != nil {
+						return err
+					}
+					limitedRoute.Match.PathSpecifier = &envoy_route.RouteMatch_Path{
+						Path: MethodPath(method),
+					}
+					routes = append(routes, limitedRoute)
+				}
+				if http.RequestRate != nil {
+					limitedRoute, err := limitRoute(route, headers, *http.RequestRate, http.OnRateLimit)
+					if err != nil {
+						return err
+					}
+					routes = append(routes, limitedRoute)
+				}
+			}
+		}
+		if len(routes) == 0 {
+			continue
+		}
+		virtualHost.Routes = append(routes, virtualHost.Routes...)
+		limited = true
+	}
+	if !limited {
+		return nil
+	}
+	// the filter only provides the per route configuration with the token buckets,
+	// on its own it doesn't limit anything
+	config, err := util_proto.MarshalAnyDeterministic(&envoy_http_ratelimit.LocalRateLimit{
+		StatPrefix: "rate_limit",
+	})
+	if err != nil {
+		return err
+	}
+	return policies_xds.InsertHTTPFiltersBeforeRouter(hcm, &envoy_hcm.HttpFilter{
+		Name: httpFilterName,
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: config,
+		},
+	})
+}
+
+func (c *Configurer) configureTcp(filterChain *envoy_listener.FilterChain, statsName string) error {
+	idx := -1
+	for i, filter := range filterChain.Filters {
+		if filter.Name == tcpProxyName {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return nil
+	}
+	tcp := c.tcpConf()
+	if tcp == nil {
+		return nil
+	}
+	rate := tcp.ConnectionRate
+	config, err := util_proto.MarshalAnyDeterministic(&envoy_network_ratelimit.LocalRateLimit{
+		StatPrefix: util_xds.SanitizeMetric(statsName) + ".rate_limit",
+		TokenBucket: &envoy_type.TokenBucket{
+			MaxTokens:     rate.Num,
+			TokensPerFill: util_proto.UInt32(rate.Num),
+			FillInterval:  util_proto.Duration(rate.Interval.Duration),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	filter := &envoy_listener.Filter{
+		Name: networkFilterName,
+		ConfigType: &envoy_listener.Filter_TypedConfig{
+			TypedConfig: config,
+		},
+	}
+	filterChain.Filters = append(filterChain.Filters[:idx:idx], append([]*envoy_listener.Filter{filter}, filterChain.Filters[idx:]...)...)
+	return nil
+}
+
+// tcpConf returns the TCP configuration of the rules. TCP limits are only accepted
+// from the policies targeting all the clients, so they are the same in every rule.
+func (c *Configurer) tcpConf() *api.LocalTCP {
+	for _, rule := range c.Rules {
+		local := rule.Conf.(api.Conf).Local
+		if local == nil || local.TCP == nil {
+			continue
+		}
+		if local.TCP.ConnectionRate == nil || isDisabled(local.TCP.Disabled) {
+			return nil
+		}
+		return local.TCP
+	}
+	return nil
+}
+
+func httpConf(conf api.Conf) *api.LocalHTTP {
+	if conf.Local == nil || conf.Local.HTTP == nil || isDisabled(conf.Local.HTTP.Disabled) {
+		return nil
+	}
+	return conf.Local.HTTP
+}
+
+func isDisabled(disabled *bool) bool {
+	return disabled != nil && *disabled
+}
+
+// limitRoute clones the route, narrows it down to the requests carrying the given
+// headers and attaches the token bucket of the rate to it.
+func limitRoute(
+	route *envoy_route.Route,
+	headers []*envoy_route.HeaderMatcher,
+	rate api.Rate,
+	onRateLimit *api.OnRateLimit,
+) (*envoy_route.Route, error) {
+	config, err := routes_v3.NewRateLimitConfiguration(rateLimitConfiguration(rate, onRateLimit))
+	if err != nil {
+		return nil, err
+	}
+	limited := proto.Clone(route).(*envoy_route.Route)
+	limited.Match.Headers = append(limited.Match.Headers, headers...)
+	if limited.TypedPerFilterConfig == nil {
+		limited.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	limited.TypedPerFilterConfig[httpFilterName] = config
+	return limited, nil
+}
+
+func rateLimitConfiguration(rate api.Rate, onRateLimit *api.OnRateLimit) *routes_v3.RateLimitConfiguration {
+	configuration := &routes_v3.RateLimitConfiguration{
+		Interval: rate.Interval.Duration,
+		Requests: rate.Num,
+	}
+	if onRateLimit == nil {
+		return configuration
+	}
+	configuration.OnRateLimit = &routes_v3.OnRateLimit{}
+	if onRateLimit.Status != nil {
+		configuration.OnRateLimit.Status = *onRateLimit.Status
+	}
+	if headers := onRateLimit.Headers; headers != nil {
+		for _, header := range headers.Add {
+			configuration.OnRateLimit.Headers = append(configuration.OnRateLimit.Headers, &routes_v3.Headers{
+				Key:    header.Name,
+				Value:  header.Value,
+				Append: true,
+			})
+		}
+		for _, header := range headers.Set {
+			configuration.OnRateLimit.Headers = append(configuration.OnRateLimit.Headers, &routes_v3.Headers{
+				Key:   header.Name,
+				Value: header.Value,
+			})
+		}
+	}
+	return configuration
+}
+
+// MethodPath returns the path of the requests of the Dubbo/Triple method,
+// which follows the gRPC convention of "/{interface}/{method}".
+func MethodPath(method api.Method) string {
+	return fmt.Sprintf("/%s/%s", method.Interface, method.Name)
+}
@@ -0,0 +1,16 @@
+package ratelimit
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core"
+	api_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/ratelimit/api/v1alpha1"
+	k8s_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/ratelimit/k8s/v1alpha1"
+	plugin_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/ratelimit/plugin/v1alpha1"
+)
+
+func init() {
+	core.Register(
+		api_v1alpha1.RateLimitResourceTypeDescriptor,
+		k8s_v1alpha1.AddToScheme,
+		plugin_v1alpha1.NewPlugin(),
+	)
+}
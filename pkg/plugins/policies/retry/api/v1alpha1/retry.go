@@ -0,0 +1,146 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// +kubebuilder:object:generate=true
+package v1alpha1
+
+import (
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+)
+
+// Retry
+// +dubbo:policy:singular_display_name=Retry
+type Retry struct {
+	// TargetRef is a reference to the resource the policy takes an effect on.
+	// The resource could be either a real store object or virtual resource
+	// defined inplace.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// To list makes a match between the consumed services and corresponding configurations
+	To []To `json:"to,omitempty"`
+}
+
+type To struct {
+	// TargetRef is a reference to the resource that represents a group of
+	// destinations.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// Default is a configuration specific to the group of destinations referenced in
+	// 'targetRef'
+	Default Conf `json:"default,omitempty"`
+}
+
+type Conf struct {
+	// TCP defines a configuration of retries for TCP traffic
+	TCP *TCP `json:"tcp,omitempty"`
+	// HTTP defines a configuration of retries for HTTP traffic
+	HTTP *HTTP `json:"http,omitempty"`
+	// GRPC defines a configuration of retries for gRPC and Triple traffic
+	GRPC *GRPC `json:"grpc,omitempty"`
+	// NonIdempotentMethods is a list of Dubbo/Triple methods which are not safe to be
+	// retried. Requests to these methods are only retried when the request has not
+	// reached the provider, i.e. on connection failures and refused streams.
+	NonIdempotentMethods []Method `json:"nonIdempotentMethods,omitempty"`
+}
+
+type TCP struct {
+	// MaxConnectAttempt is a maximal amount of TCP connection attempts
+	// the proxy will make before giving up
+	MaxConnectAttempt *uint32 `json:"maxConnectAttempt,omitempty"`
+}
+
+type HTTP struct {
+	// NumRetries is the number of attempts that will be made on failed (and
+	// retriable) requests. If not set, the default value is 1.
+	NumRetries *uint32 `json:"numRetries,omitempty"`
+	// PerTryTimeout is the amount of time after which retry attempt should time out.
+	// If left unspecified, the global route timeout of the request will apply.
+	PerTryTimeout *k8s.Duration `json:"perTryTimeout,omitempty"`
+	// BackOff is a configuration of durations which will be used in exponential
+	// backoff strategy between retries.
+	BackOff *BackOff `json:"backOff,omitempty"`
+	// RetryOn is a list of conditions which will cause a retry. If not set,
+	// the request is retried on 5XX, GatewayError, Reset, Retriable4xx,
+	// ConnectFailure, EnvoyRatelimited and RefusedStream.
+	RetryOn *[]HTTPRetryOn `json:"retryOn,omitempty"`
+	// RetriableStatusCodes is a list of HTTP response status codes which will
+	// cause a retry in addition to the conditions of RetryOn.
+	RetriableStatusCodes []uint32 `json:"retriableStatusCodes,omitempty"`
+}
+
+type GRPC struct {
+	// NumRetries is the number of attempts that will be made on failed (and
+	// retriable) requests. If not set, the default value is 1.
+	NumRetries *uint32 `json:"numRetries,omitempty"`
+	// PerTryTimeout is the amount of time after which retry attempt should time out.
+	// If left unspecified, the global route timeout of the request will apply.
+	PerTryTimeout *k8s.Duration `json:"perTryTimeout,omitempty"`
+	// BackOff is a configuration of durations which will be used in exponential
+	// backoff strategy between retries.
+	BackOff *BackOff `json:"backOff,omitempty"`
+	// RetryOn is a list of gRPC status codes which will cause a retry. If not set,
+	// the request is retried on Canceled, DeadlineExceeded, Internal,
+	// ResourceExhausted and Unavailable.
+	RetryOn *[]GRPCRetryOn `json:"retryOn,omitempty"`
+}
+
+type BackOff struct {
+	// BaseInterval is an amount of time which should be taken between retries.
+	// Must be greater than zero. Values less than 1 ms are rounded up to 1 ms.
+	// Default is 25ms.
+	BaseInterval *k8s.Duration `json:"baseInterval,omitempty"`
+	// MaxInterval is a maximal amount of time which will be taken between retries.
+	// Default is 10 times the "BaseInterval".
+	MaxInterval *k8s.Duration `json:"maxInterval,omitempty"`
+}
+
+type Method struct {
+	// Interface is the fully qualified name of the Dubbo interface, e.g. org.apache.dubbo.demo.GreetService
+	Interface string `json:"interface"`
+	// Name of the method of the interface
+	Name string `json:"name"`
+}
+
+// +kubebuilder:validation:Enum=5XX;GatewayError;Reset;Retriable4xx;ConnectFailure;EnvoyRatelimited;RefusedStream
+type HTTPRetryOn string
+
+var (
+	All5xx           HTTPRetryOn = "5XX"
+	GatewayError     HTTPRetryOn = "GatewayError"
+	Reset            HTTPRetryOn = "Reset"
+	Retriable4xx     HTTPRetryOn = "Retriable4xx"
+	ConnectFailure   HTTPRetryOn = "ConnectFailure"
+	EnvoyRatelimited HTTPRetryOn = "EnvoyRatelimited"
+	RefusedStream    HTTPRetryOn = "RefusedStream"
+)
+
+var AllHTTPRetryOn = []HTTPRetryOn{All5xx, GatewayError, Reset, Retriable4xx, ConnectFailure, EnvoyRatelimited, RefusedStream}
+
+// +kubebuilder:validation:Enum=Canceled;DeadlineExceeded;Internal;ResourceExhausted;Unavailable
+type GRPCRetryOn string
+
+var (
+	Canceled          GRPCRetryOn = "Canceled"
+	DeadlineExceeded  GRPCRetryOn = "DeadlineExceeded"
+	Internal          GRPCRetryOn = "Internal"
+	ResourceExhausted GRPCRetryOn = "ResourceExhausted"
+	Unavailable       GRPCRetryOn = "Unavailable"
+)
+
+var AllGRPCRetryOn = []GRPCRetryOn{Canceled, DeadlineExceeded, Internal, ResourceExhausted, Unavailable}
@@ -0,0 +1,155 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	matcher_validators "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers/validators"
+)
+
+func (r *RetryResource) validate() error {
+	var verr validators.ValidationError
+	path := validators.RootedAt("spec")
+	verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(r.Spec.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+		SupportedKinds: []common_api.TargetRefKind{
+			common_api.Mesh,
+			common_api.MeshSubset,
+			common_api.MeshService,
+			common_api.MeshServiceSubset,
+		},
+	}))
+	if len(r.Spec.To) == 0 {
+		verr.AddViolationAt(path.Field("to"), validators.MustNotBeEmpty)
+	}
+	verr.AddErrorAt(path, validateTo(r.Spec.To))
+	return verr.OrNil()
+}
+
+func validateTo(to []To) validators.ValidationError {
+	var verr validators.ValidationError
+	for idx, toItem := range to {
+		path := validators.RootedAt("to").Index(idx)
+		verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(toItem.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+			SupportedKinds: []common_api.TargetRefKind{
+				common_api.Mesh,
+				common_api.MeshService,
+			},
+		}))
+		verr.AddErrorAt(path.Field("default"), validateDefault(toItem.Default))
+	}
+	return verr
+}
+
+func validateDefault(conf Conf) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if conf.TCP == nil && conf.HTTP == nil && conf.GRPC == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("tcp", "http", "grpc"))
+	}
+	if conf.TCP != nil {
+		verr.AddErrorAt(path.Field("tcp"), validateTCP(*conf.TCP))
+	}
+	if conf.HTTP != nil {
+		verr.AddErrorAt(path.Field("http"), validateHTTP(*conf.HTTP))
+	}
+	if conf.GRPC != nil {
+		verr.AddErrorAt(path.Field("grpc"), validateGRPC(*conf.GRPC))
+	}
+	for idx, method := range conf.NonIdempotentMethods {
+		methodPath := path.Field("nonIdempotentMethods").Index(idx)
+		verr.Add(validators.ValidateStringDefined(methodPath.Field("interface"), method.Interface))
+		verr.Add(validators.ValidateStringDefined(methodPath.Field("name"), method.Name))
+	}
+	return verr
+}
+
+func validateTCP(tcp TCP) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if tcp.MaxConnectAttempt == nil {
+		verr.AddViolationAt(path.Field("maxConnectAttempt"), validators.MustBeDefined)
+	}
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("maxConnectAttempt"), tcp.MaxConnectAttempt))
+	return verr
+}
+
+func validateHTTP(http HTTP) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if http.NumRetries == nil && http.PerTryTimeout == nil && http.BackOff == nil && http.RetryOn == nil && len(http.RetriableStatusCodes) == 0 {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("numRetries", "perTryTimeout", "backOff", "retryOn", "retriableStatusCodes"))
+	}
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("numRetries"), http.NumRetries))
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("perTryTimeout"), http.PerTryTimeout))
+	verr.AddErrorAt(path.Field("backOff"), validateBackOff(http.BackOff))
+	if http.RetryOn != nil {
+		for idx, retryOn := range *http.RetryOn {
+			if !slices.Contains(AllHTTPRetryOn, retryOn) {
+				verr.AddViolationAt(path.Field("retryOn").Index(idx), fmt.Sprintf("unknown retry condition %q", retryOn))
+			}
+		}
+	}
+	for idx, code := range http.RetriableStatusCodes {
+		if code < 100 || code >= 600 {
+			verr.AddViolationAt(path.Field("retriableStatusCodes").Index(idx), "must be in inclusive range [100, 599]")
+		}
+	}
+	return verr
+}
+
+func validateGRPC(grpc GRPC) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if grpc.NumRetries == nil && grpc.PerTryTimeout == nil && grpc.BackOff == nil && grpc.RetryOn == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("numRetries", "perTryTimeout", "backOff", "retryOn"))
+	}
+	verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("numRetries"), grpc.NumRetries))
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("perTryTimeout"), grpc.PerTryTimeout))
+	verr.AddErrorAt(path.Field("backOff"), validateBackOff(grpc.BackOff))
+	if grpc.RetryOn != nil {
+		for idx, retryOn := range *grpc.RetryOn {
+			if !slices.Contains(AllGRPCRetryOn, retryOn) {
+				verr.AddViolationAt(path.Field("retryOn").Index(idx), fmt.Sprintf("unknown retry condition %q", retryOn))
+			}
+		}
+	}
+	return verr
+}
+
+func validateBackOff(backOff *BackOff) validators.ValidationError {
+	var verr validators.ValidationError
+	if backOff == nil {
+		return verr
+	}
+	path := validators.Root()
+	if backOff.BaseInterval == nil && backOff.MaxInterval == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("baseInterval", "maxInterval"))
+	}
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("baseInterval"), backOff.BaseInterval))
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("maxInterval"), backOff.MaxInterval))
+	if backOff.BaseInterval != nil && backOff.MaxInterval != nil && backOff.MaxInterval.Duration < backOff.BaseInterval.Duration {
+		verr.AddViolationAt(path.Field("maxInterval"), "must be greater than or equal to baseInterval")
+	}
+	return verr
+}
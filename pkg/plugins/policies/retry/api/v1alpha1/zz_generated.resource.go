@@ -0,0 +1,139 @@
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	_ "embed"
+	"fmt"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+//go:embed schema.yaml
+var rawSchema []byte
+
+func init() {
+	var schema spec.Schema
+	if err := yaml.Unmarshal(rawSchema, &schema); err != nil {
+		panic(err)
+	}
+	rawSchema = nil
+	RetryResourceTypeDescriptor.Schema = &schema
+}
+
+const (
+	RetryType model.ResourceType = "Retry"
+)
+
+var _ model.Resource = &RetryResource{}
+
+type RetryResource struct {
+	Meta model.ResourceMeta
+	Spec *Retry
+}
+
+func NewRetryResource() *RetryResource {
+	return &RetryResource{
+		Spec: &Retry{},
+	}
+}
+
+func (t *RetryResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *RetryResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *RetryResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *RetryResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*Retry)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &Retry{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *RetryResource) Descriptor() model.ResourceTypeDescriptor {
+	return RetryResourceTypeDescriptor
+}
+
+func (t *RetryResource) Validate() error {
+	if v, ok := interface{}(t).(interface{ validate() error }); !ok {
+		return nil
+	} else {
+		return v.validate()
+	}
+}
+
+var _ model.ResourceList = &RetryResourceList{}
+
+type RetryResourceList struct {
+	Items      []*RetryResource
+	Pagination model.Pagination
+}
+
+func (l *RetryResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *RetryResourceList) GetItemType() model.ResourceType {
+	return RetryType
+}
+
+func (l *RetryResourceList) NewItem() model.Resource {
+	return NewRetryResource()
+}
+
+func (l *RetryResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*RetryResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*RetryResource)(nil), r)
+	}
+}
+
+func (l *RetryResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *RetryResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var RetryResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                RetryType,
+	Resource:            NewRetryResource(),
+	ResourceList:        &RetryResourceList{},
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag | model.ZoneToGlobalFlag,
+	WsPath:              "retries",
+	DubboctlArg:         "retry",
+	DubboctlListArg:     "retries",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	IsExperimental:      false,
+	SingularDisplayName: "Retry",
+	PluralDisplayName:   "Retries",
+	IsPluginOriginated:  true,
+}
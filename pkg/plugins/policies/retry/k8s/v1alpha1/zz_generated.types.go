@@ -0,0 +1,104 @@
+// Generated by tools/policy-gen
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	policy "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/retry/api/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/metadata"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=dubbo,scope=Namespaced
+type Retry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the Dubbo Retry resource.
+	// +kubebuilder:validation:Optional
+	Spec *policy.Retry `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type RetryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Retry `json:"items"`
+}
+
+func (cb *Retry) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *Retry) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *Retry) GetMesh() string {
+	if mesh, ok := cb.ObjectMeta.Labels[metadata.DubboMeshLabel]; ok {
+		return mesh
+	} else {
+		return core_model.DefaultMesh
+	}
+}
+
+func (cb *Retry) SetMesh(mesh string) {
+	if cb.ObjectMeta.Labels == nil {
+		cb.ObjectMeta.Labels = map[string]string{}
+	}
+	cb.ObjectMeta.Labels[metadata.DubboMeshLabel] = mesh
+}
+
+func (cb *Retry) GetSpec() (core_model.ResourceSpec, error) {
+	return cb.Spec, nil
+}
+
+func (cb *Retry) SetSpec(spec core_model.ResourceSpec) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*policy.Retry); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = spec.(*policy.Retry)
+}
+
+func (cb *Retry) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *RetryList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	SchemeBuilder.Register(&Retry{}, &RetryList{})
+	registry.RegisterObjectType(&policy.Retry{}, &Retry{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "Retry",
+		},
+	})
+	registry.RegisterListType(&policy.Retry{}, &RetryList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "RetryList",
+		},
+	})
+}
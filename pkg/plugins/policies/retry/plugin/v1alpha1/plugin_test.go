@@ -0,0 +1,154 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1_test
+
+import (
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/retry/api/v1alpha1"
+	plugin "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/retry/plugin/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	"github.com/apache/dubbo-kubernetes/pkg/test/resources/samples"
+	test_xds "github.com/apache/dubbo-kubernetes/pkg/test/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+)
+
+var _ = Describe("Retry", func() {
+	type testCase struct {
+		protocol   core_mesh.Protocol
+		policies   []*api.RetryResource
+		goldenFile string
+	}
+
+	DescribeTable("should apply the retries to the resources of the proxy",
+		func(given testCase) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(given.protocol, "backend")
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.RetryResourceList{Items: given.policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(test_xds.ResourcesYAML(rs)).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+		},
+		Entry("outbound HTTP", testCase{
+			protocol: core_mesh.ProtocolHTTP,
+			policies: []*api.RetryResource{
+				test_xds.Policy(api.NewRetryResource, "web-to-backend", &api.Retry{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"},
+						Default: api.Conf{
+							HTTP: &api.HTTP{
+								NumRetries:           pointer.To[uint32](3),
+								PerTryTimeout:        &k8s.Duration{Duration: time.Second},
+								RetryOn:              &[]api.HTTPRetryOn{api.All5xx, api.Reset},
+								RetriableStatusCodes: []uint32{409},
+								BackOff: &api.BackOff{
+									BaseInterval: &k8s.Duration{Duration: 10 * time.Millisecond},
+									MaxInterval:  &k8s.Duration{Duration: 100 * time.Millisecond},
+								},
+							},
+						},
+					}},
+				}),
+			},
+			goldenFile: "retry.outbound-http.golden.yaml",
+		}),
+		Entry("outbound Triple with non-idempotent methods", testCase{
+			protocol: core_mesh.ProtocolTriple,
+			policies: []*api.RetryResource{
+				test_xds.Policy(api.NewRetryResource, "mesh-retry", &api.Retry{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+						Default: api.Conf{
+							GRPC: &api.GRPC{
+								NumRetries: pointer.To[uint32](2),
+								RetryOn:    &[]api.GRPCRetryOn{api.Unavailable, api.Internal},
+							},
+							NonIdempotentMethods: []api.Method{
+								{Interface: "org.apache.dubbo.OrderService", Name: "create"},
+								{Interface: "org.apache.dubbo.OrderService", Name: "pay"},
+							},
+						},
+					}},
+				}),
+			},
+			goldenFile: "retry.outbound-triple.golden.yaml",
+		}),
+		Entry("outbound TCP", testCase{
+			protocol: core_mesh.ProtocolTCP,
+			policies: []*api.RetryResource{
+				test_xds.Policy(api.NewRetryResource, "mesh-retry", &api.Retry{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+						Default: api.Conf{
+							TCP: &api.TCP{MaxConnectAttempt: pointer.To[uint32](5)},
+						},
+					}},
+				}),
+			},
+			goldenFile: "retry.outbound-tcp.golden.yaml",
+		}),
+	)
+
+	DescribeTable("should leave the resources of the proxy untouched",
+		func(policies []*api.RetryResource) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+			untouched, err := test_xds.ResourceSet(xdsCtx, dataplane)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.RetryResourceList{Items: policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).To(test_xds.MatchResources(untouched))
+		},
+		Entry("targetRef mismatch", []*api.RetryResource{
+			test_xds.Policy(api.NewRetryResource, "other", &api.Retry{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "other"},
+				To: []api.To{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						HTTP: &api.HTTP{NumRetries: pointer.To[uint32](3)},
+					},
+				}},
+			}),
+		}),
+	)
+})
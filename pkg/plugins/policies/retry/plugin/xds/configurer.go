@@ -0,0 +1,279 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	"fmt"
+	"strings"
+)
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/retry/api/v1alpha1"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	listeners_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners/v3"
+)
+
+// safeRetryOn are the conditions on which the request has not reached the
+// provider yet, so that even non-idempotent methods can be retried.
+const safeRetryOn = "connect-failure,refused-stream"
+
+var httpRetryOnEnvoyValues = map[api.HTTPRetryOn]string{
+	api.All5xx:           "5xx",
+	api.GatewayError:     "gateway-error",
+	api.Reset:            "reset",
+	api.Retriable4xx:     "retriable-4xx",
+	api.ConnectFailure:   "connect-failure",
+	api.EnvoyRatelimited: "envoy-ratelimited",
+	api.RefusedStream:    "refused-stream",
+}
+
+var grpcRetryOnEnvoyValues = map[api.GRPCRetryOn]string{
+	api.Canceled:          "cancelled",
+	api.DeadlineExceeded:  "deadline-exceeded",
+	api.Internal:          "internal",
+	api.ResourceExhausted: "resource-exhausted",
+	api.Unavailable:       "unavailable",
+}
+
+// Configurer applies the retry configuration to the outbound listener of a service.
+// HTTP, gRPC and Triple traffic gets a retry policy on every route, TCP traffic gets
+// the maximal number of connection attempts.
+//
+// The requests to the non-idempotent methods are matched by their path, which follows
+// the gRPC convention of "/{interface}/{method}". Every route covering the path of such
+// a method is preceded by a copy of the route matching the exact path of the method,
+// which is only retried when the request has not reached the provider.
+type Configurer struct {
+	Conf     api.Conf
+	Protocol core_mesh.Protocol
+}
+
+func (c *Configurer) ConfigureListener(listener *envoy_listener.Listener) error {
+	if listener == nil {
+		return nil
+	}
+	for _, filterChain := range listener.FilterChains {
+		switch c.Protocol {
+		case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2, core_mesh.ProtocolGRPC, core_mesh.ProtocolTriple:
+			if err := listeners_v3.UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+				for _, virtualHost := range hcm.GetRouteConfig().GetVirtualHosts() {
+					c.configureVirtualHost(virtualHost)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			if err := listeners_v3.UpdateTCPProxy(filterChain, func(proxy *envoy_tcp.TcpProxy) error {
+				if c.Conf.TCP != nil && c.Conf.TCP.MaxConnectAttempt != nil {
+					proxy.MaxConnectAttempts = util_proto.UInt32(*c.Conf.TCP.MaxConnectAttempt)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Configurer) configureVirtualHost(virtualHost *envoy_route.VirtualHost) {
+	policy := c.retryPolicy()
+	if policy == nil {
+		return
+	}
+	var routes []*envoy_route.Route
+	var matches []*envoy_route.RouteMatch
+	for _, route := range virtualHost.GetRoutes() {
+		action := route.GetRoute()
+		if action == nil {
+			routes = append(routes, route)
+			continue
+		}
+		for _, path := range c.nonIdempotentPaths() {
+			if !coversPath(route.GetMatch(), path) {
+				continue
+			}
+			methodRoute := proto.Clone(route).(*envoy_route.Route)
+			methodRoute.Name = ""
+			methodRoute.Match.PathSpecifier = &envoy_route.RouteMatch_Path{Path: path}
+			if containsMatch(matches, methodRoute.GetMatch()) {
+				// the method already has a route of its own, e.g. the per-method routes of Triple
+				continue
+			}
+			methodRoute.GetRoute().RetryPolicy = safeRetryPolicy(policy)
+			routes = append(routes, methodRoute)
+			matches = append(matches, methodRoute.GetMatch())
+		}
+		if c.isNonIdempotent(route.GetMatch().GetPath()) {
+			action.RetryPolicy = safeRetryPolicy(policy)
+		} else {
+			action.RetryPolicy = proto.Clone(policy).(*envoy_route.RetryPolicy)
+		}
+		routes = append(routes, route)
+		matches = append(matches, route.GetMatch())
+	}
+	virtualHost.Routes = routes
+}
+
+func (c *Configurer) retryPolicy() *envoy_route.RetryPolicy {
+	switch c.Protocol {
+	case core_mesh.ProtocolGRPC, core_mesh.ProtocolTriple:
+		return grpcRetryPolicy(c.Conf.GRPC)
+	default:
+		return httpRetryPolicy(c.Conf.HTTP)
+	}
+}
+
+func (c *Configurer) nonIdempotentPaths() []string {
+	var paths []string
+	for _, method := range c.Conf.NonIdempotentMethods {
+		paths = append(paths, fmt.Sprintf("/%s/%s", method.Interface, method.Name))
+	}
+	return paths
+}
+
+// isNonIdempotent checks if the route matches exactly the path of one of the non-idempotent methods.
+func (c *Configurer) isNonIdempotent(path string) bool {
+	if path == "" {
+		return false
+	}
+	for _, methodPath := range c.nonIdempotentPaths() {
+		if path == methodPath {
+			return true
+		}
+	}
+	return false
+}
+
+// coversPath checks if the prefix of the route matches the path.
+func coversPath(match *envoy_route.RouteMatch, path string) bool {
+	switch specifier := match.GetPathSpecifier().(type) {
+	case *envoy_route.RouteMatch_Prefix:
+		return strings.HasPrefix(path, specifier.Prefix)
+	default:
+		return false
+	}
+}
+
+func containsMatch(matches []*envoy_route.RouteMatch, match *envoy_route.RouteMatch) bool {
+	for _, m := range matches {
+		if proto.Equal(m, match) {
+			return true
+		}
+	}
+	return false
+}
+
+// safeRetryPolicy returns the retry policy that retries the requests only when they
+// have not reached the provider yet.
+func safeRetryPolicy(policy *envoy_route.RetryPolicy) *envoy_route.RetryPolicy {
+	safe := proto.Clone(policy).(*envoy_route.RetryPolicy)
+	safe.RetryOn = safeRetryOn
+	safe.RetriableStatusCodes = nil
+	return safe
+}
+
+func httpRetryPolicy(conf *api.HTTP) *envoy_route.RetryPolicy {
+	if conf == nil {
+		return nil
+	}
+	retryOn := api.AllHTTPRetryOn
+	if conf.RetryOn != nil {
+		retryOn = *conf.RetryOn
+	}
+	var conditions []string
+	for _, condition := range retryOn {
+		conditions = append(conditions, httpRetryOnEnvoyValues[condition])
+	}
+
+	policy := &envoy_route.RetryPolicy{
+		NumRetries:    numRetries(conf.NumRetries),
+		PerTryTimeout: perTryTimeout(conf.PerTryTimeout),
+		RetryBackOff:  retryBackOff(conf.BackOff),
+	}
+	if len(conf.RetriableStatusCodes) > 0 {
+		conditions = append(conditions, "retriable-status-codes")
+		policy.RetriableStatusCodes = conf.RetriableStatusCodes
+	}
+	policy.RetryOn = strings.Join(conditions, ",")
+	return policy
+}
+
+func grpcRetryPolicy(conf *api.GRPC) *envoy_route.RetryPolicy {
+	if conf == nil {
+		return nil
+	}
+	retryOn := api.AllGRPCRetryOn
+	if conf.RetryOn != nil {
+		retryOn = *conf.RetryOn
+	}
+	var conditions []string
+	for _, condition := range retryOn {
+		conditions = append(conditions, grpcRetryOnEnvoyValues[condition])
+	}
+
+	return &envoy_route.RetryPolicy{
+		RetryOn:       strings.Join(conditions, ","),
+		NumRetries:    numRetries(conf.NumRetries),
+		PerTryTimeout: perTryTimeout(conf.PerTryTimeout),
+		RetryBackOff:  retryBackOff(conf.BackOff),
+	}
+}
+
+func numRetries(n *uint32) *wrapperspb.UInt32Value {
+	if n == nil {
+		return nil
+	}
+	return util_proto.UInt32(*n)
+}
+
+func perTryTimeout(timeout *k8s.Duration) *durationpb.Duration {
+	if timeout == nil {
+		return nil
+	}
+	return durationpb.New(timeout.Duration)
+}
+
+func retryBackOff(backOff *api.BackOff) *envoy_route.RetryPolicy_RetryBackOff {
+	if backOff == nil {
+		return nil
+	}
+	result := &envoy_route.RetryPolicy_RetryBackOff{}
+	if backOff.BaseInterval != nil {
+		result.BaseInterval = durationpb.New(backOff.BaseInterval.Duration)
+	}
+	if backOff.MaxInterval != nil {
+		result.MaxInterval = durationpb.New(backOff.MaxInterval.Duration)
+	}
+	return result
+}
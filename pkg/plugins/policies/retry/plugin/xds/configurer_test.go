@@ -0,0 +1,86 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds_test
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/retry/api/v1alpha1"
+	plugin_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/retry/plugin/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	envoy_listeners "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("Configurer", func() {
+	It("should give the non-idempotent methods the safe retry policy without duplicating their routes", func() {
+		// given
+		backend := envoy_common.NewCluster(
+			envoy_common.WithService("backend"),
+			envoy_common.WithName("backend"),
+		)
+		routes := envoy_common.Routes{
+			// the per-method routes of Triple
+			envoy_common.NewRoute(
+				envoy_common.WithMatchExactPath("/org.apache.dubbo.OrderService/create"),
+				envoy_common.WithCluster(backend),
+			),
+			envoy_common.NewRoute(
+				envoy_common.WithMatchExactPath("/org.apache.dubbo.OrderService/get"),
+				envoy_common.WithCluster(backend),
+			),
+			envoy_common.NewRoute(envoy_common.WithCluster(backend)),
+		}
+		listener, err := envoy_listeners.NewOutboundListenerBuilder(core_xds.APIVersion(envoy_common.APIV3), "127.0.0.1", 10001, core_xds.SocketAddressProtocolTCP).
+			Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(core_xds.APIVersion(envoy_common.APIV3), envoy_common.AnonymousResource).
+				Configure(envoy_listeners.HttpConnectionManager("backend", false)).
+				Configure(envoy_listeners.HttpOutboundRoute("backend", routes, nil)),
+			)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		configurer := plugin_xds.Configurer{
+			Conf: api.Conf{
+				GRPC: &api.GRPC{NumRetries: pointer.To[uint32](2)},
+				NonIdempotentMethods: []api.Method{
+					{Interface: "org.apache.dubbo.OrderService", Name: "create"},
+					{Interface: "org.apache.dubbo.OrderService", Name: "pay"},
+				},
+			},
+			Protocol: core_mesh.ProtocolTriple,
+		}
+
+		// when
+		err = configurer.ConfigureListener(listener.(*envoy_listener.Listener))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := util_proto.ToYAML(listener.(*envoy_listener.Listener))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(matchers.MatchGoldenYAML("testdata", "non-idempotent-methods.golden.yaml"))
+	})
+})
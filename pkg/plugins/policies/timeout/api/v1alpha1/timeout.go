@@ -0,0 +1,106 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// +kubebuilder:object:generate=true
+package v1alpha1
+
+import (
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+)
+
+// Timeout
+// +dubbo:policy:singular_display_name=Timeout
+type Timeout struct {
+	// TargetRef is a reference to the resource the policy takes an effect on.
+	// The resource could be either a real store object or virtual resource
+	// defined inplace.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// To list makes a match between the consumed services and corresponding configurations
+	To []To `json:"to,omitempty"`
+	// From list makes a match between clients and corresponding configurations
+	From []From `json:"from,omitempty"`
+}
+
+type To struct {
+	// TargetRef is a reference to the resource that represents a group of
+	// destinations.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// Default is a configuration specific to the group of destinations referenced in
+	// 'targetRef'
+	Default Conf `json:"default,omitempty"`
+}
+
+type From struct {
+	// TargetRef is a reference to the resource that represents a group of
+	// clients.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// Default is a configuration specific to the group of clients referenced in
+	// 'targetRef'
+	Default Conf `json:"default,omitempty"`
+}
+
+type Conf struct {
+	// ConnectionTimeout specifies the amount of time proxy will wait for an TCP connection to be established.
+	// Default value is 5 seconds. Cannot be set to 0.
+	ConnectionTimeout *k8s.Duration `json:"connectionTimeout,omitempty"`
+	// IdleTimeout is defined as the period in which there are no bytes sent or received on connection
+	// Setting this timeout to 0 will disable it. Be cautious when disabling it because
+	// it can lead to connection leaking. Default value is 1h.
+	IdleTimeout *k8s.Duration `json:"idleTimeout,omitempty"`
+	// Protocol specific configurations, applied to HTTP, gRPC and Triple traffic
+	Http *Http `json:"http,omitempty"`
+}
+
+type Http struct {
+	// RequestTimeout The amount of time that proxy will wait for the entire request to be received.
+	// The timer is activated when the request is initiated, and is disarmed when the last byte of the request is sent,
+	// OR when the response is initiated. Setting this timeout to 0 will disable it.
+	// Default is 15s.
+	RequestTimeout *k8s.Duration `json:"requestTimeout,omitempty"`
+	// StreamIdleTimeout is the amount of time that proxy will allow a stream to exist with no activity.
+	// Setting this timeout to 0 will disable it. Default is 30m
+	StreamIdleTimeout *k8s.Duration `json:"streamIdleTimeout,omitempty"`
+	// MaxStreamDuration is the maximum time that a stream's lifetime will span.
+	// Setting this timeout to 0 will disable it. Disabled by default.
+	MaxStreamDuration *k8s.Duration `json:"maxStreamDuration,omitempty"`
+	// MaxConnectionDuration is the time after which a connection will be drained and/or closed,
+	// starting from when it was first established. Setting this timeout to 0 will disable it.
+	// Disabled by default.
+	MaxConnectionDuration *k8s.Duration `json:"maxConnectionDuration,omitempty"`
+	// RequestHeadersTimeout The amount of time that proxy will wait for the request headers to be received.
+	// The timer is activated when the first byte of the headers is received, and is disarmed when the last byte of
+	// the headers has been received. If not specified or set to 0, this timeout is disabled.
+	// Disabled by default.
+	RequestHeadersTimeout *k8s.Duration `json:"requestHeadersTimeout,omitempty"`
+	// Methods overrides the request timeout for the given methods. Every method gets a route of its own,
+	// which matches the /{interface}/{method} path of its Triple and gRPC requests.
+	Methods []Method `json:"methods,omitempty"`
+}
+
+type Method struct {
+	// Interface is the fully qualified name of the Dubbo interface, e.g. org.apache.dubbo.demo.GreetService
+	Interface string `json:"interface"`
+	// Name of the method of the interface
+	Name string `json:"name"`
+	// RequestTimeout is the request timeout applied to the calls of the method.
+	// Setting this timeout to 0 will disable it.
+	RequestTimeout *k8s.Duration `json:"requestTimeout,omitempty"`
+}
@@ -0,0 +1,139 @@
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	_ "embed"
+	"fmt"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+//go:embed schema.yaml
+var rawSchema []byte
+
+func init() {
+	var schema spec.Schema
+	if err := yaml.Unmarshal(rawSchema, &schema); err != nil {
+		panic(err)
+	}
+	rawSchema = nil
+	TimeoutResourceTypeDescriptor.Schema = &schema
+}
+
+const (
+	TimeoutType model.ResourceType = "Timeout"
+)
+
+var _ model.Resource = &TimeoutResource{}
+
+type TimeoutResource struct {
+	Meta model.ResourceMeta
+	Spec *Timeout
+}
+
+func NewTimeoutResource() *TimeoutResource {
+	return &TimeoutResource{
+		Spec: &Timeout{},
+	}
+}
+
+func (t *TimeoutResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *TimeoutResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *TimeoutResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *TimeoutResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*Timeout)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &Timeout{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *TimeoutResource) Descriptor() model.ResourceTypeDescriptor {
+	return TimeoutResourceTypeDescriptor
+}
+
+func (t *TimeoutResource) Validate() error {
+	if v, ok := interface{}(t).(interface{ validate() error }); !ok {
+		return nil
+	} else {
+		return v.validate()
+	}
+}
+
+var _ model.ResourceList = &TimeoutResourceList{}
+
+type TimeoutResourceList struct {
+	Items      []*TimeoutResource
+	Pagination model.Pagination
+}
+
+func (l *TimeoutResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *TimeoutResourceList) GetItemType() model.ResourceType {
+	return TimeoutType
+}
+
+func (l *TimeoutResourceList) NewItem() model.Resource {
+	return NewTimeoutResource()
+}
+
+func (l *TimeoutResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*TimeoutResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*TimeoutResource)(nil), r)
+	}
+}
+
+func (l *TimeoutResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *TimeoutResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var TimeoutResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                TimeoutType,
+	Resource:            NewTimeoutResource(),
+	ResourceList:        &TimeoutResourceList{},
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag | model.ZoneToGlobalFlag,
+	WsPath:              "timeouts",
+	DubboctlArg:         "timeout",
+	DubboctlListArg:     "timeouts",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	IsExperimental:      false,
+	SingularDisplayName: "Timeout",
+	PluralDisplayName:   "Timeouts",
+	IsPluginOriginated:  true,
+}
@@ -0,0 +1,133 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+)
+
+import (
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers"
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	policies_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/xds"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/api/v1alpha1"
+	plugin_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/plugin/xds"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_names "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/names"
+)
+
+var _ core_plugins.PolicyPlugin = &plugin{}
+
+type plugin struct{}
+
+func NewPlugin() core_plugins.Plugin {
+	return &plugin{}
+}
+
+func (p plugin) MatchedPolicies(dataplane *core_mesh.DataplaneResource, resources xds_context.Resources) (core_xds.TypedMatchingPolicies, error) {
+	return matchers.MatchedPolicies(api.TimeoutType, dataplane, resources)
+}
+
+func (p plugin) Apply(rs *core_xds.ResourceSet, ctx xds_context.Context, proxy *core_xds.Proxy) error {
+	if proxy.Dataplane == nil {
+		return nil
+	}
+	policies, ok := proxy.Policies.Dynamic[api.TimeoutType]
+	if !ok {
+		return nil
+	}
+
+	listeners := policies_xds.GatherListeners(rs)
+	clusters := policies_xds.GatherClusters(rs)
+
+	if err := applyToInbounds(policies.FromRules, listeners, clusters, proxy.Dataplane); err != nil {
+		return err
+	}
+	if err := applyToOutbounds(policies.ToRules, listeners, clusters, proxy.Dataplane); err != nil {
+		return err
+	}
+	return nil
+}
+
+func applyToInbounds(
+	fromRules core_rules.FromRules,
+	listeners policies_xds.Listeners,
+	clusters policies_xds.Clusters,
+	dataplane *core_mesh.DataplaneResource,
+) error {
+	for _, iface := range dataplane.Spec.GetNetworking().GetInboundInterfaces() {
+		key := core_rules.InboundListener{
+			Address: iface.DataplaneIP,
+			Port:    iface.DataplanePort,
+		}
+		rule := fromRules.Rules[key].Compute(core_rules.MeshSubset())
+		if rule == nil {
+			continue
+		}
+		conf := rule.Conf.(api.Conf)
+
+		listenerConfigurer := plugin_xds.ListenerConfigurer{Conf: conf}
+		if err := listenerConfigurer.ConfigureListener(listeners.Inbound[key]); err != nil {
+			return err
+		}
+		clusterConfigurer := plugin_xds.ClusterConfigurer{Conf: conf}
+		if err := clusterConfigurer.Configure(clusters.Inbound[envoy_names.GetLocalClusterName(iface.WorkloadPort)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyToOutbounds(
+	toRules core_rules.ToRules,
+	listeners policies_xds.Listeners,
+	clusters policies_xds.Clusters,
+	dataplane *core_mesh.DataplaneResource,
+) error {
+	networking := dataplane.Spec.GetNetworking()
+	for _, outbound := range networking.GetOutbound() {
+		rule := toRules.Rules.Compute(core_rules.MeshService(outbound.GetService()))
+		if rule == nil {
+			continue
+		}
+		listenerConfigurer := plugin_xds.ListenerConfigurer{Conf: rule.Conf.(api.Conf)}
+		if err := listenerConfigurer.ConfigureListener(listeners.Outbound[networking.ToOutboundInterface(outbound)]); err != nil {
+			return err
+		}
+	}
+
+	targetedClusters := policies_xds.GatherTargetedClusters(networking.GetOutbound(), clusters.OutboundSplit, clusters.Outbound)
+	for cluster, service := range targetedClusters {
+		if err := configureCluster(toRules.Rules, service, cluster); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func configureCluster(rules core_rules.Rules, service string, cluster *envoy_cluster.Cluster) error {
+	rule := rules.Compute(core_rules.MeshService(service))
+	if rule == nil {
+		return nil
+	}
+	configurer := plugin_xds.ClusterConfigurer{Conf: rule.Conf.(api.Conf)}
+	return configurer.Configure(cluster)
+}
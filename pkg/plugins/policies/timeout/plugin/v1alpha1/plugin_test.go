@@ -0,0 +1,169 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1_test
+
+import (
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/api/v1alpha1"
+	plugin "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/plugin/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	"github.com/apache/dubbo-kubernetes/pkg/test/resources/samples"
+	test_xds "github.com/apache/dubbo-kubernetes/pkg/test/xds"
+)
+
+var _ = Describe("Timeout", func() {
+	type testCase struct {
+		policies   []*api.TimeoutResource
+		goldenFile string
+	}
+
+	duration := func(d time.Duration) *k8s.Duration {
+		return &k8s.Duration{Duration: d}
+	}
+
+	DescribeTable("should apply the timeouts to the resources of the proxy",
+		func(given testCase) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.TimeoutResourceList{Items: given.policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(test_xds.ResourcesYAML(rs)).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+		},
+		Entry("inbound", testCase{
+			policies: []*api.TimeoutResource{
+				test_xds.Policy(api.NewTimeoutResource, "web-inbound", &api.Timeout{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					From: []api.From{{
+						TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+						Default: api.Conf{
+							ConnectionTimeout: duration(2 * time.Second),
+							IdleTimeout:       duration(20 * time.Second),
+							Http: &api.Http{
+								RequestTimeout:        duration(5 * time.Second),
+								StreamIdleTimeout:     duration(10 * time.Second),
+								RequestHeadersTimeout: duration(time.Second),
+							},
+						},
+					}},
+				}),
+			},
+			goldenFile: "timeout.inbound.golden.yaml",
+		}),
+		Entry("outbound", testCase{
+			policies: []*api.TimeoutResource{
+				test_xds.Policy(api.NewTimeoutResource, "mesh-outbound", &api.Timeout{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+						Default: api.Conf{
+							ConnectionTimeout: duration(3 * time.Second),
+						},
+					}},
+				}),
+				test_xds.Policy(api.NewTimeoutResource, "backend-outbound", &api.Timeout{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"},
+						Default: api.Conf{
+							IdleTimeout: duration(time.Minute),
+							Http: &api.Http{
+								RequestTimeout:        duration(15 * time.Second),
+								MaxStreamDuration:     duration(30 * time.Second),
+								MaxConnectionDuration: duration(time.Hour),
+							},
+						},
+					}},
+				}),
+			},
+			goldenFile: "timeout.outbound.golden.yaml",
+		}),
+		Entry("methods", testCase{
+			policies: []*api.TimeoutResource{
+				test_xds.Policy(api.NewTimeoutResource, "backend-methods", &api.Timeout{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"},
+						Default: api.Conf{
+							Http: &api.Http{
+								RequestTimeout: duration(15 * time.Second),
+								Methods: []api.Method{
+									{Interface: "org.apache.dubbo.demo.GreetService", Name: "greet", RequestTimeout: duration(3 * time.Second)},
+									{Interface: "org.apache.dubbo.demo.GreetService", Name: "greetStream", RequestTimeout: duration(0)},
+								},
+							},
+						},
+					}},
+				}),
+			},
+			goldenFile: "timeout.methods.golden.yaml",
+		}),
+	)
+
+	DescribeTable("should leave the resources of the proxy untouched",
+		func(policies []*api.TimeoutResource) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+			untouched, err := test_xds.ResourceSet(xdsCtx, dataplane)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.TimeoutResourceList{Items: policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).To(test_xds.MatchResources(untouched))
+		},
+		Entry("targetRef mismatch", []*api.TimeoutResource{
+			test_xds.Policy(api.NewTimeoutResource, "other", &api.Timeout{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "other"},
+				From: []api.From{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						ConnectionTimeout: duration(2 * time.Second),
+					},
+				}},
+				To: []api.To{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						ConnectionTimeout: duration(2 * time.Second),
+					},
+				}},
+			}),
+		}),
+	)
+})
@@ -0,0 +1,199 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	"fmt"
+)
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoy_upstream_http "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/api/v1alpha1"
+	clusters_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/clusters/v3"
+	listeners_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners/v3"
+)
+
+const httpProtocolOptionsName = "envoy.extensions.upstreams.http.v3.HttpProtocolOptions"
+
+// ListenerConfigurer applies the timeouts to the HTTP connection managers, including
+// the timeouts of their routes, and to the TCP proxies of the listener.
+type ListenerConfigurer struct {
+	Conf api.Conf
+}
+
+func (c *ListenerConfigurer) ConfigureListener(listener *envoy_listener.Listener) error {
+	if listener == nil {
+		return nil
+	}
+	for _, filterChain := range listener.FilterChains {
+		if err := listeners_v3.UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+			c.configureHcm(hcm)
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := listeners_v3.UpdateTCPProxy(filterChain, func(proxy *envoy_tcp.TcpProxy) error {
+			if c.Conf.IdleTimeout != nil {
+				proxy.IdleTimeout = toProtoDuration(c.Conf.IdleTimeout)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ListenerConfigurer) configureHcm(hcm *envoy_hcm.HttpConnectionManager) {
+	if c.Conf.IdleTimeout != nil {
+		if hcm.CommonHttpProtocolOptions == nil {
+			hcm.CommonHttpProtocolOptions = &envoy_core.HttpProtocolOptions{}
+		}
+		hcm.CommonHttpProtocolOptions.IdleTimeout = toProtoDuration(c.Conf.IdleTimeout)
+	}
+	http := c.Conf.Http
+	if http == nil {
+		return
+	}
+	if http.StreamIdleTimeout != nil {
+		hcm.StreamIdleTimeout = toProtoDuration(http.StreamIdleTimeout)
+	}
+	if http.RequestHeadersTimeout != nil {
+		hcm.RequestHeadersTimeout = toProtoDuration(http.RequestHeadersTimeout)
+	}
+	for _, virtualHost := range hcm.GetRouteConfig().GetVirtualHosts() {
+		addMethodRoutes(virtualHost, http.Methods)
+		for _, route := range virtualHost.GetRoutes() {
+			c.configureRoute(route)
+		}
+	}
+}
+
+// addMethodRoutes gives every method which has no route of its own yet a copy of the last route
+// of the virtual host, which takes the requests no other route matches, limited to the path of the method.
+// The copies are placed right before the last route, so the timeouts of the methods can be applied to them.
+func addMethodRoutes(virtualHost *envoy_route.VirtualHost, methods []api.Method) {
+	routes := virtualHost.GetRoutes()
+	if len(methods) == 0 || len(routes) == 0 {
+		return
+	}
+	paths := map[string]struct{}{}
+	for _, route := range routes {
+		if path := route.GetMatch().GetPath(); path != "" {
+			paths[path] = struct{}{}
+		}
+	}
+	last := routes[len(routes)-1]
+	var methodRoutes []*envoy_route.Route
+	for _, method := range methods {
+		path := MethodPath(method)
+		if _, ok := paths[path]; ok {
+			continue
+		}
+		paths[path] = struct{}{}
+		route := proto.Clone(last).(*envoy_route.Route)
+		if route.Match == nil {
+			route.Match = &envoy_route.RouteMatch{}
+		}
+		route.Match.PathSpecifier = &envoy_route.RouteMatch_Path{Path: path}
+		methodRoutes = append(methodRoutes, route)
+	}
+	virtualHost.Routes = append(append(routes[:len(routes)-1:len(routes)-1], methodRoutes...), last)
+}
+
+func (c *ListenerConfigurer) configureRoute(route *envoy_route.Route) {
+	action := route.GetRoute()
+	if action == nil {
+		return
+	}
+	http := c.Conf.Http
+	if http.RequestTimeout != nil {
+		action.Timeout = toProtoDuration(http.RequestTimeout)
+	}
+	if http.StreamIdleTimeout != nil {
+		action.IdleTimeout = toProtoDuration(http.StreamIdleTimeout)
+	}
+	path := route.GetMatch().GetPath()
+	if path == "" {
+		return
+	}
+	for _, method := range http.Methods {
+		if method.RequestTimeout != nil && path == MethodPath(method) {
+			action.Timeout = toProtoDuration(method.RequestTimeout)
+		}
+	}
+}
+
+// ClusterConfigurer applies the connection timeouts to the cluster. HTTP specific
+// timeouts are only applied to clusters that carry HTTP protocol options.
+type ClusterConfigurer struct {
+	Conf api.Conf
+}
+
+func (c *ClusterConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if cluster == nil {
+		return nil
+	}
+	if c.Conf.ConnectionTimeout != nil {
+		cluster.ConnectTimeout = toProtoDuration(c.Conf.ConnectionTimeout)
+	}
+	if _, ok := cluster.GetTypedExtensionProtocolOptions()[httpProtocolOptionsName]; !ok {
+		return nil
+	}
+	return clusters_v3.UpdateCommonHttpProtocolOptions(cluster, func(options *envoy_upstream_http.HttpProtocolOptions) {
+		if options.CommonHttpProtocolOptions == nil {
+			options.CommonHttpProtocolOptions = &envoy_core.HttpProtocolOptions{}
+		}
+		common := options.CommonHttpProtocolOptions
+		if c.Conf.IdleTimeout != nil {
+			common.IdleTimeout = toProtoDuration(c.Conf.IdleTimeout)
+		}
+		if http := c.Conf.Http; http != nil {
+			if http.MaxStreamDuration != nil {
+				common.MaxStreamDuration = toProtoDuration(http.MaxStreamDuration)
+			}
+			if http.MaxConnectionDuration != nil {
+				common.MaxConnectionDuration = toProtoDuration(http.MaxConnectionDuration)
+			}
+		}
+	})
+}
+
+// MethodPath returns the path of the requests of the Dubbo/Triple method,
+// which follows the gRPC convention of "/{interface}/{method}".
+func MethodPath(method api.Method) string {
+	return fmt.Sprintf("/%s/%s", method.Interface, method.Name)
+}
+
+func toProtoDuration(d *k8s.Duration) *durationpb.Duration {
+	return durationpb.New(d.Duration)
+}
@@ -0,0 +1,16 @@
+package timeout
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core"
+	api_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/api/v1alpha1"
+	k8s_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/k8s/v1alpha1"
+	plugin_v1alpha1 "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/plugin/v1alpha1"
+)
+
+func init() {
+	core.Register(
+		api_v1alpha1.TimeoutResourceTypeDescriptor,
+		k8s_v1alpha1.AddToScheme,
+		plugin_v1alpha1.NewPlugin(),
+	)
+}
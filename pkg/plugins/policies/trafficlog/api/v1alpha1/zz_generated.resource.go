@@ -0,0 +1,139 @@
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	_ "embed"
+	"fmt"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+//go:embed schema.yaml
+var rawSchema []byte
+
+func init() {
+	var schema spec.Schema
+	if err := yaml.Unmarshal(rawSchema, &schema); err != nil {
+		panic(err)
+	}
+	rawSchema = nil
+	TrafficLogResourceTypeDescriptor.Schema = &schema
+}
+
+const (
+	TrafficLogType model.ResourceType = "TrafficLog"
+)
+
+var _ model.Resource = &TrafficLogResource{}
+
+type TrafficLogResource struct {
+	Meta model.ResourceMeta
+	Spec *TrafficLog
+}
+
+func NewTrafficLogResource() *TrafficLogResource {
+	return &TrafficLogResource{
+		Spec: &TrafficLog{},
+	}
+}
+
+func (t *TrafficLogResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *TrafficLogResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *TrafficLogResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *TrafficLogResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*TrafficLog)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &TrafficLog{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *TrafficLogResource) Descriptor() model.ResourceTypeDescriptor {
+	return TrafficLogResourceTypeDescriptor
+}
+
+func (t *TrafficLogResource) Validate() error {
+	if v, ok := interface{}(t).(interface{ validate() error }); !ok {
+		return nil
+	} else {
+		return v.validate()
+	}
+}
+
+var _ model.ResourceList = &TrafficLogResourceList{}
+
+type TrafficLogResourceList struct {
+	Items      []*TrafficLogResource
+	Pagination model.Pagination
+}
+
+func (l *TrafficLogResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *TrafficLogResourceList) GetItemType() model.ResourceType {
+	return TrafficLogType
+}
+
+func (l *TrafficLogResourceList) NewItem() model.Resource {
+	return NewTrafficLogResource()
+}
+
+func (l *TrafficLogResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*TrafficLogResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*TrafficLogResource)(nil), r)
+	}
+}
+
+func (l *TrafficLogResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *TrafficLogResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var TrafficLogResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                TrafficLogType,
+	Resource:            NewTrafficLogResource(),
+	ResourceList:        &TrafficLogResourceList{},
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag | model.ZoneToGlobalFlag,
+	WsPath:              "trafficlogs",
+	DubboctlArg:         "trafficlog",
+	DubboctlListArg:     "trafficlogs",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	IsExperimental:      false,
+	SingularDisplayName: "Traffic Log",
+	PluralDisplayName:   "Traffic Logs",
+	IsPluginOriginated:  true,
+}
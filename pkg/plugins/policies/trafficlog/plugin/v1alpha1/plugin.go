@@ -0,0 +1,122 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers"
+	core_rules "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/rules"
+	policies_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/xds"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/trafficlog/api/v1alpha1"
+	plugin_xds "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/trafficlog/plugin/xds"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+)
+
+var _ core_plugins.PolicyPlugin = &plugin{}
+
+type plugin struct{}
+
+func NewPlugin() core_plugins.Plugin {
+	return &plugin{}
+}
+
+func (p plugin) MatchedPolicies(dataplane *core_mesh.DataplaneResource, resources xds_context.Resources) (core_xds.TypedMatchingPolicies, error) {
+	return matchers.MatchedPolicies(api.TrafficLogType, dataplane, resources)
+}
+
+func (p plugin) Apply(rs *core_xds.ResourceSet, ctx xds_context.Context, proxy *core_xds.Proxy) error {
+	if proxy.Dataplane == nil {
+		return nil
+	}
+	policies, ok := proxy.Policies.Dynamic[api.TrafficLogType]
+	if !ok {
+		return nil
+	}
+
+	listeners := policies_xds.GatherListeners(rs)
+
+	if err := applyToInbounds(policies.FromRules, listeners, ctx, proxy); err != nil {
+		return err
+	}
+	if err := applyToOutbounds(policies.ToRules, listeners, ctx, proxy); err != nil {
+		return err
+	}
+	return nil
+}
+
+func applyToInbounds(
+	fromRules core_rules.FromRules,
+	listeners policies_xds.Listeners,
+	ctx xds_context.Context,
+	proxy *core_xds.Proxy,
+) error {
+	for _, iface := range proxy.Dataplane.Spec.GetNetworking().GetInbound() {
+		dataplaneIface := proxy.Dataplane.Spec.GetNetworking().ToInboundInterface(iface)
+		key := core_rules.InboundListener{
+			Address: dataplaneIface.DataplaneIP,
+			Port:    dataplaneIface.DataplanePort,
+		}
+		rule := fromRules.Rules[key].Compute(core_rules.MeshSubset())
+		if rule == nil {
+			continue
+		}
+		configurer := plugin_xds.Configurer{
+			Conf:               rule.Conf.(api.Conf),
+			Mesh:               ctx.Mesh.Resource,
+			TrafficDirection:   envoy_common.TrafficDirectionInbound,
+			SourceService:      mesh_proto.MatchAllTag,
+			DestinationService: iface.GetService(),
+			Proxy:              proxy,
+		}
+		if err := configurer.ConfigureListener(listeners.Inbound[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyToOutbounds(
+	toRules core_rules.ToRules,
+	listeners policies_xds.Listeners,
+	ctx xds_context.Context,
+	proxy *core_xds.Proxy,
+) error {
+	networking := proxy.Dataplane.Spec.GetNetworking()
+	for _, outbound := range networking.GetOutbound() {
+		rule := toRules.Rules.Compute(core_rules.MeshService(outbound.GetService()))
+		if rule == nil {
+			continue
+		}
+		configurer := plugin_xds.Configurer{
+			Conf:               rule.Conf.(api.Conf),
+			Mesh:               ctx.Mesh.Resource,
+			TrafficDirection:   envoy_common.TrafficDirectionOutbound,
+			SourceService:      proxy.Dataplane.Spec.GetIdentifyingService(),
+			DestinationService: outbound.GetService(),
+			Proxy:              proxy,
+		}
+		if err := configurer.ConfigureListener(listeners.Outbound[networking.ToOutboundInterface(outbound)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/trafficlog/api/v1alpha1"
+	plugin "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/trafficlog/plugin/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	"github.com/apache/dubbo-kubernetes/pkg/test/resources/samples"
+	test_xds "github.com/apache/dubbo-kubernetes/pkg/test/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+)
+
+var _ = Describe("TrafficLog", func() {
+	type testCase struct {
+		policies   []*api.TrafficLogResource
+		goldenFile string
+	}
+
+	xdsContext := func() xds_context.Context {
+		xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+		xdsCtx.Mesh.Resource.Spec.Logging = &mesh_proto.Logging{
+			DefaultBackend: "file",
+			Backends: []*mesh_proto.LoggingBackend{
+				{
+					Name: "file",
+					Type: mesh_proto.LoggingFileType,
+					Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{Path: "/var/log/access.log"}),
+				},
+				{
+					Name:   "logstash",
+					Type:   mesh_proto.LoggingTcpType,
+					Format: "%START_TIME% %DUBBO_SOURCE_SERVICE% -> %DUBBO_DESTINATION_SERVICE%",
+					Conf:   util_proto.MustToStruct(&mesh_proto.TcpLoggingBackendConfig{Address: "logstash.logging:5000"}),
+				},
+			},
+		}
+		return xdsCtx
+	}
+
+	DescribeTable("should apply the logging backend to the resources of the proxy",
+		func(given testCase) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := xdsContext()
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.TrafficLogResourceList{Items: given.policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(test_xds.ResourcesYAML(rs)).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+		},
+		Entry("inbound and outbound", testCase{
+			policies: []*api.TrafficLogResource{
+				test_xds.Policy(api.NewTrafficLogResource, "web-logs", &api.TrafficLog{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					From: []api.From{{
+						TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+						Default: api.Conf{
+							Backend: pointer.To("logstash"),
+						},
+					}},
+					To: []api.To{{
+						TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "backend"},
+						Default: api.Conf{
+							Disabled: pointer.To(true),
+						},
+					}},
+				}),
+			},
+			goldenFile: "trafficlog.inbound-outbound.golden.yaml",
+		}),
+	)
+
+	DescribeTable("should leave the resources of the proxy untouched",
+		func(policies []*api.TrafficLogResource) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := xdsContext()
+			untouched, err := test_xds.ResourceSet(xdsCtx, dataplane)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.TrafficLogResourceList{Items: policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).To(test_xds.MatchResources(untouched))
+		},
+		Entry("unknown backend", []*api.TrafficLogResource{
+			test_xds.Policy(api.NewTrafficLogResource, "web-logs", &api.TrafficLog{
+				TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+				To: []api.To{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						Backend: pointer.To("fluentd"),
+					},
+				}},
+			}),
+		}),
+		Entry("targetRef mismatch", []*api.TrafficLogResource{
+			test_xds.Policy(api.NewTrafficLogResource, "other", &api.TrafficLog{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "other"},
+				From: []api.From{{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						Disabled: pointer.To(true),
+					},
+				}},
+			}),
+		}),
+	)
+})
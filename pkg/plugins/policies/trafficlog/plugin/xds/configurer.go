@@ -0,0 +1,85 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/trafficlog/api/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	listeners_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners/v3"
+)
+
+// Configurer replaces the access logs of the HTTP connection managers and TCP proxies, which
+// the generators configured with the default logging backend of the mesh, with the ones of the policy.
+// A policy referring to a backend that the mesh doesn't define leaves the listener untouched.
+type Configurer struct {
+	Conf               api.Conf
+	Mesh               *core_mesh.MeshResource
+	TrafficDirection   envoy_common.TrafficDirection
+	SourceService      string
+	DestinationService string
+	Proxy              *core_xds.Proxy
+}
+
+func (c *Configurer) ConfigureListener(listener *envoy_listener.Listener) error {
+	if listener == nil {
+		return nil
+	}
+	disabled := pointer.Deref(c.Conf.Disabled)
+	backend := c.Mesh.GetLoggingBackend(pointer.Deref(c.Conf.Backend))
+	if !disabled && backend == nil {
+		return nil
+	}
+	if disabled {
+		backend = nil
+	}
+	for _, filterChain := range listener.FilterChains {
+		if err := listeners_v3.UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+			hcm.AccessLog = nil
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := listeners_v3.UpdateTCPProxy(filterChain, func(tcpProxy *envoy_tcp.TcpProxy) error {
+			tcpProxy.AccessLog = nil
+			return nil
+		}); err != nil {
+			return err
+		}
+		configurer := listeners_v3.AccessLogConfigurer{
+			Mesh:               c.Mesh.GetMeta().GetName(),
+			TrafficDirection:   c.TrafficDirection,
+			SourceService:      c.SourceService,
+			DestinationService: c.DestinationService,
+			Backend:            backend,
+			Proxy:              c.Proxy,
+		}
+		if err := configurer.Configure(filterChain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// +kubebuilder:object:generate=true
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+)
+
+// TrafficTrace
+// +dubbo:policy:singular_display_name=Traffic Trace
+type TrafficTrace struct {
+	// TargetRef is a reference to the resource the policy takes an effect on.
+	// The resource could be either a real store object or virtual resource
+	// defined inplace.
+	TargetRef common_api.TargetRef `json:"targetRef"`
+	// Default is a configuration of the tracing of the proxies referenced in
+	// 'targetRef', which overrides the default tracing backend of the mesh
+	Default Conf `json:"default,omitempty"`
+}
+
+type Conf struct {
+	// Backend is the name of one of the tracing backends of the mesh. The
+	// default backend of the mesh is used when it's not set
+	Backend *string `json:"backend,omitempty"`
+	// Sampling is the percentage of the requests which are traced, it overrides
+	// the sampling of the backend. Either int or decimal represented as string
+	Sampling *intstr.IntOrString `json:"sampling,omitempty"`
+	// Disabled turns off the tracing of the requests handled by the proxies
+	Disabled *bool `json:"disabled,omitempty"`
+}
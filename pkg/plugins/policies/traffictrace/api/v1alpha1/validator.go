@@ -0,0 +1,63 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
+	matcher_validators "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/matchers/validators"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+)
+
+func (r *TrafficTraceResource) validate() error {
+	var verr validators.ValidationError
+	path := validators.RootedAt("spec")
+	verr.AddErrorAt(path.Field("targetRef"), matcher_validators.ValidateTargetRef(r.Spec.GetTargetRef(), &matcher_validators.ValidateTargetRefOpts{
+		SupportedKinds: []common_api.TargetRefKind{
+			common_api.Mesh,
+			common_api.MeshSubset,
+			common_api.MeshService,
+			common_api.MeshServiceSubset,
+		},
+	}))
+	verr.AddErrorAt(path.Field("default"), validateDefault(r.Spec.Default))
+	return verr.OrNil()
+}
+
+func validateDefault(conf Conf) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.Root()
+	if conf.Backend == nil && conf.Sampling == nil && conf.Disabled == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("backend", "sampling", "disabled"))
+	}
+	if conf.Backend != nil {
+		verr.Add(validators.ValidateStringDefined(path.Field("backend"), *conf.Backend))
+	}
+	if conf.Sampling != nil {
+		verr.Add(validators.ValidatePercentage(path.Field("sampling"), *conf.Sampling))
+	}
+	if pointer.Deref(conf.Disabled) {
+		if conf.Backend != nil {
+			verr.AddViolationAt(path.Field("backend"), validators.MustNotBeSet)
+		}
+		if conf.Sampling != nil {
+			verr.AddViolationAt(path.Field("sampling"), validators.MustNotBeSet)
+		}
+	}
+	return verr
+}
@@ -0,0 +1,36 @@
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+func (x *TrafficTrace) GetTargetRef() common_api.TargetRef {
+	return x.TargetRef
+}
+
+func (x *TrafficTrace) GetDefault() interface{} {
+	return x.Default
+}
+
+func (x *TrafficTrace) GetPolicyItem() core_model.PolicyItem {
+	return &policyItem{
+		TrafficTrace: x,
+	}
+}
+
+// policyItem is an auxiliary struct with the implementation of the GetTargetRef() to always return the whole mesh,
+// because the top-level targetRef of a single item policy only selects the proxies.
+type policyItem struct {
+	*TrafficTrace
+}
+
+var _ core_model.PolicyItem = &policyItem{}
+
+func (p *policyItem) GetTargetRef() common_api.TargetRef {
+	return common_api.TargetRef{Kind: common_api.Mesh}
+}
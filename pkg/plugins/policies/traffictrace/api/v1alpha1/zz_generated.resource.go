@@ -0,0 +1,139 @@
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package v1alpha1
+
+import (
+	_ "embed"
+	"fmt"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+//go:embed schema.yaml
+var rawSchema []byte
+
+func init() {
+	var schema spec.Schema
+	if err := yaml.Unmarshal(rawSchema, &schema); err != nil {
+		panic(err)
+	}
+	rawSchema = nil
+	TrafficTraceResourceTypeDescriptor.Schema = &schema
+}
+
+const (
+	TrafficTraceType model.ResourceType = "TrafficTrace"
+)
+
+var _ model.Resource = &TrafficTraceResource{}
+
+type TrafficTraceResource struct {
+	Meta model.ResourceMeta
+	Spec *TrafficTrace
+}
+
+func NewTrafficTraceResource() *TrafficTraceResource {
+	return &TrafficTraceResource{
+		Spec: &TrafficTrace{},
+	}
+}
+
+func (t *TrafficTraceResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *TrafficTraceResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *TrafficTraceResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *TrafficTraceResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*TrafficTrace)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &TrafficTrace{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *TrafficTraceResource) Descriptor() model.ResourceTypeDescriptor {
+	return TrafficTraceResourceTypeDescriptor
+}
+
+func (t *TrafficTraceResource) Validate() error {
+	if v, ok := interface{}(t).(interface{ validate() error }); !ok {
+		return nil
+	} else {
+		return v.validate()
+	}
+}
+
+var _ model.ResourceList = &TrafficTraceResourceList{}
+
+type TrafficTraceResourceList struct {
+	Items      []*TrafficTraceResource
+	Pagination model.Pagination
+}
+
+func (l *TrafficTraceResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *TrafficTraceResourceList) GetItemType() model.ResourceType {
+	return TrafficTraceType
+}
+
+func (l *TrafficTraceResourceList) NewItem() model.Resource {
+	return NewTrafficTraceResource()
+}
+
+func (l *TrafficTraceResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*TrafficTraceResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*TrafficTraceResource)(nil), r)
+	}
+}
+
+func (l *TrafficTraceResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func (l *TrafficTraceResourceList) SetPagination(p model.Pagination) {
+	l.Pagination = p
+}
+
+var TrafficTraceResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:                TrafficTraceType,
+	Resource:            NewTrafficTraceResource(),
+	ResourceList:        &TrafficTraceResourceList{},
+	Scope:               model.ScopeMesh,
+	DDSFlags:            model.GlobalToAllZonesFlag | model.ZoneToGlobalFlag,
+	WsPath:              "traffictraces",
+	DubboctlArg:         "traffictrace",
+	DubboctlListArg:     "traffictraces",
+	AllowToInspect:      true,
+	IsPolicy:            true,
+	IsExperimental:      false,
+	SingularDisplayName: "Traffic Trace",
+	PluralDisplayName:   "Traffic Traces",
+	IsPluginOriginated:  true,
+}
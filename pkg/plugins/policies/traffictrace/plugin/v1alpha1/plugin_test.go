@@ -0,0 +1,158 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/traffictrace/api/v1alpha1"
+	plugin "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/traffictrace/plugin/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	"github.com/apache/dubbo-kubernetes/pkg/test/resources/samples"
+	test_xds "github.com/apache/dubbo-kubernetes/pkg/test/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+)
+
+var _ = Describe("TrafficTrace", func() {
+	type testCase struct {
+		policies   []*api.TrafficTraceResource
+		goldenFile string
+	}
+
+	zipkin := func(name, url string) *mesh_proto.TracingBackend {
+		return &mesh_proto.TracingBackend{
+			Name:     name,
+			Type:     mesh_proto.TracingZipkinType,
+			Sampling: wrapperspb.Double(100),
+			Conf:     util_proto.MustToStruct(&mesh_proto.ZipkinTracingBackendConfig{Url: url}),
+		}
+	}
+
+	xdsContext := func() xds_context.Context {
+		xdsCtx := test_xds.Context(core_mesh.ProtocolHTTP, "backend")
+		xdsCtx.Mesh.Resource.Spec.Tracing = &mesh_proto.Tracing{
+			DefaultBackend: "zipkin",
+			Backends: []*mesh_proto.TracingBackend{
+				zipkin("zipkin", "http://zipkin.tracing:9411/api/v2/spans"),
+				zipkin("zipkin-debug", "http://zipkin-debug.tracing:9411/api/v2/spans"),
+			},
+		}
+		return xdsCtx
+	}
+
+	DescribeTable("should apply the tracing backend to the resources of the proxy",
+		func(given testCase) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := xdsContext()
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.TrafficTraceResourceList{Items: given.policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(test_xds.ResourcesYAML(rs)).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+		},
+		Entry("inbound and outbound", testCase{
+			policies: []*api.TrafficTraceResource{
+				test_xds.Policy(api.NewTrafficTraceResource, "mesh-trace", &api.TrafficTrace{
+					TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+					Default: api.Conf{
+						Sampling: pointer.To(intstr.FromInt(10)),
+					},
+				}),
+				test_xds.Policy(api.NewTrafficTraceResource, "web-trace", &api.TrafficTrace{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+					Default: api.Conf{
+						Backend: pointer.To("zipkin-debug"),
+					},
+				}),
+			},
+			goldenFile: "traffictrace.enabled.golden.yaml",
+		}),
+	)
+
+	DescribeTable("should leave the resources of the proxy untouched",
+		func(policies []*api.TrafficTraceResource) {
+			// given
+			dataplane := samples.DataplaneWeb()
+			xdsCtx := xdsContext()
+			untouched, err := test_xds.ResourceSet(xdsCtx, dataplane)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsCtx, dataplane, &api.TrafficTraceResourceList{Items: policies})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).To(test_xds.MatchResources(untouched))
+		},
+		Entry("unknown backend", []*api.TrafficTraceResource{
+			test_xds.Policy(api.NewTrafficTraceResource, "web-trace", &api.TrafficTrace{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+				Default: api.Conf{
+					Backend: pointer.To("jaeger"),
+				},
+			}),
+		}),
+		Entry("targetRef mismatch", []*api.TrafficTraceResource{
+			test_xds.Policy(api.NewTrafficTraceResource, "other", &api.TrafficTrace{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "other"},
+				Default: api.Conf{
+					Backend: pointer.To("zipkin-debug"),
+				},
+			}),
+		}),
+	)
+
+	It("should remove the tracing of the mesh from the resources of the proxy when it is disabled", func() {
+		// given
+		dataplane := samples.DataplaneWeb()
+		untraced, err := test_xds.ResourceSet(test_xds.Context(core_mesh.ProtocolHTTP, "backend"), dataplane)
+		Expect(err).ToNot(HaveOccurred())
+		policies := &api.TrafficTraceResourceList{Items: []*api.TrafficTraceResource{
+			test_xds.Policy(api.NewTrafficTraceResource, "web-trace", &api.TrafficTrace{
+				TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Name: "web"},
+				Default: api.Conf{
+					Disabled: pointer.To(true),
+				},
+			}),
+		}}
+
+		// when
+		rs, err := test_xds.ApplyPolicies(plugin.NewPlugin().(core_plugins.PolicyPlugin), xdsContext(), dataplane, policies)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rs).To(test_xds.MatchResources(untraced))
+	})
+})
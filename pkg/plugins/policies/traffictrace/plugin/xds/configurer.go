@@ -0,0 +1,86 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/traffictrace/api/v1alpha1"
+	util_k8s "github.com/apache/dubbo-kubernetes/pkg/util/k8s"
+	"github.com/apache/dubbo-kubernetes/pkg/util/pointer"
+	listeners_v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners/v3"
+)
+
+// Configurer replaces the tracing of the HTTP connection managers, which the generators
+// configured with the default tracing backend of the mesh, with the one of the policy.
+type Configurer struct {
+	Conf api.Conf
+	Mesh *core_mesh.MeshResource
+}
+
+func (c *Configurer) ConfigureListener(listener *envoy_listener.Listener) error {
+	if listener == nil {
+		return nil
+	}
+	backend, ok, err := c.backend()
+	if err != nil || !ok {
+		return err
+	}
+	for _, filterChain := range listener.FilterChains {
+		if err := listeners_v3.UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+			hcm.Tracing = nil
+			return nil
+		}); err != nil {
+			return err
+		}
+		configurer := listeners_v3.TracingConfigurer{Backend: backend}
+		if err := configurer.Configure(filterChain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backend returns the tracing backend which should be used by the listener, nil when the tracing is disabled.
+// It returns false when the policy refers to a backend that the mesh doesn't define, so the listener is left untouched.
+func (c *Configurer) backend() (*mesh_proto.TracingBackend, bool, error) {
+	if pointer.Deref(c.Conf.Disabled) {
+		return nil, true, nil
+	}
+	backend := c.Mesh.GetTracingBackend(pointer.Deref(c.Conf.Backend))
+	if backend == nil {
+		return nil, false, nil
+	}
+	if c.Conf.Sampling != nil {
+		sampling, err := util_k8s.ParsePercentage(*c.Conf.Sampling)
+		if err != nil {
+			return nil, false, err
+		}
+		backend = proto.Clone(backend).(*mesh_proto.TracingBackend)
+		backend.Sampling = wrapperspb.Double(sampling)
+	}
+	return backend, true, nil
+}
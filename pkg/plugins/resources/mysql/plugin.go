@@ -0,0 +1,101 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mysql
+
+import (
+	"sync"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/events"
+	common_mysql "github.com/apache/dubbo-kubernetes/pkg/plugins/common/mysql"
+)
+
+var (
+	log                                  = core.Log.WithName("plugins").WithName("resources").WithName("mysql")
+	_   core_plugins.ResourceStorePlugin = &plugin{}
+)
+
+type plugin struct {
+	sync.Mutex
+	// db is shared by NewResourceStore and Migrate, so that both work on the same connection pool.
+	db *gorm.DB
+}
+
+func init() {
+	core_plugins.Register(core_plugins.MySQL, &plugin{})
+}
+
+func (p *plugin) NewResourceStore(pc core_plugins.PluginContext, _ core_plugins.PluginConfig) (core_store.ResourceStore, core_store.Transactions, error) {
+	db, err := p.connect(pc)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := Migrate(db); err != nil {
+		return nil, nil, errors.Wrap(err, "could not migrate mysql schema")
+	}
+	if pc.Config().Store.Mysql.MysqlDsn == common_mysql.InMemoryDsn {
+		log.Info("dubbo-cp runs with an in-memory SQLite database and its state isn't preserved between restarts.")
+	} else {
+		log.Info("dubbo-cp runs with a mysql resource store")
+	}
+	return NewStore(db), core_store.NoTransactions{}, nil
+}
+
+func (p *plugin) Migrate(pc core_plugins.PluginContext, _ core_plugins.PluginConfig) (core_plugins.DbVersion, error) {
+	db, err := p.connect(pc)
+	if err != nil {
+		return 0, err
+	}
+	if err := Migrate(db); err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
+
+func (p *plugin) connect(pc core_plugins.PluginContext) (*gorm.DB, error) {
+	p.Lock()
+	defer p.Unlock()
+	if p.db != nil {
+		return p.db, nil
+	}
+	cfg := pc.Config().Store.Mysql
+	if cfg == nil {
+		return nil, errors.New("mysql store configuration is missing")
+	}
+	db, err := common_mysql.ConnectToDb(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not connect to mysql")
+	}
+	p.db = db
+	return db, nil
+}
+
+func (p *plugin) EventListener(pc core_plugins.PluginContext, writer events.Emitter) error {
+	pc.ResourceStore().DefaultResourceStore().(*mysqlStore).SetEventWriter(writer)
+	return nil
+}
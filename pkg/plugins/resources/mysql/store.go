@@ -0,0 +1,389 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+import (
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/registry"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/events"
+)
+
+// ResourceEntity is the database representation of a resource.
+// Every resource type is kept in the same table and identified by (type, mesh, name).
+type ResourceEntity struct {
+	Type             string `gorm:"primaryKey;size:64"`
+	Mesh             string `gorm:"primaryKey;size:128"`
+	Name             string `gorm:"primaryKey;size:255"`
+	Version          uint64 `gorm:"not null"`
+	Spec             string
+	Labels           string
+	OwnerType        string `gorm:"size:64;index:idx_resources_owner"`
+	OwnerMesh        string `gorm:"size:128;index:idx_resources_owner"`
+	OwnerName        string `gorm:"size:255;index:idx_resources_owner"`
+	CreationTime     time.Time
+	ModificationTime time.Time
+}
+
+func (ResourceEntity) TableName() string {
+	return "resources"
+}
+
+// Migrate creates or updates the schema used by the store.
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&ResourceEntity{})
+}
+
+var _ store.ResourceStore = &mysqlStore{}
+
+type mysqlStore struct {
+	db          *gorm.DB
+	mu          sync.RWMutex
+	eventWriter events.Emitter
+}
+
+func NewStore(db *gorm.DB) store.ResourceStore {
+	return &mysqlStore{
+		db: db,
+	}
+}
+
+func (s *mysqlStore) SetEventWriter(writer events.Emitter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eventWriter = writer
+}
+
+func (s *mysqlStore) Create(ctx context.Context, r core_model.Resource, fs ...store.CreateOptionsFunc) error {
+	opts := store.NewCreateOptions(fs...)
+
+	spec, err := core_model.ToJSON(r.GetSpec())
+	if err != nil {
+		return errors.Wrap(err, "failed to convert spec to json")
+	}
+	labels, err := marshalLabels(opts.Labels)
+	if err != nil {
+		return err
+	}
+
+	entity := &ResourceEntity{
+		Type:             string(r.Descriptor().Name),
+		Mesh:             opts.Mesh,
+		Name:             opts.Name,
+		Version:          initialVersion,
+		Spec:             string(spec),
+		Labels:           labels,
+		CreationTime:     opts.CreationTime,
+		ModificationTime: opts.CreationTime,
+	}
+	if opts.Owner != nil {
+		entity.OwnerType = string(opts.Owner.Descriptor().Name)
+		entity.OwnerMesh = opts.Owner.GetMeta().GetMesh()
+		entity.OwnerName = opts.Owner.GetMeta().GetName()
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if opts.Owner != nil {
+			ownerExists, err := recordExists(tx, entity.OwnerType, entity.OwnerName, entity.OwnerMesh)
+			if err != nil {
+				return err
+			}
+			if !ownerExists {
+				return store.ErrorResourceNotFound(opts.Owner.Descriptor().Name, entity.OwnerName, entity.OwnerMesh)
+			}
+		}
+		// the primary key rejects a resource which exists already, also when it's created concurrently
+		if err := tx.Create(entity).Error; err != nil {
+			if s.isDuplicatedKey(err) {
+				return store.ErrorResourceAlreadyExists(r.Descriptor().Name, opts.Name, opts.Mesh)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, &store.ResourceConflictError{}) || store.IsResourceNotFound(err) {
+			return err
+		}
+		return errors.Wrapf(err, "failed to create %s %s/%s", r.Descriptor().Name, opts.Mesh, opts.Name)
+	}
+
+	r.SetMeta(&resourceMetaObject{
+		Name:             opts.Name,
+		Mesh:             opts.Mesh,
+		Version:          initialVersion,
+		CreationTime:     opts.CreationTime,
+		ModificationTime: opts.CreationTime,
+		Labels:           opts.Labels,
+	})
+
+	s.emit(events.Create, r.Descriptor().Name, core_model.MetaToResourceKey(r.GetMeta()))
+	return nil
+}
+
+func (s *mysqlStore) Update(ctx context.Context, r core_model.Resource, fs ...store.UpdateOptionsFunc) error {
+	opts := store.NewUpdateOptions(fs...)
+
+	meta, ok := (r.GetMeta()).(*resourceMetaObject)
+	if !ok {
+		return fmt.Errorf("MysqlStore.Update() requires r.GetMeta() to be of type resourceMetaObject")
+	}
+
+	spec, err := core_model.ToJSON(r.GetSpec())
+	if err != nil {
+		return errors.Wrap(err, "failed to convert spec to json")
+	}
+	labels, err := marshalLabels(opts.Labels)
+	if err != nil {
+		return err
+	}
+
+	newVersion := meta.Version + 1
+	// optimistic concurrency: the row is updated only if nobody changed it since it was read
+	result := s.db.WithContext(ctx).
+		Model(&ResourceEntity{}).
+		Where("type = ? AND mesh = ? AND name = ? AND version = ?", string(r.Descriptor().Name), meta.Mesh, meta.Name, meta.Version).
+		Updates(map[string]interface{}{
+			"version":           newVersion,
+			"spec":              string(spec),
+			"labels":            labels,
+			"modification_time": opts.ModificationTime,
+		})
+	if result.Error != nil {
+		return errors.Wrapf(result.Error, "failed to update %s %s/%s", r.Descriptor().Name, meta.Mesh, meta.Name)
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrorResourceConflict(r.Descriptor().Name, meta.Name, meta.Mesh)
+	}
+
+	r.SetMeta(&resourceMetaObject{
+		Name:             meta.Name,
+		Mesh:             meta.Mesh,
+		Version:          newVersion,
+		CreationTime:     meta.CreationTime,
+		ModificationTime: opts.ModificationTime,
+		Labels:           opts.Labels,
+	})
+
+	s.emit(events.Update, r.Descriptor().Name, core_model.MetaToResourceKey(r.GetMeta()))
+	return nil
+}
+
+func (s *mysqlStore) Delete(ctx context.Context, r core_model.Resource, fs ...store.DeleteOptionsFunc) error {
+	opts := store.NewDeleteOptions(fs...)
+
+	_, ok := (r.GetMeta()).(*resourceMetaObject)
+	if r.GetMeta() != nil && !ok {
+		return fmt.Errorf("MysqlStore.Delete() requires r.GetMeta() either to be nil or to be of type resourceMetaObject")
+	}
+
+	var deleted []*ResourceEntity
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		entity := &ResourceEntity{}
+		err := tx.Where("type = ? AND mesh = ? AND name = ?", string(r.Descriptor().Name), opts.Mesh, opts.Name).Take(entity).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return store.ErrorResourceNotFound(r.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+		if err != nil {
+			return err
+		}
+		deleted, err = deleteWithChildren(tx, entity)
+		return err
+	})
+	if err != nil {
+		if store.IsResourceNotFound(err) {
+			return err
+		}
+		return errors.Wrapf(err, "failed to delete %s %s/%s", r.Descriptor().Name, opts.Mesh, opts.Name)
+	}
+
+	for _, entity := range deleted {
+		s.emit(events.Delete, core_model.ResourceType(entity.Type), core_model.ResourceKey{
+			Mesh: entity.Mesh,
+			Name: entity.Name,
+		})
+	}
+	return nil
+}
+
+func (s *mysqlStore) Get(ctx context.Context, r core_model.Resource, fs ...store.GetOptionsFunc) error {
+	opts := store.NewGetOptions(fs...)
+
+	entity := &ResourceEntity{}
+	err := s.db.WithContext(ctx).
+		Where("type = ? AND mesh = ? AND name = ?", string(r.Descriptor().Name), opts.Mesh, opts.Name).
+		Take(entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return store.ErrorResourceNotFound(r.Descriptor().Name, opts.Name, opts.Mesh)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %s %s/%s", r.Descriptor().Name, opts.Mesh, opts.Name)
+	}
+	if opts.Version != "" && opts.Version != fmt.Sprint(entity.Version) {
+		return store.ErrorResourceConflict(r.Descriptor().Name, opts.Name, opts.Mesh)
+	}
+	return unmarshalEntity(entity, r)
+}
+
+func (s *mysqlStore) List(ctx context.Context, rs core_model.ResourceList, fs ...store.ListOptionsFunc) error {
+	opts := store.NewListOptions(fs...)
+
+	query := s.db.WithContext(ctx).Where("type = ?", string(rs.GetItemType()))
+	if opts.Mesh != "" {
+		query = query.Where("mesh = ?", opts.Mesh)
+	}
+	if opts.NameContains != "" {
+		query = query.Where("name LIKE ? ESCAPE '!'", "%"+escapeLike(opts.NameContains)+"%")
+	}
+
+	var entities []*ResourceEntity
+	if err := query.Order("name").Order("mesh").Find(&entities).Error; err != nil {
+		return errors.Wrapf(err, "failed to list %s", rs.GetItemType())
+	}
+
+	for _, entity := range entities {
+		r := rs.NewItem()
+		if err := unmarshalEntity(entity, r); err != nil {
+			return err
+		}
+		_ = rs.AddItem(r)
+	}
+
+	rs.GetPagination().SetTotal(uint32(len(entities)))
+	return nil
+}
+
+func (s *mysqlStore) emit(op events.Op, resourceType core_model.ResourceType, key core_model.ResourceKey) {
+	s.mu.RLock()
+	writer := s.eventWriter
+	s.mu.RUnlock()
+	if writer == nil {
+		return
+	}
+	go func() {
+		writer.Send(events.ResourceChangedEvent{
+			Operation: op,
+			Type:      resourceType,
+			Key:       key,
+		})
+	}()
+}
+
+const initialVersion uint64 = 1
+
+// isDuplicatedKey returns true when the error is the violation of a unique constraint,
+// MySQL error 1062 or a SQLite constraint error, as translated by the dialector of the database.
+func (s *mysqlStore) isDuplicatedKey(err error) bool {
+	if translator, ok := s.db.Dialector.(gorm.ErrorTranslator); ok {
+		err = translator.Translate(err)
+	}
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
+
+func recordExists(tx *gorm.DB, resourceType, name, mesh string) (bool, error) {
+	var count int64
+	err := tx.Model(&ResourceEntity{}).
+		Where("type = ? AND mesh = ? AND name = ?", resourceType, mesh, name).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// deleteWithChildren removes the entity together with every resource it owns, transitively.
+// It returns all entities that were removed.
+func deleteWithChildren(tx *gorm.DB, entity *ResourceEntity) ([]*ResourceEntity, error) {
+	var children []*ResourceEntity
+	err := tx.Where("owner_type = ? AND owner_mesh = ? AND owner_name = ?", entity.Type, entity.Mesh, entity.Name).
+		Find(&children).Error
+	if err != nil {
+		return nil, err
+	}
+	var deleted []*ResourceEntity
+	for _, child := range children {
+		if _, err := registry.Global().DescriptorFor(core_model.ResourceType(child.Type)); err != nil {
+			return nil, fmt.Errorf("MysqlStore.Delete() couldn't find descriptor of child resource %s", child.Type)
+		}
+		removed, err := deleteWithChildren(tx, child)
+		if err != nil {
+			return nil, err
+		}
+		deleted = append(deleted, removed...)
+	}
+	err = tx.Where("type = ? AND mesh = ? AND name = ?", entity.Type, entity.Mesh, entity.Name).
+		Delete(&ResourceEntity{}).Error
+	if err != nil {
+		return nil, err
+	}
+	return append(deleted, entity), nil
+}
+
+func unmarshalEntity(entity *ResourceEntity, r core_model.Resource) error {
+	labels, err := unmarshalLabels(entity.Labels)
+	if err != nil {
+		return err
+	}
+	r.SetMeta(&resourceMetaObject{
+		Name:             entity.Name,
+		Mesh:             entity.Mesh,
+		Version:          entity.Version,
+		CreationTime:     entity.CreationTime,
+		ModificationTime: entity.ModificationTime,
+		Labels:           labels,
+	})
+	return core_model.FromJSON([]byte(entity.Spec), r.GetSpec())
+}
+
+func marshalLabels(labels map[string]string) (string, error) {
+	if len(labels) == 0 {
+		return "", nil
+	}
+	bytes, err := json.Marshal(labels)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert labels to json")
+	}
+	return string(bytes), nil
+}
+
+func unmarshalLabels(labels string) (map[string]string, error) {
+	res := map[string]string{}
+	if labels == "" {
+		return res, nil
+	}
+	if err := json.Unmarshal([]byte(labels), &res); err != nil {
+		return nil, errors.Wrap(err, "failed to convert json to labels")
+	}
+	return res, nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
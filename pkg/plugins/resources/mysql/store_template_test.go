@@ -0,0 +1,44 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mysql_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	config_mysql "github.com/apache/dubbo-kubernetes/pkg/config/plugins/resources/mysql"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	common_mysql "github.com/apache/dubbo-kubernetes/pkg/plugins/common/mysql"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/mysql"
+	test_store "github.com/apache/dubbo-kubernetes/pkg/test/store"
+)
+
+var _ = Describe("MysqlStore template", func() {
+	createStore := func() store.ResourceStore {
+		db, err := common_mysql.ConnectToDb(config_mysql.MysqlStoreConfig{MysqlDsn: common_mysql.InMemoryDsn})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mysql.Migrate(db)).To(Succeed())
+		return mysql.NewStore(db)
+	}
+
+	test_store.ExecuteStoreTests(createStore, "mysql")
+	test_store.ExecuteOwnerTests(createStore, "mysql")
+})
@@ -20,6 +20,7 @@ package traditional
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 )
 
@@ -205,6 +206,17 @@ func (t *traditionalStore) Create(_ context.Context, resource core_model.Resourc
 			return err
 		}
 
+		err = t.governance.SetConfig(path, string(bytes))
+		if err != nil {
+			return err
+		}
+	case mesh.AuthenticationPolicyType, mesh.AuthorizationPolicyType:
+		path := getPolicyPath(resource.Descriptor().Name, name)
+		bytes, err := core_model.ToYAML(resource.GetSpec())
+		if err != nil {
+			return err
+		}
+
 		err = t.governance.SetConfig(path, string(bytes))
 		if err != nil {
 			return err
@@ -350,6 +362,24 @@ func (t *traditionalStore) Update(ctx context.Context, resource core_model.Resou
 				return err
 			}
 		}
+	case mesh.AuthenticationPolicyType, mesh.AuthorizationPolicyType:
+		path := getPolicyPath(resource.Descriptor().Name, name)
+		cfg, err := t.governance.GetConfig(path)
+		if err != nil {
+			return err
+		}
+		if cfg == "" {
+			return store.ErrorResourceNotFound(resource.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+
+		bytes, err := core_model.ToYAML(resource.GetSpec())
+		if err != nil {
+			return err
+		}
+		err = t.governance.SetConfig(path, string(bytes))
+		if err != nil {
+			return err
+		}
 	case mesh.MappingType:
 		spec := resource.GetSpec()
 		mapping := spec.(*mesh_proto.Mapping)
@@ -471,7 +501,7 @@ func (t *traditionalStore) Delete(ctx context.Context, resource core_model.Resou
 			ServiceGroup:   labels[mesh_proto.ServiceGroup],
 		}
 		key := mesh_proto.BuildServiceKey(base)
-		path := mesh_proto.GetOverridePath(key)
+		path := mesh_proto.GetRoutePath(key, consts.TagRoute)
 		err := t.governance.DeleteConfig(path)
 		if err != nil {
 			return err
@@ -540,6 +570,12 @@ func (t *traditionalStore) Delete(ctx context.Context, resource core_model.Resou
 				return err
 			}
 		}
+	case mesh.AuthenticationPolicyType, mesh.AuthorizationPolicyType:
+		path := getPolicyPath(resource.Descriptor().Name, name)
+		err := t.governance.DeleteConfig(path)
+		if err != nil {
+			return err
+		}
 	case mesh.MappingType:
 		// 无法删除
 	case mesh.MetaDataType:
@@ -606,10 +642,11 @@ func (c *traditionalStore) Get(_ context.Context, resource core_model.Resource,
 		if err != nil {
 			return err
 		}
-		if cfg != "" {
-			if err := core_model.FromYAML([]byte(cfg), resource.GetSpec()); err != nil {
-				return errors.Wrap(err, "failed to convert json to spec")
-			}
+		if cfg == "" {
+			return store.ErrorResourceNotFound(resource.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+		if err := core_model.FromYAML([]byte(cfg), resource.GetSpec()); err != nil {
+			return errors.Wrap(err, "failed to convert json to spec")
 		}
 		resource.SetMeta(&resourceMetaObject{
 			Name: name,
@@ -630,10 +667,11 @@ func (c *traditionalStore) Get(_ context.Context, resource core_model.Resource,
 		if err != nil {
 			return err
 		}
-		if cfg != "" {
-			if err := core_model.FromYAML([]byte(cfg), resource.GetSpec()); err != nil {
-				return errors.Wrap(err, "failed to convert json to spec")
-			}
+		if cfg == "" {
+			return store.ErrorResourceNotFound(resource.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+		if err := core_model.FromYAML([]byte(cfg), resource.GetSpec()); err != nil {
+			return errors.Wrap(err, "failed to convert json to spec")
 		}
 		resource.SetMeta(&resourceMetaObject{
 			Name: name,
@@ -654,10 +692,27 @@ func (c *traditionalStore) Get(_ context.Context, resource core_model.Resource,
 		if err != nil {
 			return err
 		}
-		if cfg != "" {
-			if err := core_model.FromYAML([]byte(cfg), resource.GetSpec()); err != nil {
-				return errors.Wrap(err, "failed to convert json to spec")
-			}
+		if cfg == "" {
+			return store.ErrorResourceNotFound(resource.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+		if err := core_model.FromYAML([]byte(cfg), resource.GetSpec()); err != nil {
+			return errors.Wrap(err, "failed to convert json to spec")
+		}
+		resource.SetMeta(&resourceMetaObject{
+			Name: name,
+			Mesh: opts.Mesh,
+		})
+	case mesh.AuthenticationPolicyType, mesh.AuthorizationPolicyType:
+		path := getPolicyPath(resource.Descriptor().Name, name)
+		cfg, err := c.governance.GetConfig(path)
+		if err != nil {
+			return err
+		}
+		if cfg == "" {
+			return store.ErrorResourceNotFound(resource.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+		if err := core_model.FromYAML([]byte(cfg), resource.GetSpec()); err != nil {
+			return errors.Wrap(err, "failed to convert json to spec")
 		}
 		resource.SetMeta(&resourceMetaObject{
 			Name: name,
@@ -844,12 +899,14 @@ func (c *traditionalStore) List(_ context.Context, resources core_model.Resource
 			}
 		}
 
-	case mesh.DynamicConfigType:
-		// 不支持List
 	case mesh.TagRouteType:
-		// 不支持List
+		return c.listRules(resources, opts, consts.TagRuleSuffix)
 	case mesh.ConditionRouteType:
-		// 不支持List
+		return c.listRules(resources, opts, consts.ConditionRuleSuffix)
+	case mesh.DynamicConfigType:
+		return c.listRules(resources, opts, consts.ConfiguratorRuleSuffix)
+	case mesh.AuthenticationPolicyType, mesh.AuthorizationPolicyType:
+		return c.listRules(resources, opts, getPolicySuffix(resources.GetItemType()))
 	default:
 		rootDir := getDubboCpPath(string(resources.GetItemType()))
 		names, err := c.regClient.GetChildren(rootDir)
@@ -878,3 +935,45 @@ func (c *traditionalStore) List(_ context.Context, resources core_model.Resource
 	}
 	return nil
 }
+
+// listRules 遍历配置中心dubbo分组下的所有key, 通过后缀筛选出对应类型的流量规则
+func (c *traditionalStore) listRules(resources core_model.ResourceList, opts *store.ListOptions, suffix string) error {
+	keys, err := c.configCenter.GetConfigKeysByGroup(dubboGroup)
+	if err != nil {
+		// 分组下还没有任何配置时同样会返回错误, 视为没有规则
+		logger.Sugar().Warnf("failed to get config keys of group %s: %s", dubboGroup, err.Error())
+		return nil
+	}
+	meshName := opts.Mesh
+	if meshName == "" {
+		meshName = core_model.DefaultMesh
+	}
+	for _, key := range keys.Values() {
+		key := key.(string)
+		if !strings.HasSuffix(key, suffix) {
+			continue
+		}
+		cfg, err := c.governance.GetConfig(key)
+		if err != nil {
+			return err
+		}
+		if cfg == "" {
+			continue
+		}
+		item := resources.NewItem()
+		if err := core_model.FromYAML([]byte(cfg), item.GetSpec()); err != nil {
+			return errors.Wrapf(err, "failed to convert rule %s to spec", key)
+		}
+		item.SetMeta(&resourceMetaObject{
+			Name: key,
+			Mesh: meshName,
+		})
+		if !opts.Filter(item) {
+			continue
+		}
+		if err := resources.AddItem(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -22,6 +22,12 @@ import (
 	"strings"
 )
 
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
 func GenerateCpGroupPath(resourceName string, name string) string {
 	return pathSeparator + cpGroup + pathSeparator + resourceName + pathSeparator + name
 }
@@ -74,3 +80,15 @@ func splitAppAndRevision(name string) (app string, revision string) {
 	app = strings.Replace(name, "-"+split[n-1], "", -1)
 	return app, split[n-1]
 }
+
+// getPolicyPath 认证鉴权策略与流量规则一样保存在配置中心, 通过后缀区分类型
+func getPolicyPath(resourceType core_model.ResourceType, name string) string {
+	return name + getPolicySuffix(resourceType)
+}
+
+func getPolicySuffix(resourceType core_model.ResourceType) string {
+	if resourceType == mesh.AuthenticationPolicyType {
+		return consts.AuthenticationPolicySuffix
+	}
+	return consts.AuthorizationPolicySuffix
+}
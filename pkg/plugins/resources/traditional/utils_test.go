@@ -21,6 +21,10 @@ import (
 	"testing"
 )
 
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+)
+
 func TestSplitAppAndRevision(t *testing.T) {
 	name := "dubbo-springboot-demo-lixinyang-bdc0958191bba7a0f050a32709ee1111"
 	app, revision := splitAppAndRevision(name)
@@ -28,3 +32,12 @@ func TestSplitAppAndRevision(t *testing.T) {
 		t.Error("解析错误")
 	}
 }
+
+func TestGetPolicyPath(t *testing.T) {
+	if path := getPolicyPath(mesh.AuthenticationPolicyType, "demo"); path != "demo.authentication" {
+		t.Errorf("unexpected authentication policy path %s", path)
+	}
+	if path := getPolicyPath(mesh.AuthorizationPolicyType, "demo"); path != "demo.authorization" {
+		t.Errorf("unexpected authorization policy path %s", path)
+	}
+}
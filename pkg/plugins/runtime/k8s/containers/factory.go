@@ -19,12 +19,16 @@ package containers
 
 import (
 	"sort"
+	"strconv"
 	"time"
 )
 
 import (
+	"github.com/pkg/errors"
+
 	kube_core "k8s.io/api/core/v1"
 
+	kube_api "k8s.io/apimachinery/pkg/api/resource"
 	kube_intstr "k8s.io/apimachinery/pkg/util/intstr"
 
 	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +47,9 @@ func (a EnvVarsByName) Less(i, j int) bool {
 	return a[i].Name < a[j].Name
 }
 
+// SidecarContainerName is the name of the Dubbo sidecar container injected into Pods.
+const SidecarContainerName = "dubbo-sidecar"
+
 type DataplaneProxyFactory struct {
 	ControlPlaneURL    string
 	ControlPlaneCACert string
@@ -66,10 +73,49 @@ func NewDataplaneProxyFactory(
 }
 
 func (i *DataplaneProxyFactory) envoyAdminPort(annotations map[string]string) (uint32, error) {
-	adminPort, _, err := metadata.Annotations(annotations).GetUint32(metadata.DubboIngressAnnotation)
+	adminPort, _, err := metadata.Annotations(annotations).GetUint32(metadata.DubboEnvoyAdminPort)
 	return adminPort, err
 }
 
+func (i *DataplaneProxyFactory) sidecarImage(annotations map[string]string) string {
+	image, _ := metadata.Annotations(annotations).GetStringWithDefault(i.ContainerConfig.Image, metadata.DubboSidecarContainerImageAnnotation)
+	return image
+}
+
+func (i *DataplaneProxyFactory) sidecarResources() (kube_core.ResourceRequirements, error) {
+	resources := kube_core.ResourceRequirements{
+		Requests: kube_core.ResourceList{},
+		Limits:   kube_core.ResourceList{},
+	}
+	for name, quantity := range map[kube_core.ResourceName]string{
+		kube_core.ResourceCPU:    i.ContainerConfig.Resources.Requests.CPU,
+		kube_core.ResourceMemory: i.ContainerConfig.Resources.Requests.Memory,
+	} {
+		if quantity == "" {
+			continue
+		}
+		q, err := kube_api.ParseQuantity(quantity)
+		if err != nil {
+			return kube_core.ResourceRequirements{}, errors.Wrapf(err, "invalid sidecar %s request", name)
+		}
+		resources.Requests[name] = q
+	}
+	for name, quantity := range map[kube_core.ResourceName]string{
+		kube_core.ResourceCPU:    i.ContainerConfig.Resources.Limits.CPU,
+		kube_core.ResourceMemory: i.ContainerConfig.Resources.Limits.Memory,
+	} {
+		if quantity == "" {
+			continue
+		}
+		q, err := kube_api.ParseQuantity(quantity)
+		if err != nil {
+			return kube_core.ResourceRequirements{}, errors.Wrapf(err, "invalid sidecar %s limit", name)
+		}
+		resources.Limits[name] = q
+	}
+	return resources, nil
+}
+
 func (i *DataplaneProxyFactory) drainTime(annotations map[string]string) (time.Duration, error) {
 	r, _, err := metadata.Annotations(annotations).GetDurationWithDefault(i.ContainerConfig.DrainTime.Duration, metadata.DubboSidecarDrainTime)
 	return r, err
@@ -131,6 +177,27 @@ func (i *DataplaneProxyFactory) sidecarEnvVars(mesh string, podAnnotations map[s
 		},
 	}
 
+	builtinDNS, exists, err := metadata.Annotations(podAnnotations).GetEnabled(metadata.DubboBuiltinDNS)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		envVars["DUBBO_DNS_ENABLED"] = kube_core.EnvVar{
+			Name:  "DUBBO_DNS_ENABLED",
+			Value: strconv.FormatBool(builtinDNS),
+		}
+	}
+	if builtinDNS {
+		dnsPort, _, err := metadata.Annotations(podAnnotations).GetUint32(metadata.DubboBuiltinDNSPort)
+		if err != nil {
+			return nil, err
+		}
+		envVars["DUBBO_DNS_ENVOY_DNS_PORT"] = kube_core.EnvVar{
+			Name:  "DUBBO_DNS_ENVOY_DNS_PORT",
+			Value: strconv.FormatUint(uint64(dnsPort), 10),
+		}
+	}
+
 	// override defaults with cfg env vars
 	for envName, envVal := range i.ContainerConfig.EnvVars {
 		envVars[envName] = kube_core.EnvVar{
@@ -179,8 +246,30 @@ func (i *DataplaneProxyFactory) NewContainer(
 		adminPort = i.DefaultAdminPort
 	}
 
+	resources, err := i.sidecarResources()
+	if err != nil {
+		return kube_core.Container{}, err
+	}
+
+	uid := i.ContainerConfig.UID
+	gid := i.ContainerConfig.GID
+
 	container := kube_core.Container{
+		Name:  SidecarContainerName,
+		Image: i.sidecarImage(annnotations),
+		Args: []string{
+			"proxy",
+			"--proxy-type=dataplane",
+			"--cp-address=$(DUBBO_CONTROL_PLANE_URL)",
+			"--name=$(POD_NAME).$(POD_NAMESPACE)",
+			"--mesh=$(DUBBO_DATAPLANE_MESH)",
+		},
 		Env: env,
+		SecurityContext: &kube_core.SecurityContext{
+			RunAsUser:  &uid,
+			RunAsGroup: &gid,
+		},
+		Resources: resources,
 		LivenessProbe: &kube_core.Probe{
 			ProbeHandler: kube_core.ProbeHandler{
 				HTTPGet: &kube_core.HTTPGetAction{
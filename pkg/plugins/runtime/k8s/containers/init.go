@@ -0,0 +1,158 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package containers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	kube_core "k8s.io/api/core/v1"
+
+	kube_api "k8s.io/apimachinery/pkg/api/resource"
+
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+import (
+	runtime_k8s "github.com/apache/dubbo-kubernetes/pkg/config/plugins/runtime/k8s"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/metadata"
+)
+
+// InitContainerName is the name of the init container that redirects the traffic of a Pod to the sidecar.
+const InitContainerName = "dubbo-init"
+
+type InitContainerFactory struct {
+	Image      string
+	SidecarUID int64
+	Traffic    runtime_k8s.SidecarTraffic
+}
+
+func NewInitContainerFactory(image string, sidecarUID int64, traffic runtime_k8s.SidecarTraffic) *InitContainerFactory {
+	return &InitContainerFactory{
+		Image:      image,
+		SidecarUID: sidecarUID,
+		Traffic:    traffic,
+	}
+}
+
+// NewContainer builds the init container that installs the transparent proxy in the network namespace of the Pod.
+// Ports excluded by the traffic.dubbo.io annotations take precedence over the configured ones,
+// additionalExcludedInboundPorts are always excluded from interception.
+func (i *InitContainerFactory) NewContainer(owner kube_client.Object, additionalExcludedInboundPorts ...uint32) (kube_core.Container, error) {
+	annotations := metadata.Annotations(owner.GetAnnotations())
+
+	excludeInbound, err := excludedPorts(annotations, metadata.DubboTrafficExcludeInboundPorts, i.Traffic.ExcludeInboundPorts)
+	if err != nil {
+		return kube_core.Container{}, err
+	}
+	excludeInbound = append(excludeInbound, additionalExcludedInboundPorts...)
+	excludeOutbound, err := excludedPorts(annotations, metadata.DubboTrafficExcludeOutboundPorts, i.Traffic.ExcludeOutboundPorts)
+	if err != nil {
+		return kube_core.Container{}, err
+	}
+
+	args := []string{
+		"install",
+		"transparent-proxy",
+		fmt.Sprintf("--redirect-inbound-port=%d", i.Traffic.RedirectPortInbound),
+		fmt.Sprintf("--redirect-outbound-port=%d", i.Traffic.RedirectPortOutbound),
+		fmt.Sprintf("--dubbo-dp-uid=%d", i.SidecarUID),
+	}
+	if len(excludeInbound) > 0 {
+		args = append(args, "--exclude-inbound-ports="+joinPorts(excludeInbound))
+	}
+	if len(excludeOutbound) > 0 {
+		args = append(args, "--exclude-outbound-ports="+joinPorts(excludeOutbound))
+	}
+	builtinDNS, _, err := annotations.GetEnabled(metadata.DubboBuiltinDNS)
+	if err != nil {
+		return kube_core.Container{}, err
+	}
+	if builtinDNS {
+		dnsPort, _, err := annotations.GetUint32(metadata.DubboBuiltinDNSPort)
+		if err != nil {
+			return kube_core.Container{}, err
+		}
+		args = append(args, "--redirect-dns", fmt.Sprintf("--redirect-dns-port=%d", dnsPort))
+	}
+
+	image, _ := annotations.GetStringWithDefault(i.Image, metadata.DubboInitContainerImageAnnotation)
+	root := int64(0)
+	return kube_core.Container{
+		Name:  InitContainerName,
+		Image: image,
+		Args:  args,
+		SecurityContext: &kube_core.SecurityContext{
+			RunAsUser:  &root,
+			RunAsGroup: &root,
+			Capabilities: &kube_core.Capabilities{
+				Add: []kube_core.Capability{
+					"NET_ADMIN",
+					"NET_RAW",
+				},
+				Drop: []kube_core.Capability{
+					"ALL",
+				},
+			},
+		},
+		Resources: kube_core.ResourceRequirements{
+			Requests: kube_core.ResourceList{
+				kube_core.ResourceCPU:    kube_api.MustParse("20m"),
+				kube_core.ResourceMemory: kube_api.MustParse("20Mi"),
+			},
+			Limits: kube_core.ResourceList{
+				kube_core.ResourceCPU:    kube_api.MustParse("100m"),
+				kube_core.ResourceMemory: kube_api.MustParse("50Mi"),
+			},
+		},
+	}, nil
+}
+
+func excludedPorts(annotations metadata.Annotations, key string, defaults []uint32) ([]uint32, error) {
+	values, exist := annotations.GetList(key)
+	if !exist {
+		return append([]uint32{}, defaults...), nil
+	}
+	var ports []uint32
+	for _, value := range values {
+		port, err := strconv.ParseUint(strings.TrimSpace(value), 10, 16)
+		if err != nil {
+			return nil, errors.Errorf("annotation %q has invalid port %q", key, value)
+		}
+		ports = append(ports, uint32(port))
+	}
+	return ports, nil
+}
+
+func joinPorts(ports []uint32) string {
+	sorted := append([]uint32{}, ports...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	var values []string
+	for _, port := range sorted {
+		values = append(values, strconv.FormatUint(uint64(port), 10))
+	}
+	return strings.Join(values, ",")
+}
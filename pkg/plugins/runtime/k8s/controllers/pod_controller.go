@@ -113,6 +113,15 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req kube_ctrl.Request) (k
 		return kube_ctrl.Result{}, r.reconcileDataplane(ctx, pod, log)
 	}
 
+	// for Pods with the sidecar injected by the injector webhook the Dataplane is generated as well
+	injected, _, err := metadata.Annotations(pod.Annotations).GetEnabled(metadata.DubboSidecarInjectedAnnotation)
+	if err != nil {
+		return kube_ctrl.Result{}, err
+	}
+	if injected {
+		return kube_ctrl.Result{}, r.reconcileDataplane(ctx, pod, log)
+	}
+
 	return kube_ctrl.Result{}, nil
 }
 
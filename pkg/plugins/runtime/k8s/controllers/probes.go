@@ -18,13 +18,58 @@
 package controllers
 
 import (
+	"github.com/pkg/errors"
+
 	kube_core "k8s.io/api/core/v1"
 )
 
 import (
 	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/metadata"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/probes"
 )
 
+// ProbesFor collects virtual probes of the Pod's containers that were rewritten by the injector,
+// so the sidecar can expose them on the virtual probes port.
 func ProbesFor(pod *kube_core.Pod) (*mesh_proto.Dataplane_Probes, error) {
-	return &mesh_proto.Dataplane_Probes{}, nil
+	annotations := metadata.Annotations(pod.Annotations)
+	enabled, _, err := annotations.GetEnabled(metadata.DubboVirtualProbesAnnotation)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, nil
+	}
+	port, exist, err := annotations.GetUint32(metadata.DubboVirtualProbesPortAnnotation)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.Errorf("%s annotation doesn't exist", metadata.DubboVirtualProbesPortAnnotation)
+	}
+
+	dpProbes := &mesh_proto.Dataplane_Probes{
+		Port: port,
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, probe := range []*kube_core.Probe{c.LivenessProbe, c.ReadinessProbe, c.StartupProbe} {
+			if probe == nil || probe.HTTPGet == nil {
+				continue
+			}
+			virtual := probes.DubboProbe(*probe)
+			if virtual.Port() != port {
+				continue
+			}
+			application, err := virtual.ToReal(port)
+			if err != nil {
+				return nil, errors.Wrapf(err, "container %q has invalid virtual probe", c.Name)
+			}
+			dpProbes.Endpoints = append(dpProbes.Endpoints, &mesh_proto.Dataplane_Probes_Endpoint{
+				InboundPort: application.Port(),
+				InboundPath: application.Path(),
+				Path:        virtual.Path(),
+			})
+		}
+	}
+	return dpProbes, nil
 }
@@ -60,11 +60,38 @@ const (
 	// DubboIngressPublicPortAnnotation allows to pick public port for Ingress
 	// If not defined, Dubbo will try to pick this address from the Ingress Service
 	DubboIngressPublicPortAnnotation = "dubbo.io/ingress-public-port"
+
+	// DubboVirtualProbesAnnotation enables automatic converting HttpGet probes to virtual probes.
+	// Proxy will expose a virtual probes port and application probes are rewritten to go through it.
+	DubboVirtualProbesAnnotation = "dubbo.io/virtual-probes"
+
+	// DubboVirtualProbesPortAnnotation is a port on which the virtual probes are exposed.
+	DubboVirtualProbesPortAnnotation = "dubbo.io/virtual-probes-port"
+
+	// DubboBuiltinDNS enables the builtin DNS of the sidecar, the DNS traffic of the Pod is redirected to it.
+	DubboBuiltinDNS = "dubbo.io/builtin-dns"
+
+	// DubboBuiltinDNSPort is a port on which the builtin DNS of the sidecar is served.
+	DubboBuiltinDNSPort = "dubbo.io/builtin-dns-port"
+
+	// DubboSidecarContainerImageAnnotation allows to override the image of the injected sidecar.
+	DubboSidecarContainerImageAnnotation = "dubbo.io/sidecar-container-image"
+
+	// DubboInitContainerImageAnnotation allows to override the image of the injected init container.
+	DubboInitContainerImageAnnotation = "dubbo.io/init-container-image"
+
+	// DubboTrafficExcludeInboundPorts is a comma separated list of inbound ports that won't be intercepted by the sidecar.
+	DubboTrafficExcludeInboundPorts = "traffic.dubbo.io/exclude-inbound-ports"
+
+	// DubboTrafficExcludeOutboundPorts is a comma separated list of outbound ports that won't be intercepted by the sidecar.
+	DubboTrafficExcludeOutboundPorts = "traffic.dubbo.io/exclude-outbound-ports"
 )
 
-// Annotations that are being automatically set by the Dubbo SDK.
+// Annotations that are being automatically set by the Dubbo SDK or the sidecar injector.
 const (
-	DubboEnvoyAdminPort            = "dubbo.io/envoy-admin-port"
+	DubboEnvoyAdminPort = "dubbo.io/envoy-admin-port"
+	// DubboSidecarInjectedAnnotation marks Pods that have the Dubbo sidecar injected.
+	// The Pod controller generates a Dataplane for every Pod with this annotation.
 	DubboSidecarInjectedAnnotation = "dubbo.io/sidecar-injected"
 )
 
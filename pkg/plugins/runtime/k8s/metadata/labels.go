@@ -22,4 +22,10 @@ const (
 	// with a particular Mesh.
 	// Label value must be the name of a Mesh resource.
 	DubboMeshLabel = "dubbo.io/mesh"
+
+	// DubboSidecarInjectionLabel defines a Namespace or Pod label that enables
+	// or disables injection of the Dubbo sidecar. A label on a Pod takes precedence
+	// over the one on its Namespace.
+	// Label value must be either "enabled" or "disabled".
+	DubboSidecarInjectionLabel = "dubbo.io/sidecar-injection"
 )
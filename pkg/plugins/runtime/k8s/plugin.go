@@ -17,6 +17,10 @@
 
 package k8s
 
+import (
+	"fmt"
+)
+
 import (
 	"github.com/pkg/errors"
 
@@ -37,6 +41,7 @@ import (
 	k8s_registry "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/k8s/native/pkg/registry"
 	k8s_controllers "github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/controllers"
 	k8s_webhooks "github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/webhooks"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/webhooks/injector"
 )
 
 var log = core.Log.WithName("plugin").WithName("runtime").WithName("k8s")
@@ -78,6 +83,10 @@ func (p *plugin) Customize(rt core_runtime.Runtime) error {
 	//	return err
 	//}
 
+	if err := addInjector(mgr, rt); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -151,3 +160,16 @@ func addMutators(mgr kube_ctrl.Manager, rt core_runtime.Runtime, converter k8s_c
 	mgr.GetWebhookServer().Register("/default-dubbo-io-v1alpha1-mesh", defaultMutator)
 	return nil
 }
+
+// addInjector registers the webhook that injects the sidecar and the init containers into the pods.
+// It is served on its own, the other mutators are not enabled with it.
+func addInjector(mgr kube_ctrl.Manager, rt core_runtime.Runtime) error {
+	k8sConfig := rt.Config().Runtime.Kubernetes
+	controlPlaneURL := fmt.Sprintf("https://%s.%s:%d", k8sConfig.ControlPlaneServiceName, rt.Config().Store.Kubernetes.SystemNamespace, rt.Config().DpServer.Port)
+	dubboInjector, err := injector.New(k8sConfig.Injector, controlPlaneURL, mgr.GetClient())
+	if err != nil {
+		return err
+	}
+	mgr.GetWebhookServer().Register("/inject-sidecar", k8s_webhooks.PodMutatingWebhook(dubboInjector.InjectDubbo))
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package probes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	kube_core "k8s.io/api/core/v1"
+
+	kube_intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// DubboProbe is a Kubernetes probe that can be converted to and from a virtual probe.
+// A virtual probe is an HttpGet probe that targets the virtual probes port of the
+// sidecar with the path prefixed by the application port, i.e. GET /8080/healthz.
+// The sidecar exposes the virtual probes port without mTLS and forwards the request
+// to the application, so probes keep working when mTLS is enabled in the Mesh.
+type DubboProbe kube_core.Probe
+
+// ToVirtual rewrites an application HttpGet probe into a virtual probe exposed on virtualPort.
+func (p DubboProbe) ToVirtual(virtualPort uint32) (DubboProbe, error) {
+	if p.HTTPGet == nil {
+		return DubboProbe{}, errors.New("only HttpGet probes can be converted to virtual probes")
+	}
+	if p.HTTPGet.Port.Type != kube_intstr.Int {
+		return DubboProbe{}, errors.Errorf("named port %q has to be resolved before conversion", p.HTTPGet.Port.StrVal)
+	}
+	if p.Port() == virtualPort {
+		return DubboProbe{}, errors.Errorf("cannot override Pod's probes. Port for probe cannot be set to %d. It is reserved for the virtual probes port", virtualPort)
+	}
+
+	virtual := kube_core.Probe(p)
+	httpGet := *p.HTTPGet
+	httpGet.Port = kube_intstr.FromInt32(int32(virtualPort))
+	httpGet.Path = fmt.Sprintf("/%d%s", p.Port(), p.Path())
+	virtual.HTTPGet = &httpGet
+	return DubboProbe(virtual), nil
+}
+
+// ToReal recovers the application probe from a virtual probe exposed on virtualPort.
+func (p DubboProbe) ToReal(virtualPort uint32) (DubboProbe, error) {
+	if p.HTTPGet == nil {
+		return DubboProbe{}, errors.New("virtual probe has to be an HttpGet probe")
+	}
+	if p.Port() != virtualPort {
+		return DubboProbe{}, errors.Errorf("probe on port %d is not a virtual probe", p.Port())
+	}
+
+	segments := strings.SplitN(strings.TrimPrefix(p.Path(), "/"), "/", 2)
+	port, err := strconv.ParseUint(segments[0], 10, 32)
+	if err != nil {
+		return DubboProbe{}, errors.Errorf("path %q of the virtual probe has to start with the port of the application", p.Path())
+	}
+	path := "/"
+	if len(segments) == 2 {
+		path += segments[1]
+	}
+
+	application := kube_core.Probe(p)
+	httpGet := *p.HTTPGet
+	httpGet.Port = kube_intstr.FromInt32(int32(port))
+	httpGet.Path = path
+	application.HTTPGet = &httpGet
+	return DubboProbe(application), nil
+}
+
+// Port returns the port of an HttpGet probe.
+func (p DubboProbe) Port() uint32 {
+	return uint32(p.HTTPGet.Port.IntValue())
+}
+
+// Path returns the path of an HttpGet probe, always starting with a slash.
+func (p DubboProbe) Path() string {
+	if !strings.HasPrefix(p.HTTPGet.Path, "/") {
+		return "/" + p.HTTPGet.Path
+	}
+	return p.HTTPGet.Path
+}
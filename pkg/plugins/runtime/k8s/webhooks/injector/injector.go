@@ -0,0 +1,259 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package injector
+
+import (
+	"context"
+	"os"
+	"strconv"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	kube_core "k8s.io/api/core/v1"
+
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_intstr "k8s.io/apimachinery/pkg/util/intstr"
+
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+import (
+	runtime_k8s "github.com/apache/dubbo-kubernetes/pkg/config/plugins/runtime/k8s"
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/containers"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/metadata"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/probes"
+	util_k8s "github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/util"
+)
+
+var log = core.Log.WithName("injector")
+
+// DubboInjector injects the Dubbo sidecar and init containers into Pods
+// that opted in with the dubbo.io/sidecar-injection label.
+type DubboInjector struct {
+	cfg          runtime_k8s.Injector
+	client       kube_client.Client
+	proxyFactory *containers.DataplaneProxyFactory
+	initFactory  *containers.InitContainerFactory
+}
+
+func New(
+	cfg runtime_k8s.Injector,
+	controlPlaneURL string,
+	client kube_client.Client,
+) (*DubboInjector, error) {
+	var caCert string
+	if cfg.CaCertFile != "" {
+		bytes, err := os.ReadFile(cfg.CaCertFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read provided CA cert file %s", cfg.CaCertFile)
+		}
+		caCert = string(bytes)
+	}
+	return &DubboInjector{
+		cfg:          cfg,
+		client:       client,
+		proxyFactory: containers.NewDataplaneProxyFactory(controlPlaneURL, caCert, cfg.SidecarContainer.AdminPort, cfg.SidecarContainer, false),
+		initFactory:  containers.NewInitContainerFactory(cfg.InitContainer.Image, cfg.SidecarContainer.UID, cfg.SidecarTraffic),
+	}, nil
+}
+
+func (i *DubboInjector) InjectDubbo(ctx context.Context, pod *kube_core.Pod) error {
+	logger := log.WithValues("pod", pod.GenerateName, "namespace", pod.Namespace)
+
+	ns := &kube_core.Namespace{}
+	if err := i.client.Get(ctx, kube_types.NamespacedName{Name: pod.Namespace}, ns); err != nil {
+		return errors.Wrap(err, "could not retrieve namespace for pod")
+	}
+
+	inject, err := i.needToInject(pod, ns)
+	if err != nil {
+		return err
+	}
+	if !inject {
+		logger.V(1).Info("skipping Dubbo injection")
+		return nil
+	}
+
+	mesh := util_k8s.MeshOfByAnnotation(pod, ns)
+	logger = logger.WithValues("mesh", mesh)
+	logger.Info("injecting Dubbo")
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+
+	var excludedInboundPorts []uint32
+	virtualProbesPort, enabled, err := i.overrideHTTPProbes(pod)
+	if err != nil {
+		return err
+	}
+	if enabled {
+		// kubelet has to reach the virtual probes listener of the sidecar directly
+		excludedInboundPorts = append(excludedInboundPorts, virtualProbesPort)
+	}
+
+	if err := i.resolveBuiltinDNS(pod); err != nil {
+		return err
+	}
+
+	sidecar, err := i.proxyFactory.NewContainer(pod, mesh)
+	if err != nil {
+		return errors.Wrap(err, "could not generate sidecar container")
+	}
+	initContainer, err := i.initFactory.NewContainer(pod, excludedInboundPorts...)
+	if err != nil {
+		return errors.Wrap(err, "could not generate init container")
+	}
+
+	// init containers are run in order, the traffic of the ones defined by the user is not redirected yet
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, initContainer)
+	pod.Spec.Containers = append(pod.Spec.Containers, sidecar)
+
+	pod.Annotations[metadata.DubboSidecarInjectedAnnotation] = metadata.AnnotationTrue
+	pod.Annotations[metadata.DubboMeshAnnotation] = mesh
+	if _, exist := pod.Annotations[metadata.DubboEnvoyAdminPort]; !exist && i.cfg.SidecarContainer.AdminPort != 0 {
+		pod.Annotations[metadata.DubboEnvoyAdminPort] = strconv.FormatUint(uint64(i.cfg.SidecarContainer.AdminPort), 10)
+	}
+	return nil
+}
+
+func (i *DubboInjector) needToInject(pod *kube_core.Pod, ns *kube_core.Namespace) (bool, error) {
+	// Zone Ingress and Zone Egress run the proxy on their own
+	for _, annotation := range []string{metadata.DubboIngressAnnotation, metadata.DubboEgressAnnotation} {
+		enabled, _, err := metadata.Annotations(pod.Annotations).GetEnabled(annotation)
+		if err != nil {
+			return false, err
+		}
+		if enabled {
+			return false, nil
+		}
+	}
+
+	injected, _, err := metadata.Annotations(pod.Annotations).GetEnabled(metadata.DubboSidecarInjectedAnnotation)
+	if err != nil {
+		return false, err
+	}
+	if injected {
+		return false, nil
+	}
+	for _, c := range pod.Spec.Containers {
+		if c.Name == containers.SidecarContainerName {
+			return false, nil
+		}
+	}
+
+	// redirecting the traffic would affect the whole node
+	if pod.Spec.HostNetwork {
+		return false, nil
+	}
+
+	// the label on the Pod takes precedence over the label on the Namespace
+	enabled, exist, err := metadata.Annotations(pod.GetLabels()).GetEnabled(metadata.DubboSidecarInjectionLabel)
+	if err != nil {
+		return false, err
+	}
+	if exist {
+		return enabled, nil
+	}
+	enabled, _, err = metadata.Annotations(ns.GetLabels()).GetEnabled(metadata.DubboSidecarInjectionLabel)
+	if err != nil {
+		return false, err
+	}
+	return enabled, nil
+}
+
+// overrideHTTPProbes rewrites HttpGet probes of the application containers to virtual probes,
+// so they go through the virtual probes port of the sidecar, and records the result in the annotations
+// read by the Pod controller. It returns the virtual probes port and whether virtual probes are enabled.
+func (i *DubboInjector) overrideHTTPProbes(pod *kube_core.Pod) (uint32, bool, error) {
+	annotations := metadata.Annotations(pod.Annotations)
+	enabled, _, err := annotations.GetEnabledWithDefault(i.cfg.VirtualProbesEnabled, metadata.DubboVirtualProbesAnnotation)
+	if err != nil {
+		return 0, false, err
+	}
+	if !enabled {
+		pod.Annotations[metadata.DubboVirtualProbesAnnotation] = metadata.AnnotationDisabled
+		return 0, false, nil
+	}
+	port, _, err := annotations.GetUint32WithDefault(i.cfg.VirtualProbesPort, metadata.DubboVirtualProbesPortAnnotation)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for idx := range pod.Spec.Containers {
+		c := &pod.Spec.Containers[idx]
+		for _, probe := range []*kube_core.Probe{c.LivenessProbe, c.ReadinessProbe, c.StartupProbe} {
+			if probe == nil || probe.HTTPGet == nil {
+				continue
+			}
+			// the sidecar exposes virtual probes over plain HTTP only
+			if probe.HTTPGet.Scheme == kube_core.URISchemeHTTPS {
+				continue
+			}
+			if probe.HTTPGet.Port.Type == kube_intstr.String {
+				containerPort, found := namedPort(c, probe.HTTPGet.Port.StrVal)
+				if !found {
+					return 0, false, errors.Errorf("container %q has a probe on unknown named port %q", c.Name, probe.HTTPGet.Port.StrVal)
+				}
+				probe.HTTPGet.Port = kube_intstr.FromInt32(containerPort)
+			}
+			virtual, err := probes.DubboProbe(*probe).ToVirtual(port)
+			if err != nil {
+				return 0, false, errors.Wrapf(err, "could not convert probe of container %q", c.Name)
+			}
+			*probe = kube_core.Probe(virtual)
+		}
+	}
+
+	pod.Annotations[metadata.DubboVirtualProbesAnnotation] = metadata.AnnotationEnabled
+	pod.Annotations[metadata.DubboVirtualProbesPortAnnotation] = strconv.FormatUint(uint64(port), 10)
+	return port, true, nil
+}
+
+// resolveBuiltinDNS records in the annotations whether the sidecar serves DNS and on which port,
+// so the containers are generated consistently.
+func (i *DubboInjector) resolveBuiltinDNS(pod *kube_core.Pod) error {
+	annotations := metadata.Annotations(pod.Annotations)
+	enabled, _, err := annotations.GetEnabledWithDefault(i.cfg.BuiltinDNS.Enabled, metadata.DubboBuiltinDNS)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		pod.Annotations[metadata.DubboBuiltinDNS] = metadata.AnnotationDisabled
+		return nil
+	}
+	port, _, err := annotations.GetUint32WithDefault(i.cfg.BuiltinDNS.Port, metadata.DubboBuiltinDNSPort)
+	if err != nil {
+		return err
+	}
+	pod.Annotations[metadata.DubboBuiltinDNS] = metadata.AnnotationEnabled
+	pod.Annotations[metadata.DubboBuiltinDNSPort] = strconv.FormatUint(uint64(port), 10)
+	return nil
+}
+
+func namedPort(container *kube_core.Container, name string) (int32, bool) {
+	for _, port := range container.Ports {
+		if port.Name == name {
+			return port.ContainerPort, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,215 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package injector_test
+
+import (
+	"context"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	kube_core "k8s.io/api/core/v1"
+
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_intstr "k8s.io/apimachinery/pkg/util/intstr"
+
+	kube_fake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+import (
+	runtime_k8s "github.com/apache/dubbo-kubernetes/pkg/config/plugins/runtime/k8s"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/containers"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/controllers"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/metadata"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/runtime/k8s/webhooks/injector"
+)
+
+var _ = Describe("DubboInjector", func() {
+	namespace := func(labels map[string]string) *kube_core.Namespace {
+		return &kube_core.Namespace{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name:   "demo",
+				Labels: labels,
+			},
+		}
+	}
+
+	newPod := func(labels map[string]string) *kube_core.Pod {
+		return &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				GenerateName: "provider-",
+				Namespace:    "demo",
+				Labels:       labels,
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{
+						Name:  "provider",
+						Image: "provider:latest",
+						Ports: []kube_core.ContainerPort{
+							{Name: "http", ContainerPort: 8080},
+						},
+						ReadinessProbe: &kube_core.Probe{
+							ProbeHandler: kube_core.ProbeHandler{
+								HTTPGet: &kube_core.HTTPGetAction{
+									Path: "/health",
+									Port: kube_intstr.FromString("http"),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newInjector := func(ns *kube_core.Namespace) *injector.DubboInjector {
+		client := kube_fake.NewClientBuilder().WithObjects(ns).Build()
+		dubboInjector, err := injector.New(runtime_k8s.DefaultKubernetesRuntimeConfig().Injector, "https://dubbo-control-plane.dubbo-system:5678", client)
+		Expect(err).ToNot(HaveOccurred())
+		return dubboInjector
+	}
+
+	It("should inject the sidecar into a Pod of an enabled Namespace", func() {
+		// given
+		dubboInjector := newInjector(namespace(map[string]string{
+			metadata.DubboSidecarInjectionLabel: metadata.AnnotationEnabled,
+		}))
+		pod := newPod(nil)
+
+		// when
+		err := dubboInjector.InjectDubbo(context.Background(), pod)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers).To(HaveLen(2))
+		sidecar := pod.Spec.Containers[1]
+		Expect(sidecar.Name).To(Equal(containers.SidecarContainerName))
+		Expect(sidecar.Image).To(Equal("docker.io/apache/dubbo-dp:latest"))
+		Expect(sidecar.Resources.Limits.Memory().String()).To(Equal("512Mi"))
+		Expect(pod.Spec.InitContainers).To(HaveLen(1))
+		Expect(pod.Spec.InitContainers[0].Name).To(Equal(containers.InitContainerName))
+		Expect(pod.Spec.InitContainers[0].Args).To(ContainElement("--exclude-inbound-ports=9000"))
+
+		// and the marker and mesh are recorded for the Pod controller
+		Expect(pod.Annotations).To(HaveKeyWithValue(metadata.DubboSidecarInjectedAnnotation, metadata.AnnotationTrue))
+		Expect(pod.Annotations).To(HaveKeyWithValue(metadata.DubboMeshAnnotation, "default"))
+		Expect(pod.Annotations).To(HaveKeyWithValue(metadata.DubboEnvoyAdminPort, "9901"))
+
+		// and the probe goes through the virtual probes port
+		probe := pod.Spec.Containers[0].ReadinessProbe.HTTPGet
+		Expect(probe.Port.IntValue()).To(Equal(9000))
+		Expect(probe.Path).To(Equal("/8080/health"))
+	})
+
+	It("should let the Pod label override the Namespace label", func() {
+		// given
+		dubboInjector := newInjector(namespace(map[string]string{
+			metadata.DubboSidecarInjectionLabel: metadata.AnnotationEnabled,
+		}))
+		pod := newPod(map[string]string{
+			metadata.DubboSidecarInjectionLabel: metadata.AnnotationDisabled,
+		})
+
+		// when
+		err := dubboInjector.InjectDubbo(context.Background(), pod)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers).To(HaveLen(1))
+		Expect(pod.Spec.InitContainers).To(BeEmpty())
+	})
+
+	It("should not inject the sidecar twice", func() {
+		// given
+		dubboInjector := newInjector(namespace(nil))
+		pod := newPod(map[string]string{
+			metadata.DubboSidecarInjectionLabel: metadata.AnnotationEnabled,
+		})
+		Expect(dubboInjector.InjectDubbo(context.Background(), pod)).To(Succeed())
+
+		// when
+		err := dubboInjector.InjectDubbo(context.Background(), pod)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers).To(HaveLen(2))
+		Expect(pod.Spec.InitContainers).To(HaveLen(1))
+	})
+
+	It("should produce virtual probes readable by the Pod controller", func() {
+		// given
+		dubboInjector := newInjector(namespace(map[string]string{
+			metadata.DubboSidecarInjectionLabel: metadata.AnnotationEnabled,
+		}))
+		pod := newPod(nil)
+		Expect(dubboInjector.InjectDubbo(context.Background(), pod)).To(Succeed())
+
+		// when
+		probes, err := controllers.ProbesFor(pod)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(probes.GetPort()).To(Equal(uint32(9000)))
+		Expect(probes.GetEndpoints()).To(HaveLen(1))
+		Expect(probes.GetEndpoints()[0].GetInboundPort()).To(Equal(uint32(8080)))
+		Expect(probes.GetEndpoints()[0].GetInboundPath()).To(Equal("/health"))
+		Expect(probes.GetEndpoints()[0].GetPath()).To(Equal("/8080/health"))
+	})
+
+	It("should redirect the DNS traffic to the builtin DNS of the sidecar", func() {
+		// given
+		dubboInjector := newInjector(namespace(map[string]string{
+			metadata.DubboSidecarInjectionLabel: metadata.AnnotationEnabled,
+		}))
+		pod := newPod(nil)
+
+		// when
+		err := dubboInjector.InjectDubbo(context.Background(), pod)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.InitContainers[0].Args).To(ContainElements("--redirect-dns", "--redirect-dns-port=15054"))
+		Expect(pod.Spec.Containers[1].Env).To(ContainElements(
+			kube_core.EnvVar{Name: "DUBBO_DNS_ENABLED", Value: "true"},
+			kube_core.EnvVar{Name: "DUBBO_DNS_ENVOY_DNS_PORT", Value: "15054"},
+		))
+	})
+
+	It("should not redirect the DNS traffic when the builtin DNS is disabled for the Pod", func() {
+		// given
+		dubboInjector := newInjector(namespace(map[string]string{
+			metadata.DubboSidecarInjectionLabel: metadata.AnnotationEnabled,
+		}))
+		pod := newPod(nil)
+		pod.Annotations = map[string]string{
+			metadata.DubboBuiltinDNS: metadata.AnnotationDisabled,
+		}
+
+		// when
+		err := dubboInjector.InjectDubbo(context.Background(), pod)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.InitContainers[0].Args).ToNot(ContainElement("--redirect-dns"))
+		Expect(pod.Spec.Containers[1].Env).To(ContainElement(kube_core.EnvVar{Name: "DUBBO_DNS_ENABLED", Value: "false"}))
+	})
+})
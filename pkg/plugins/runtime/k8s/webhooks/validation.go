@@ -102,6 +102,12 @@ func (h *validatingHandler) Handle(ctx context.Context, req admission.Request) a
 		if err := h.validateLabels(coreRes.GetMeta()); err.HasViolations() {
 			return convertValidationErrorOf(err, k8sObj, k8sObj.GetObjectMeta())
 		}
+		if err := core_model.Validate(coreRes); err != nil {
+			if dubboErr, ok := err.(*validators.ValidationError); ok {
+				return convertValidationErrorOf(*dubboErr, k8sObj, k8sObj.GetObjectMeta())
+			}
+			return admission.Denied(err.Error())
+		}
 
 		return admission.Allowed("")
 	}
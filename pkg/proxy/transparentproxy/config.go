@@ -0,0 +1,103 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transparentproxy
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	// DefaultRedirectPortInbound is the port of the inbound passthrough listener of the sidecar.
+	DefaultRedirectPortInbound uint32 = 15006
+	// DefaultRedirectPortOutbound is the port of the outbound passthrough listener of the sidecar.
+	DefaultRedirectPortOutbound uint32 = 15001
+	// DefaultDNSPort is the port of the DNS server of the sidecar.
+	DefaultDNSPort uint32 = 15053
+	// DefaultDubboDpUID is the ID of the user the sidecar runs as, its traffic is never intercepted.
+	DefaultDubboDpUID = "5678"
+
+	// InboundPassthroughSourceIPv4 is the source address of the traffic the sidecar sends to
+	// the application over IPv4, the traffic is not intercepted again.
+	InboundPassthroughSourceIPv4 = "127.0.0.6"
+	// InboundPassthroughSourceIPv6 is the IPv6 counterpart of InboundPassthroughSourceIPv4.
+	InboundPassthroughSourceIPv6 = "::6"
+)
+
+// Config defines how the traffic of the host or Pod is redirected to the sidecar.
+type Config struct {
+	// RedirectPortInbound is a port the inbound traffic is redirected to.
+	RedirectPortInbound uint32
+	// RedirectPortOutbound is a port the outbound traffic is redirected to.
+	RedirectPortOutbound uint32
+	// ExcludeInboundPorts are inbound ports that are not intercepted.
+	ExcludeInboundPorts []uint32
+	// ExcludeOutboundPorts are outbound ports that are not intercepted.
+	ExcludeOutboundPorts []uint32
+	// ExcludeOutboundUIDs are IDs of users whose outbound traffic is not intercepted.
+	ExcludeOutboundUIDs []string
+	// DubboDpUID is the ID of the user the sidecar runs as.
+	DubboDpUID string
+	// RedirectDNS enables redirection of the DNS traffic to the DNS server of the sidecar.
+	RedirectDNS bool
+	// RedirectAllDNSTraffic redirects DNS traffic to any server, not only to DNSServers.
+	RedirectAllDNSTraffic bool
+	// DNSPort is a port of the DNS server of the sidecar.
+	DNSPort uint32
+	// DNSServers are the nameservers of the host whose traffic is redirected,
+	// usually taken from /etc/resolv.conf.
+	DNSServers []string
+	// IPv6 enables redirection of the IPv6 traffic with ip6tables as well.
+	IPv6 bool
+	// DryRun prints the rules instead of applying them.
+	DryRun bool
+	// Verbose prints the rules while applying them.
+	Verbose bool
+	// Stdout is where rules are printed.
+	Stdout io.Writer
+	// Stderr is where the output of iptables is printed.
+	Stderr io.Writer
+}
+
+func DefaultConfig() Config {
+	return Config{
+		RedirectPortInbound:  DefaultRedirectPortInbound,
+		RedirectPortOutbound: DefaultRedirectPortOutbound,
+		DubboDpUID:           DefaultDubboDpUID,
+		DNSPort:              DefaultDNSPort,
+		Stdout:               os.Stdout,
+		Stderr:               os.Stderr,
+	}
+}
+
+// ReadNameservers reads the nameservers from a resolv.conf file.
+func ReadNameservers(path string) ([]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var servers []string
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			servers = append(servers, fields[1])
+		}
+	}
+	return servers, nil
+}
@@ -0,0 +1,169 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transparentproxy
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Chains created in the nat table. Every chain is prefixed with DUBBO_, so they are easy to spot in iptables-save.
+const (
+	ChainInbound          = "DUBBO_INBOUND"
+	ChainInboundRedirect  = "DUBBO_IN_REDIRECT"
+	ChainOutbound         = "DUBBO_OUTPUT"
+	ChainOutboundRedirect = "DUBBO_REDIRECT"
+)
+
+var chains = []string{ChainInbound, ChainInboundRedirect, ChainOutbound, ChainOutboundRedirect}
+
+// jumps are the rules of the built-in chains that send the traffic to the DUBBO_ chains.
+var jumps = []string{
+	"PREROUTING -p tcp -j " + ChainInbound,
+	"OUTPUT -j " + ChainOutbound,
+}
+
+func (c Config) Validate() error {
+	if c.RedirectPortInbound == 0 || c.RedirectPortInbound > 65535 {
+		return errors.Errorf("inbound redirect port %d must be in the range [1, 65535]", c.RedirectPortInbound)
+	}
+	if c.RedirectPortOutbound == 0 || c.RedirectPortOutbound > 65535 {
+		return errors.Errorf("outbound redirect port %d must be in the range [1, 65535]", c.RedirectPortOutbound)
+	}
+	if c.RedirectPortInbound == c.RedirectPortOutbound {
+		return errors.New("inbound and outbound redirect ports must be different")
+	}
+	if c.DubboDpUID == "" {
+		return errors.New("the ID of the user running the sidecar must be provided, otherwise its traffic would be intercepted as well")
+	}
+	for _, port := range append(append([]uint32{}, c.ExcludeInboundPorts...), c.ExcludeOutboundPorts...) {
+		if port == 0 || port > 65535 {
+			return errors.Errorf("excluded port %d must be in the range [1, 65535]", port)
+		}
+	}
+	if c.RedirectDNS && (c.DNSPort == 0 || c.DNSPort > 65535) {
+		return errors.Errorf("DNS port %d must be in the range [1, 65535]", c.DNSPort)
+	}
+	for _, server := range c.DNSServers {
+		if net.ParseIP(server) == nil {
+			return errors.Errorf("DNS server %q is not a valid IP address", server)
+		}
+	}
+	return nil
+}
+
+// BuildRules renders the nat table that redirects the traffic to the sidecar
+// in the format of iptables-restore. When ipv6 is true the rules are rendered for ip6tables-restore.
+func BuildRules(cfg Config, ipv6 bool) string {
+	loopback, passthroughSource := "127.0.0.1/32", InboundPassthroughSourceIPv4+"/32"
+	if ipv6 {
+		loopback, passthroughSource = "::1/128", InboundPassthroughSourceIPv6+"/128"
+	}
+
+	var rules []string
+	for _, chain := range chains {
+		rules = append(rules, fmt.Sprintf(":%s - [0:0]", chain))
+	}
+	for _, jump := range jumps {
+		rules = append(rules, "-A "+jump)
+	}
+
+	// inbound
+	for _, port := range cfg.ExcludeInboundPorts {
+		rules = append(rules, fmt.Sprintf("-A %s -p tcp --dport %d -j RETURN", ChainInbound, port))
+	}
+	rules = append(rules,
+		fmt.Sprintf("-A %s -p tcp -j %s", ChainInbound, ChainInboundRedirect),
+		fmt.Sprintf("-A %s -p tcp -j REDIRECT --to-ports %d", ChainInboundRedirect, cfg.RedirectPortInbound),
+		fmt.Sprintf("-A %s -p tcp -j REDIRECT --to-ports %d", ChainOutboundRedirect, cfg.RedirectPortOutbound),
+	)
+
+	// DNS
+	if cfg.RedirectDNS {
+		var dnsRules []string
+		if cfg.RedirectAllDNSTraffic {
+			dnsRules = append(dnsRules, fmt.Sprintf("-A %s -p udp --dport 53 -j REDIRECT --to-ports %d", ChainOutbound, cfg.DNSPort))
+		} else {
+			for _, server := range cfg.DNSServers {
+				ip := net.ParseIP(server)
+				if ip == nil || (ip.To4() == nil) != ipv6 {
+					continue
+				}
+				dnsRules = append(dnsRules, fmt.Sprintf("-A %s -d %s -p udp --dport 53 -j REDIRECT --to-ports %d", ChainOutbound, server, cfg.DNSPort))
+			}
+		}
+		if len(dnsRules) > 0 {
+			// the DNS server of the sidecar resolves names it does not know with the real nameservers
+			rules = append(rules, fmt.Sprintf("-A %s -p udp --dport 53 -m owner --uid-owner %s -j RETURN", ChainOutbound, cfg.DubboDpUID))
+			rules = append(rules, dnsRules...)
+		}
+	}
+
+	// outbound
+	rules = append(rules,
+		// the sidecar forwards the inbound traffic to the application from the passthrough address
+		fmt.Sprintf("-A %s -p tcp -o lo -s %s -j RETURN", ChainOutbound, passthroughSource),
+		// the sidecar calls an application in the same Pod through its own IP, it's an inbound traffic
+		fmt.Sprintf("-A %s -p tcp -o lo ! -d %s -m owner --uid-owner %s -j %s", ChainOutbound, loopback, cfg.DubboDpUID, ChainInboundRedirect),
+		fmt.Sprintf("-A %s -p tcp -o lo -m owner ! --uid-owner %s -j RETURN", ChainOutbound, cfg.DubboDpUID),
+		fmt.Sprintf("-A %s -p tcp -m owner --uid-owner %s -j RETURN", ChainOutbound, cfg.DubboDpUID),
+	)
+	for _, uid := range cfg.ExcludeOutboundUIDs {
+		rules = append(rules, fmt.Sprintf("-A %s -p tcp -m owner --uid-owner %s -j RETURN", ChainOutbound, uid))
+	}
+	for _, port := range cfg.ExcludeOutboundPorts {
+		rules = append(rules, fmt.Sprintf("-A %s -p tcp --dport %d -j RETURN", ChainOutbound, port))
+	}
+	rules = append(rules,
+		fmt.Sprintf("-A %s -p tcp -d %s -j RETURN", ChainOutbound, loopback),
+		fmt.Sprintf("-A %s -p tcp -j %s", ChainOutbound, ChainOutboundRedirect),
+	)
+
+	return renderTable("nat", rules)
+}
+
+// BuildCleanupRules renders the rules that remove everything BuildRules installed
+// in the format of iptables-restore.
+func BuildCleanupRules() string {
+	var rules []string
+	for _, jump := range jumps {
+		rules = append(rules, "-D "+jump)
+	}
+	for _, chain := range chains {
+		rules = append(rules, "-F "+chain)
+	}
+	for _, chain := range chains {
+		rules = append(rules, "-X "+chain)
+	}
+	return renderTable("nat", rules)
+}
+
+func renderTable(table string, rules []string) string {
+	var b strings.Builder
+	b.WriteString("*" + table + "\n")
+	for _, rule := range rules {
+		b.WriteString(rule + "\n")
+	}
+	b.WriteString("COMMIT\n")
+	return b.String()
+}
@@ -0,0 +1,114 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transparentproxy_test
+
+import (
+	"bytes"
+	"context"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/proxy/transparentproxy"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+)
+
+var _ = Describe("Transparent proxy rules", func() {
+	type testCase struct {
+		cfg        func(cfg *transparentproxy.Config)
+		ipv6       bool
+		goldenFile string
+	}
+
+	DescribeTable("should render the nat table",
+		func(given testCase) {
+			// given
+			cfg := transparentproxy.DefaultConfig()
+			given.cfg(&cfg)
+			Expect(cfg.Validate()).To(Succeed())
+
+			// when
+			rules := transparentproxy.BuildRules(cfg, given.ipv6)
+
+			// then
+			Expect(rules).To(matchers.MatchGoldenEqual("testdata", given.goldenFile))
+		},
+		Entry("default redirection", testCase{
+			cfg:        func(cfg *transparentproxy.Config) {},
+			goldenFile: "default.golden.txt",
+		}),
+		Entry("excluded ports and users", testCase{
+			cfg: func(cfg *transparentproxy.Config) {
+				cfg.ExcludeInboundPorts = []uint32{22, 9000}
+				cfg.ExcludeOutboundPorts = []uint32{3306}
+				cfg.ExcludeOutboundUIDs = []string{"1000"}
+			},
+			goldenFile: "excluded.golden.txt",
+		}),
+		Entry("DNS redirected to the nameservers of the family", testCase{
+			cfg: func(cfg *transparentproxy.Config) {
+				cfg.RedirectDNS = true
+				cfg.DNSServers = []string{"10.96.0.10", "fd00::10"}
+			},
+			ipv6:       true,
+			goldenFile: "dns.ipv6.golden.txt",
+		}),
+		Entry("all DNS traffic redirected", testCase{
+			cfg: func(cfg *transparentproxy.Config) {
+				cfg.RedirectDNS = true
+				cfg.RedirectAllDNSTraffic = true
+			},
+			goldenFile: "dns.all.golden.txt",
+		}),
+	)
+
+	It("should reject a sidecar without a user", func() {
+		// given
+		cfg := transparentproxy.DefaultConfig()
+		cfg.DubboDpUID = ""
+
+		// when
+		err := cfg.Validate()
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("ID of the user running the sidecar")))
+	})
+
+	It("should print the rules on dry run", func() {
+		// given
+		var out bytes.Buffer
+		cfg := transparentproxy.DefaultConfig()
+		cfg.IPv6 = true
+		cfg.DryRun = true
+		cfg.Stdout = &out
+
+		// when
+		err := transparentproxy.Cleanup(context.Background(), cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("# iptables-restore --noflush\n*nat\n-D PREROUTING -p tcp -j DUBBO_INBOUND\n"))
+		Expect(out.String()).To(ContainSubstring("# ip6tables-restore --noflush\n"))
+		Expect(out.String()).To(ContainSubstring("-X DUBBO_OUTPUT\n"))
+	})
+})
@@ -0,0 +1,94 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transparentproxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/proxy/command"
+)
+
+// Setup installs the transparent proxy. With DryRun the rules are only printed.
+func Setup(ctx context.Context, cfg Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	for _, ipv6 := range ipFamilies(cfg) {
+		if err := apply(ctx, cfg, ipv6, BuildRules(cfg, ipv6)); err != nil {
+			return errors.Wrap(err, "could not install the transparent proxy")
+		}
+	}
+	return nil
+}
+
+// Cleanup removes the transparent proxy installed by Setup. With DryRun the rules are only printed.
+func Cleanup(ctx context.Context, cfg Config) error {
+	for _, ipv6 := range ipFamilies(cfg) {
+		if err := apply(ctx, cfg, ipv6, BuildCleanupRules()); err != nil {
+			return errors.Wrap(err, "could not uninstall the transparent proxy")
+		}
+	}
+	return nil
+}
+
+func ipFamilies(cfg Config) []bool {
+	if cfg.IPv6 {
+		return []bool{false, true}
+	}
+	return []bool{false}
+}
+
+func apply(ctx context.Context, cfg Config, ipv6 bool, rules string) error {
+	restore := "iptables-restore"
+	if ipv6 {
+		restore = "ip6tables-restore"
+	}
+	stdout := cfg.Stdout
+	if stdout == nil {
+		stdout = io.Discard
+	}
+	if cfg.DryRun || cfg.Verbose {
+		if _, err := fmt.Fprintf(stdout, "# %s --noflush\n%s", restore, rules); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		return nil
+	}
+
+	var stderr bytes.Buffer
+	cmd := command.BuildCommand(ctx, stdout, &stderr, restore, "--noflush")
+	cmd.Stdin = strings.NewReader(rules)
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "%s failed: %s", restore, strings.TrimSpace(stderr.String()))
+	}
+	if cfg.Stderr != nil {
+		_, _ = cfg.Stderr.Write(stderr.Bytes())
+	}
+	return nil
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/apache/dubbo-kubernetes/pkg/events"
 	leader_memory "github.com/apache/dubbo-kubernetes/pkg/plugins/leader/memory"
 	resources_memory "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	xds_auth "github.com/apache/dubbo-kubernetes/pkg/xds/auth"
 	mesh_cache "github.com/apache/dubbo-kubernetes/pkg/xds/cache/mesh"
 	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
 	xds_server "github.com/apache/dubbo-kubernetes/pkg/xds/server"
@@ -107,8 +108,9 @@ func BuilderFor(appCtx context.Context, cfg dubbo_cp.Config) (*core_runtime.Buil
 	builder.WithEventBus(eventBus)
 	builder.WithDpServer(server.NewDpServer(*cfg.DpServer, func(writer http.ResponseWriter, request *http.Request) bool {
 		return true
-	}))
+	}, xds_auth.NewNoopAuthenticator(), xds_auth.NewProxyResolver(rm)))
 
+	initializeConfigManager(builder)
 	err = initializeMeshCache(builder)
 	if err != nil {
 		return nil, err
@@ -135,6 +137,10 @@ func initializeMeshCache(builder *core_runtime.Builder) error {
 		xds_server.MeshResourceTypes(),
 		builder.LookupIP(),
 		builder.Config().Multizone.Zone.Name,
+		builder.Config().IsFederatedZoneCP(),
+		builder.DataSourceLoader(),
+		builder.Config().DNSServer,
+		builder.ConfigManager(),
 	)
 
 	meshSnapshotCache, err := mesh_cache.NewCache(
@@ -0,0 +1,86 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+import (
+	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+)
+
+// Policy returns a policy of the default mesh with the given name and spec.
+func Policy[R core_model.Resource](newResource func() R, name string, spec core_model.ResourceSpec) R {
+	policy := newResource()
+	policy.SetMeta(&test_model.ResourceMeta{Mesh: core_model.DefaultMesh, Name: name})
+	if err := policy.SetSpec(spec); err != nil {
+		panic(err)
+	}
+	return policy
+}
+
+// ApplyPolicies matches the policies to the data plane proxy like the policy plugin does when the mesh context
+// is built, and applies the matched policies to the resources returned by ResourceSet.
+func ApplyPolicies(
+	plugin core_plugins.PolicyPlugin,
+	ctx xds_context.Context,
+	dataplane *core_mesh.DataplaneResource,
+	policies core_model.ResourceList,
+) (*core_xds.ResourceSet, error) {
+	resources := xds_context.NewResources()
+	resources.MeshLocalResources[policies.GetItemType()] = policies
+	matched, err := plugin.MatchedPolicies(dataplane, resources)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := ResourceSet(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+	if err := plugin.Apply(rs, ctx, Proxy(dataplane, matched)); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// ResourcesYAML returns the resources as the YAML of a discovery response, which is what the golden files hold.
+func ResourcesYAML(rs *core_xds.ResourceSet) ([]byte, error) {
+	resp, err := rs.List().ToDeltaDiscoveryResponse()
+	if err != nil {
+		return nil, err
+	}
+	return util_proto.ToYAML(resp)
+}
+
+// MatchResources succeeds if the actual resource set holds the same resources as the expected one.
+// It lets the tests check that a policy leaves the resources untouched without a golden file of them.
+func MatchResources(expected *core_xds.ResourceSet) types.GomegaMatcher {
+	expectedYAML, err := ResourcesYAML(expected)
+	if err != nil {
+		panic(err)
+	}
+	return gomega.WithTransform(ResourcesYAML, gomega.MatchYAML(expectedYAML))
+}
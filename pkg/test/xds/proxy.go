@@ -0,0 +1,179 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xds
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	envoy_clusters "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/clusters"
+	envoy_listeners "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners"
+	envoy_names "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/names"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/generator"
+)
+
+// Context returns the xDS context of the default mesh in which the given services speak the given protocol.
+func Context(protocol core_mesh.Protocol, services ...string) xds_context.Context {
+	mesh := core_mesh.NewMeshResource()
+	mesh.SetMeta(&test_model.ResourceMeta{Name: core_model.DefaultMesh})
+	servicesInformation := map[string]*xds_context.ServiceInformation{}
+	for _, service := range services {
+		servicesInformation[service] = &xds_context.ServiceInformation{Protocol: protocol}
+	}
+	return xds_context.Context{
+		Mesh: xds_context.MeshContext{
+			Resource:            mesh,
+			ServicesInformation: servicesInformation,
+		},
+	}
+}
+
+// Proxy returns the proxy of the data plane proxy with the policies matched by a policy plugin.
+func Proxy(dataplane *core_mesh.DataplaneResource, policies core_xds.TypedMatchingPolicies) *core_xds.Proxy {
+	return &core_xds.Proxy{
+		Id:         *core_xds.BuildProxyId(dataplane.GetMeta().GetMesh(), dataplane.GetMeta().GetName()),
+		APIVersion: core_xds.APIVersion(envoy_common.APIV3),
+		Dataplane:  dataplane,
+		Policies: core_xds.MatchedPolicies{
+			Dynamic: core_xds.PluginOriginatedPolicies{
+				policies.Type: policies,
+			},
+		},
+	}
+}
+
+// ResourceSet returns the resources the policy plugins are applied to. Every inbound of the data plane proxy
+// gets an inbound listener and a local cluster, every outbound an outbound listener and a cluster.
+// Listeners of HTTP based protocols carry an HTTP connection manager with a catch-all route, the other ones a TCP proxy.
+// The protocol of the outbounds is taken from the services information of the mesh context.
+// Like the generators do, the listeners get the default tracing and logging backends of the mesh.
+func ResourceSet(ctx xds_context.Context, dataplane *core_mesh.DataplaneResource) (*core_xds.ResourceSet, error) {
+	apiVersion := core_xds.APIVersion(envoy_common.APIV3)
+	resources := core_xds.NewResourceSet()
+	networking := dataplane.Spec.GetNetworking()
+	proxy := Proxy(dataplane, core_xds.TypedMatchingPolicies{})
+	mesh := ctx.Mesh.Resource
+
+	for i, endpoint := range networking.GetInboundInterfaces() {
+		iface := networking.GetInbound()[i]
+		protocol := core_mesh.ParseProtocol(iface.GetProtocol())
+		localClusterName := envoy_names.GetLocalClusterName(endpoint.WorkloadPort)
+		localCluster := envoy_common.NewCluster(envoy_common.WithService(localClusterName))
+
+		clusterBuilder := envoy_clusters.NewClusterBuilder(apiVersion, localClusterName).
+			Configure(envoy_clusters.ProvidedEndpointCluster(false, core_xds.Endpoint{Target: endpoint.WorkloadIP, Port: endpoint.WorkloadPort}))
+		filterChainBuilder := envoy_listeners.NewFilterChainBuilder(apiVersion, envoy_common.AnonymousResource)
+		if isHTTPBased(protocol) {
+			clusterBuilder.Configure(envoy_clusters.Http2())
+			filterChainBuilder.
+				Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
+				Configure(envoy_listeners.HttpInboundRoutes(iface.GetService(), envoy_common.Routes{
+					envoy_common.NewRoute(envoy_common.WithCluster(localCluster)),
+				}))
+		} else {
+			filterChainBuilder.Configure(envoy_listeners.TcpProxyDeprecated(localClusterName, localCluster))
+		}
+		filterChainBuilder.
+			Configure(envoy_listeners.Tracing(mesh.GetTracingBackend(""))).
+			Configure(envoy_listeners.AccessLog(
+				mesh.GetMeta().GetName(),
+				envoy_common.TrafficDirectionInbound,
+				mesh_proto.MatchAllTag,
+				iface.GetService(),
+				mesh.GetLoggingBackend(""),
+				proxy,
+			))
+		listener, err := envoy_listeners.NewInboundListenerBuilder(apiVersion, endpoint.DataplaneIP, endpoint.DataplanePort, core_xds.SocketAddressProtocolTCP).
+			Configure(envoy_listeners.FilterChain(filterChainBuilder)).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		cluster, err := clusterBuilder.Build()
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(
+			&core_xds.Resource{Name: listener.GetName(), Origin: generator.OriginInbound, Resource: listener},
+			&core_xds.Resource{Name: localClusterName, Origin: generator.OriginInbound, Resource: cluster},
+		)
+	}
+
+	for _, outbound := range networking.GetOutbound() {
+		oface := networking.ToOutboundInterface(outbound)
+		serviceName := outbound.GetService()
+		protocol := ctx.Mesh.GetServiceProtocol(serviceName)
+		serviceCluster := envoy_common.NewCluster(
+			envoy_common.WithService(serviceName),
+			envoy_common.WithName(serviceName),
+			envoy_common.WithTags(outbound.GetTags()),
+		)
+
+		clusterBuilder := envoy_clusters.NewClusterBuilder(apiVersion, serviceName).
+			Configure(envoy_clusters.EdsCluster())
+		filterChainBuilder := envoy_listeners.NewFilterChainBuilder(apiVersion, envoy_common.AnonymousResource)
+		if isHTTPBased(protocol) {
+			clusterBuilder.Configure(envoy_clusters.Http2())
+			filterChainBuilder.
+				Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
+				Configure(envoy_listeners.HttpOutboundRoute(serviceName, envoy_common.Routes{
+					{Clusters: []envoy_common.Cluster{serviceCluster}},
+				}, dataplane.Spec.TagSet()))
+		} else {
+			filterChainBuilder.Configure(envoy_listeners.TcpProxyDeprecated(serviceName, serviceCluster))
+		}
+		filterChainBuilder.
+			Configure(envoy_listeners.Tracing(mesh.GetTracingBackend(""))).
+			Configure(envoy_listeners.AccessLog(
+				mesh.GetMeta().GetName(),
+				envoy_common.TrafficDirectionOutbound,
+				dataplane.Spec.GetIdentifyingService(),
+				serviceName,
+				mesh.GetLoggingBackend(""),
+				proxy,
+			))
+		listener, err := envoy_listeners.NewOutboundListenerBuilder(apiVersion, oface.DataplaneIP, oface.DataplanePort, core_xds.SocketAddressProtocolTCP).
+			Configure(envoy_listeners.FilterChain(filterChainBuilder)).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		cluster, err := clusterBuilder.Build()
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(
+			&core_xds.Resource{Name: listener.GetName(), Origin: generator.OriginOutbound, Resource: listener},
+			&core_xds.Resource{Name: serviceName, Origin: generator.OriginOutbound, Resource: cluster},
+		)
+	}
+	return resources, nil
+}
+
+func isHTTPBased(protocol core_mesh.Protocol) bool {
+	switch protocol {
+	case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2, core_mesh.ProtocolGRPC, core_mesh.ProtocolTriple:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,41 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8s
+
+import (
+	"strconv"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ParsePercentage returns the value of the percentage defined either as an integer
+// or as a string holding a decimal number, e.g. "0.5".
+func ParsePercentage(percentage intstr.IntOrString) (float64, error) {
+	if percentage.Type == intstr.Int {
+		return float64(percentage.IntVal), nil
+	}
+	value, err := strconv.ParseFloat(percentage.StrVal, 64)
+	if err != nil {
+		return 0, errors.Errorf("%q is not a valid percentage", percentage.StrVal)
+	}
+	return value, nil
+}
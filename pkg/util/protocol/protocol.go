@@ -14,6 +14,7 @@ var protocolStacks = map[core_mesh.Protocol]core_mesh.ProtocolList{
 	core_mesh.ProtocolHTTP2:  {core_mesh.ProtocolHTTP2, core_mesh.ProtocolTCP},
 	core_mesh.ProtocolHTTP:   {core_mesh.ProtocolHTTP, core_mesh.ProtocolTCP},
 	core_mesh.ProtocolKafka:  {core_mesh.ProtocolKafka, core_mesh.ProtocolTCP},
+	core_mesh.ProtocolDubbo:  {core_mesh.ProtocolDubbo, core_mesh.ProtocolTCP},
 	core_mesh.ProtocolTCP:    {core_mesh.ProtocolTCP},
 }
 
@@ -127,5 +127,15 @@ var _ = Describe("GetCommonProtocol()", func() {
 			another:  core_mesh.ProtocolTCP,
 			expected: core_mesh.ProtocolTCP,
 		}),
+		Entry("`dubbo` and `dubbo`", testCase{
+			one:      core_mesh.ProtocolDubbo,
+			another:  core_mesh.ProtocolDubbo,
+			expected: core_mesh.ProtocolDubbo,
+		}),
+		Entry("`dubbo` and `triple`", testCase{
+			one:      core_mesh.ProtocolDubbo,
+			another:  core_mesh.ProtocolTriple,
+			expected: core_mesh.ProtocolTCP,
+		}),
 	)
 })
@@ -0,0 +1,117 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"strings"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"google.golang.org/grpc/metadata"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+const authorization = "authorization"
+
+// Credential is a token a proxy presents to the dp-server in the "authorization" header.
+type Credential = string
+
+// Authenticator verifies the credential of a proxy connecting to the dp-server.
+// When resource is nil only the credential itself is verified, otherwise
+// the authenticator also checks that the credential belongs to the resource
+// (Dataplane, ZoneIngress or ZoneEgress) the proxy claims to be.
+type Authenticator interface {
+	Authenticate(ctx context.Context, resource model.Resource, credential Credential) error
+}
+
+// ExtractCredential returns the credential from the incoming gRPC metadata.
+// It returns an empty credential when the metadata has no "authorization" header.
+func ExtractCredential(ctx context.Context) (Credential, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	values := md.Get(authorization)
+	if len(values) == 0 {
+		return "", nil
+	}
+	if len(values) > 1 {
+		return "", errors.Errorf("request must have exactly 1 %q header, got %d", authorization, len(values))
+	}
+	// Envoy sends the token as is while other clients might use the "Bearer" scheme
+	credential := values[0]
+	if len(credential) > len("bearer ") && strings.EqualFold(credential[:len("bearer ")], "bearer ") {
+		credential = credential[len("bearer "):]
+	}
+	return credential, nil
+}
+
+// NewProxyTypeAuthenticator returns an authenticator that uses the dataplane authenticator
+// for Dataplanes and the zone authenticator for ZoneIngresses and ZoneEgresses.
+// A credential that is not bound to any resource is accepted by either of them.
+func NewProxyTypeAuthenticator(dataplane Authenticator, zone Authenticator) Authenticator {
+	return &proxyTypeAuthenticator{
+		dataplane: dataplane,
+		zone:      zone,
+	}
+}
+
+var _ Authenticator = &proxyTypeAuthenticator{}
+
+type proxyTypeAuthenticator struct {
+	dataplane Authenticator
+	zone      Authenticator
+}
+
+func (p *proxyTypeAuthenticator) Authenticate(ctx context.Context, resource model.Resource, credential Credential) error {
+	switch resource.(type) {
+	case nil:
+		dpErr := p.dataplane.Authenticate(ctx, nil, credential)
+		if dpErr == nil {
+			return nil
+		}
+		if zoneErr := p.zone.Authenticate(ctx, nil, credential); zoneErr != nil {
+			return dpErr
+		}
+		return nil
+	case *core_mesh.DataplaneResource:
+		return p.dataplane.Authenticate(ctx, resource, credential)
+	case *core_mesh.ZoneIngressResource, *core_mesh.ZoneEgressResource:
+		return p.zone.Authenticate(ctx, resource, credential)
+	default:
+		return errors.Errorf("no matching authenticator for %s resource", resource.Descriptor().Name)
+	}
+}
+
+// NewNoopAuthenticator returns an authenticator that accepts any credential.
+func NewNoopAuthenticator() Authenticator {
+	return &noopAuthenticator{}
+}
+
+type noopAuthenticator struct{}
+
+func (n *noopAuthenticator) Authenticate(context.Context, model.Resource, Credential) error {
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package components
+
+import (
+	"context"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	dubbo_cp "github.com/apache/dubbo-kubernetes/pkg/config/app/dubbo-cp"
+	dp_server "github.com/apache/dubbo-kubernetes/pkg/config/dp-server"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/dataplane"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/zone"
+	k8s_extensions "github.com/apache/dubbo-kubernetes/pkg/plugins/extensions/k8s"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/auth"
+	k8s_auth "github.com/apache/dubbo-kubernetes/pkg/xds/auth/k8s"
+	universal_auth "github.com/apache/dubbo-kubernetes/pkg/xds/auth/universal"
+)
+
+// NewAuthenticator builds the authenticator of the proxies connecting to the dp-server
+// out of the dataplane proxy and zone proxy authentication configuration.
+// Every credential is accepted when the authentication is disabled.
+func NewAuthenticator(cfg dubbo_cp.Config, resManager manager.ReadOnlyResourceManager, extensions context.Context) (auth.Authenticator, error) {
+	authn := cfg.DpServer.Authn
+	if !authn.Enabled {
+		return auth.NewNoopAuthenticator(), nil
+	}
+	dpAuthenticator, err := newAuthenticator(authn.DpProxy.Type, extensions, func() (auth.Authenticator, error) {
+		validatorCfg := authn.DpProxy.DpToken.Validator
+		validator, err := dataplane.NewValidator(resManager, validatorCfg.PublicKeys, validatorCfg.UseSecrets)
+		if err != nil {
+			return nil, err
+		}
+		return universal_auth.NewDataplaneTokenAuthenticator(validator), nil
+	}, dp_server.DpServerAuthDpToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create the dataplane proxy authenticator")
+	}
+	zoneAuthenticator, err := newAuthenticator(authn.ZoneProxy.Type, extensions, func() (auth.Authenticator, error) {
+		validatorCfg := authn.ZoneProxy.ZoneToken.Validator
+		validator, err := zone.NewValidator(resManager, validatorCfg.PublicKeys, validatorCfg.UseSecrets)
+		if err != nil {
+			return nil, err
+		}
+		return universal_auth.NewZoneTokenAuthenticator(validator, cfg.Multizone.Zone.Name), nil
+	}, dp_server.DpServerAuthZoneToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create the zone proxy authenticator")
+	}
+	return auth.NewProxyTypeAuthenticator(dpAuthenticator, zoneAuthenticator), nil
+}
+
+func newAuthenticator(
+	authType string,
+	extensions context.Context,
+	tokenAuthenticator func() (auth.Authenticator, error),
+	tokenAuthType string,
+) (auth.Authenticator, error) {
+	switch authType {
+	case dp_server.DpServerAuthServiceAccountToken:
+		mgr, ok := k8s_extensions.FromManagerContext(extensions)
+		if !ok {
+			return nil, errors.Errorf("%q authentication requires Kubernetes", authType)
+		}
+		return k8s_auth.New(mgr.GetClient()), nil
+	case tokenAuthType:
+		return tokenAuthenticator()
+	case dp_server.DpServerAuthNone:
+		return auth.NewNoopAuthenticator(), nil
+	default:
+		return nil, errors.Errorf("unknown authentication type %q", authType)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"os"
+)
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_health "github.com/envoyproxy/go-control-plane/envoy/service/health/v3"
+
+	"github.com/pkg/errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+)
+
+var log = core.Log.WithName("xds").WithName("auth")
+
+// ProxyResolver returns the resource of the proxy identified by the Envoy node.
+type ProxyResolver func(ctx context.Context, node *envoy_core.Node) (model.Resource, error)
+
+// NewProxyResolver resolves the proxy identified by the node from the store. A proxy which
+// is not registered yet is resolved from the resource embedded in the node metadata. The
+// embedded resource is supplied by the client, so it has to be of the proxy identified by the node.
+func NewProxyResolver(resManager manager.ReadOnlyResourceManager) ProxyResolver {
+	return func(ctx context.Context, node *envoy_core.Node) (model.Resource, error) {
+		proxyId, err := core_xds.ParseProxyIdFromString(node.GetId())
+		if err != nil {
+			return nil, err
+		}
+		md := core_xds.DataplaneMetadataFromXdsMetadata(node.GetMetadata(), os.TempDir(), proxyId.ToResourceKey())
+		var resource model.Resource
+		key := proxyId.ToResourceKey()
+		switch md.GetProxyType() {
+		case mesh_proto.IngressProxyType:
+			resource = core_mesh.NewZoneIngressResource()
+			key.Mesh = model.NoMesh
+		case mesh_proto.EgressProxyType:
+			resource = core_mesh.NewZoneEgressResource()
+			key.Mesh = model.NoMesh
+		default:
+			resource = core_mesh.NewDataplaneResource()
+		}
+		err = resManager.Get(ctx, resource, store.GetBy(key))
+		switch {
+		case err == nil:
+			return resource, nil
+		case !store.IsResourceNotFound(err) || md.Resource == nil:
+			return nil, err
+		}
+		if mdKey := model.MetaToResourceKey(md.Resource.GetMeta()); md.Resource.Descriptor().Name != resource.Descriptor().Name || mdKey != key {
+			return nil, errors.Errorf("%s %q of mesh %q from the metadata is not the proxy %q", md.Resource.Descriptor().Name, mdKey.Name, mdKey.Mesh, node.GetId())
+		}
+		return md.Resource, nil
+	}
+}
+
+// UnaryAuthenticationInterceptor rejects unary calls without a valid credential.
+func UnaryAuthenticationInterceptor(authenticator Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		credential, err := ExtractCredential(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if err := authenticator.Authenticate(ctx, nil, credential); err != nil {
+			log.Info("authentication failed", "method", info.FullMethod, "reason", err.Error())
+			return nil, status.Error(codes.Unauthenticated, "authentication failed")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthenticationInterceptor rejects streams without a valid credential. Envoy streams
+// (xDS, HDS) are additionally checked against the proxy identified by the node of the requests.
+func StreamAuthenticationInterceptor(authenticator Authenticator, resolver ProxyResolver) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		credential, err := ExtractCredential(ss.Context())
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		if err := authenticator.Authenticate(ss.Context(), nil, credential); err != nil {
+			log.Info("authentication failed", "method", info.FullMethod, "reason", err.Error())
+			return status.Error(codes.Unauthenticated, "authentication failed")
+		}
+		return handler(srv, &authenticatedStream{
+			ServerStream:  ss,
+			authenticator: authenticator,
+			resolver:      resolver,
+			credential:    credential,
+			method:        info.FullMethod,
+		})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	authenticator Authenticator
+	resolver      ProxyResolver
+	credential    Credential
+	method        string
+	// nodeId is the ID of the last node the credential was verified against
+	nodeId string
+}
+
+func (a *authenticatedStream) RecvMsg(m interface{}) error {
+	if err := a.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	node := nodeFrom(m)
+	if node.GetId() == "" || node.GetId() == a.nodeId {
+		return nil
+	}
+	resource, err := a.resolver(a.Context(), node)
+	if err != nil {
+		log.Info("could not resolve the proxy", "method", a.method, "node", node.GetId(), "reason", err.Error())
+		return status.Errorf(codes.Unauthenticated, "could not resolve the proxy %q", node.GetId())
+	}
+	if err := a.authenticator.Authenticate(a.Context(), resource, a.credential); err != nil {
+		log.Info("authentication failed", "method", a.method, "node", node.GetId(), "reason", err.Error())
+		return status.Error(codes.Unauthenticated, "authentication failed")
+	}
+	a.nodeId = node.GetId()
+	return nil
+}
+
+// nodeFrom returns the Envoy node of the xDS and HDS requests or nil for other messages.
+func nodeFrom(m interface{}) *envoy_core.Node {
+	switch msg := m.(type) {
+	case *envoy_service_health.HealthCheckRequestOrEndpointHealthResponse:
+		return msg.GetHealthCheckRequest().GetNode()
+	case interface{ GetNode() *envoy_core.Node }:
+		return msg.GetNode()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,208 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth_test
+
+import (
+	"context"
+)
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/pkg/errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	resources_memory "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/auth"
+)
+
+var _ = Describe("NewProxyResolver", func() {
+	ctx := context.Background()
+	var resManager manager.ResourceManager
+	var resolver auth.ProxyResolver
+
+	node := func(id string, dataplane string) *envoy_core.Node {
+		n := &envoy_core.Node{Id: id}
+		if dataplane != "" {
+			n.Metadata = &structpb.Struct{Fields: map[string]*structpb.Value{
+				core_xds.FieldDataplaneDataplaneResource: structpb.NewStringValue(dataplane),
+			}}
+		}
+		return n
+	}
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(resources_memory.NewStore())
+		Expect(resManager.Create(ctx, core_mesh.NewMeshResource(), store.CreateByKey(model.DefaultMesh, model.NoMesh))).To(Succeed())
+		resolver = auth.NewProxyResolver(resManager)
+	})
+
+	It("should prefer the dataplane from the store over the one from the metadata", func() {
+		// given
+		dp := core_mesh.NewDataplaneResource()
+		dp.Spec = &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+					Port: 20880,
+					Tags: map[string]string{mesh_proto.ServiceTag: "backend"},
+				}},
+			},
+		}
+		Expect(resManager.Create(ctx, dp, store.CreateByKey("dp-1", model.DefaultMesh))).To(Succeed())
+
+		// when
+		resource, err := resolver(ctx, node("default.dp-1", `
+type: Dataplane
+mesh: default
+name: dp-1
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: 20880
+    tags:
+      dubbo.io/service: admin
+`))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resource.(*core_mesh.DataplaneResource).Spec.TagSet().Values(mesh_proto.ServiceTag)).To(Equal([]string{"backend"}))
+	})
+
+	It("should resolve the dataplane which is not registered yet from the metadata", func() {
+		// when
+		resource, err := resolver(ctx, node("default.dp-1", `
+type: Dataplane
+mesh: default
+name: dp-1
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: 20880
+    tags:
+      dubbo.io/service: backend
+`))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resource.GetMeta().GetName()).To(Equal("dp-1"))
+	})
+
+	It("should reject the dataplane from the metadata of another proxy", func() {
+		// when
+		_, err := resolver(ctx, node("default.dp-1", `
+type: Dataplane
+mesh: default
+name: dp-2
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: 20880
+    tags:
+      dubbo.io/service: backend
+`))
+
+		// then
+		Expect(err).To(MatchError(`Dataplane "dp-2" of mesh "default" from the metadata is not the proxy "default.dp-1"`))
+	})
+
+	It("should not resolve the proxy which is neither registered nor in the metadata", func() {
+		// when
+		_, err := resolver(ctx, node("default.dp-1", ""))
+
+		// then
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+})
+
+var _ = Describe("UnaryAuthenticationInterceptor", func() {
+	rejecting := &rejectingAuthenticator{}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "handled", nil
+	}
+
+	DescribeTable("should reject the call without a valid credential",
+		func(method string) {
+			// given
+			interceptor := auth.UnaryAuthenticationInterceptor(rejecting)
+
+			// when
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+
+			// then
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		},
+		Entry("MappingRegister", "/dubbo.mesh.v1alpha1.ServiceNameMappingService/MappingRegister"),
+		Entry("MetadataRegister", "/dubbo.mesh.v1alpha1.MetadataService/MetadataRegister"),
+	)
+})
+
+var _ = Describe("StreamAuthenticationInterceptor", func() {
+	rejecting := &rejectingAuthenticator{}
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	}
+
+	DescribeTable("should reject the stream without a valid credential",
+		func(method string) {
+			// given
+			interceptor := auth.StreamAuthenticationInterceptor(rejecting, nil)
+
+			// when
+			err := interceptor(nil, &stream{}, &grpc.StreamServerInfo{FullMethod: method}, handler)
+
+			// then
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		},
+		Entry("DeltaAggregatedResources", "/envoy.service.discovery.v3.AggregatedDiscoveryService/DeltaAggregatedResources"),
+		Entry("MappingSync", "/dubbo.mesh.v1alpha1.ServiceNameMappingService/MappingSync"),
+		Entry("MetadataSync", "/dubbo.mesh.v1alpha1.MetadataService/MetadataSync"),
+		Entry("RuleSync", "/dubbo.mesh.v1alpha1.RuleService/RuleSync"),
+	)
+})
+
+type stream struct {
+	grpc.ServerStream
+}
+
+func (s *stream) Context() context.Context {
+	return context.Background()
+}
+
+type rejectingAuthenticator struct{}
+
+func (r *rejectingAuthenticator) Authenticate(context.Context, model.Resource, auth.Credential) error {
+	return errors.New("invalid credential")
+}
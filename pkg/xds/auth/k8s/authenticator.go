@@ -0,0 +1,106 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	kube_auth "k8s.io/api/authentication/v1"
+	kube_core "k8s.io/api/core/v1"
+
+	kube_types "k8s.io/apimachinery/pkg/types"
+
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	util_k8s "github.com/apache/dubbo-kubernetes/pkg/util/k8s"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/auth"
+)
+
+const serviceAccountUsernamePrefix = "system:serviceaccount:"
+
+// New returns an authenticator that verifies service account tokens with the Kubernetes
+// TokenReview API. The token of a proxy has to belong to the service account of its Pod.
+func New(client kube_client.Client) auth.Authenticator {
+	return &kubeAuthenticator{
+		client: client,
+	}
+}
+
+var _ auth.Authenticator = &kubeAuthenticator{}
+
+type kubeAuthenticator struct {
+	client kube_client.Client
+}
+
+func (k *kubeAuthenticator) Authenticate(ctx context.Context, resource model.Resource, credential auth.Credential) error {
+	if credential == "" {
+		return errors.New("service account token is not provided")
+	}
+	username, err := k.reviewToken(ctx, credential)
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		return nil
+	}
+	podName, namespace, err := util_k8s.CoreNameToK8sName(resource.GetMeta().GetName())
+	if err != nil {
+		return err
+	}
+	pod := &kube_core.Pod{}
+	if err := k.client.Get(ctx, kube_types.NamespacedName{Name: podName, Namespace: namespace}, pod); err != nil {
+		return errors.Wrapf(err, "could not retrieve Pod %s/%s", namespace, podName)
+	}
+	serviceAccountName := pod.Spec.ServiceAccountName
+	if serviceAccountName == "" {
+		serviceAccountName = "default"
+	}
+	if expected := fmt.Sprintf("%s%s:%s", serviceAccountUsernamePrefix, namespace, serviceAccountName); username != expected {
+		return errors.Errorf("service account token belongs to %q while the Pod %s/%s runs as %q", username, namespace, podName, expected)
+	}
+	return nil
+}
+
+// reviewToken returns the username of the service account the token belongs to.
+func (k *kubeAuthenticator) reviewToken(ctx context.Context, credential auth.Credential) (string, error) {
+	tokenReview := &kube_auth.TokenReview{
+		Spec: kube_auth.TokenReviewSpec{
+			Token: credential,
+		},
+	}
+	if err := k.client.Create(ctx, tokenReview); err != nil {
+		return "", errors.Wrap(err, "call to TokenReview API failed")
+	}
+	if !tokenReview.Status.Authenticated {
+		return "", errors.Errorf("token doesn't belong to a valid user: %s", tokenReview.Status.Error)
+	}
+	username := tokenReview.Status.User.Username
+	if !strings.HasPrefix(username, serviceAccountUsernamePrefix) {
+		return "", errors.Errorf("user %q is not a service account", username)
+	}
+	return username, nil
+}
@@ -0,0 +1,146 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package universal
+
+import (
+	"context"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/dataplane"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/zone"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/auth"
+)
+
+// NewDataplaneTokenAuthenticator returns an authenticator of the dataplane proxies using dataplane tokens.
+func NewDataplaneTokenAuthenticator(validator dataplane.Validator) auth.Authenticator {
+	return &dataplaneTokenAuthenticator{
+		validator: validator,
+	}
+}
+
+var _ auth.Authenticator = &dataplaneTokenAuthenticator{}
+
+type dataplaneTokenAuthenticator struct {
+	validator dataplane.Validator
+}
+
+func (d *dataplaneTokenAuthenticator) Authenticate(ctx context.Context, resource model.Resource, credential auth.Credential) error {
+	if credential == "" {
+		return errors.New("dataplane token is not provided")
+	}
+	identity, err := d.validator.Validate(ctx, credential)
+	if err != nil {
+		return err
+	}
+	switch r := resource.(type) {
+	case nil:
+		return nil
+	case *core_mesh.DataplaneResource:
+		return validateDataplaneIdentity(identity, r)
+	default:
+		return errors.Errorf("dataplane token cannot be used to authenticate %s resource", resource.Descriptor().Name)
+	}
+}
+
+func validateDataplaneIdentity(identity dataplane.Identity, dp *core_mesh.DataplaneResource) error {
+	if identity.Mesh != dp.GetMeta().GetMesh() {
+		return errors.Errorf("proxy mesh from requestor: %s is different than in token: %s", dp.GetMeta().GetMesh(), identity.Mesh)
+	}
+	if identity.Name != "" && identity.Name != dp.GetMeta().GetName() {
+		return errors.Errorf("proxy name from requestor: %s is different than in token: %s", dp.GetMeta().GetName(), identity.Name)
+	}
+	if identity.Type != "" && identity.Type != mesh_proto.DataplaneProxyType {
+		return errors.Errorf("proxy type from requestor: %s is different than in token: %s", mesh_proto.DataplaneProxyType, identity.Type)
+	}
+	tags := dp.Spec.TagSet()
+	for _, tag := range identity.Tags.Keys() {
+		allowed := identity.Tags.Values(tag)
+		for _, value := range tags.Values(tag) {
+			if !contains(allowed, value) {
+				return errors.Errorf("dataplane tag %q has value %q which is not allowed with this token. Allowed values in token are %q", tag, value, allowed)
+			}
+		}
+		if len(tags.Values(tag)) == 0 {
+			return errors.Errorf("dataplane has no tag %q required by the token", tag)
+		}
+	}
+	return nil
+}
+
+// NewZoneTokenAuthenticator returns an authenticator of the zone proxies using zone tokens.
+// The token has to be issued for the zone of the control plane.
+func NewZoneTokenAuthenticator(validator zone.Validator, zoneName string) auth.Authenticator {
+	return &zoneTokenAuthenticator{
+		validator: validator,
+		zoneName:  zoneName,
+	}
+}
+
+var _ auth.Authenticator = &zoneTokenAuthenticator{}
+
+type zoneTokenAuthenticator struct {
+	validator zone.Validator
+	zoneName  string
+}
+
+func (z *zoneTokenAuthenticator) Authenticate(ctx context.Context, resource model.Resource, credential auth.Credential) error {
+	if credential == "" {
+		return errors.New("zone token is not provided")
+	}
+	identity, err := z.validator.Validate(ctx, credential)
+	if err != nil {
+		return err
+	}
+	if z.zoneName != "" && identity.Zone != z.zoneName {
+		return errors.Errorf("zone from the token: %s is different than the zone of the control plane: %s", identity.Zone, z.zoneName)
+	}
+	switch resource.(type) {
+	case nil:
+		return nil
+	case *core_mesh.ZoneIngressResource:
+		return validateScope(identity, zone.IngressScope)
+	case *core_mesh.ZoneEgressResource:
+		return validateScope(identity, zone.EgressScope)
+	default:
+		return errors.Errorf("zone token cannot be used to authenticate %s resource", resource.Descriptor().Name)
+	}
+}
+
+func validateScope(identity zone.Identity, scope string) error {
+	if !contains(identity.Scope, scope) {
+		return errors.Errorf("token cannot be used to authenticate zone %s, the token scope is %v", scope, identity.Scope)
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
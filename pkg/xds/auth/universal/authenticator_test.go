@@ -0,0 +1,244 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package universal_test
+
+import (
+	"context"
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	config_types "github.com/apache/dubbo-kubernetes/pkg/config/types"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/system"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/dataplane"
+	"github.com/apache/dubbo-kubernetes/pkg/core/tokens/zone"
+	resources_memory "github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	util_rsa "github.com/apache/dubbo-kubernetes/pkg/util/rsa"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/auth"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/auth/universal"
+)
+
+var _ = Describe("Authentication with tokens", func() {
+	ctx := context.Background()
+	var resManager manager.ResourceManager
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(resources_memory.NewStore())
+	})
+
+	Describe("dataplane token", func() {
+		var issuer dataplane.Issuer
+		var authenticator auth.Authenticator
+
+		dataplaneResource := func(name string, tags map[string]string) *core_mesh.DataplaneResource {
+			return &core_mesh.DataplaneResource{
+				Meta: &test_model.ResourceMeta{Name: name, Mesh: "default"},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{Port: 20880, Tags: tags},
+						},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			issuer = dataplane.NewIssuer(resManager)
+			validator, err := dataplane.NewValidator(resManager, nil, true)
+			Expect(err).ToNot(HaveOccurred())
+			authenticator = universal.NewDataplaneTokenAuthenticator(validator)
+		})
+
+		It("should authenticate a dataplane matching the token", func() {
+			// given
+			token, err := issuer.Generate(ctx, dataplane.Identity{
+				Name: "dp-1",
+				Mesh: "default",
+				Tags: mesh_proto.MultiValueTagSetFrom(map[string][]string{
+					"app": {"demo", "demo-canary"},
+				}),
+			}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = authenticator.Authenticate(ctx, dataplaneResource("dp-1", map[string]string{"app": "demo"}), token)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should authenticate the token without a resource", func() {
+			// given
+			token, err := issuer.Generate(ctx, dataplane.Identity{Mesh: "default"}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			// expect
+			Expect(authenticator.Authenticate(ctx, nil, token)).To(Succeed())
+		})
+
+		It("should reject a dataplane with a different name", func() {
+			// given
+			token, err := issuer.Generate(ctx, dataplane.Identity{Name: "dp-1", Mesh: "default"}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = authenticator.Authenticate(ctx, dataplaneResource("dp-2", map[string]string{"app": "demo"}), token)
+
+			// then
+			Expect(err).To(MatchError("proxy name from requestor: dp-2 is different than in token: dp-1"))
+		})
+
+		It("should reject a dataplane with a tag value not allowed by the token", func() {
+			// given
+			token, err := issuer.Generate(ctx, dataplane.Identity{
+				Mesh: "default",
+				Tags: mesh_proto.MultiValueTagSetFrom(map[string][]string{"app": {"demo"}}),
+			}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = authenticator.Authenticate(ctx, dataplaneResource("dp-1", map[string]string{"app": "other"}), token)
+
+			// then
+			Expect(err).To(MatchError(`dataplane tag "app" has value "other" which is not allowed with this token. Allowed values in token are ["demo"]`))
+		})
+
+		It("should reject an expired token", func() {
+			// given
+			token, err := issuer.Generate(ctx, dataplane.Identity{Mesh: "default"}, -time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = authenticator.Authenticate(ctx, nil, token)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("token is expired")))
+		})
+
+		It("should reject a revoked token", func() {
+			// given
+			token, err := issuer.Generate(ctx, dataplane.Identity{Mesh: "default"}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			registered := &jwt.RegisteredClaims{}
+			_, _, err = new(jwt.Parser).ParseUnverified(token, registered)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			revocations := system.NewSecretResource()
+			revocations.Spec.Data = &wrapperspb.BytesValue{Value: []byte("other-token," + registered.ID)}
+			Expect(resManager.Create(ctx, revocations, core_store.CreateByKey(dataplane.RevocationsSecretFor("default"), model.NoMesh))).To(Succeed())
+
+			// then
+			Expect(authenticator.Authenticate(ctx, nil, token)).To(MatchError("token is revoked"))
+		})
+
+		It("should reject a token of another mesh", func() {
+			// given
+			token, err := issuer.Generate(ctx, dataplane.Identity{Mesh: "demo"}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = authenticator.Authenticate(ctx, dataplaneResource("dp-1", nil), token)
+
+			// then
+			Expect(err).To(MatchError("proxy mesh from requestor: default is different than in token: demo"))
+		})
+
+		It("should validate a token signed offline with a public key from the configuration", func() {
+			// given a token signed with a key that is not stored in the control plane
+			key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+			Expect(err).ToNot(HaveOccurred())
+			keyBytes, err := util_rsa.FromPrivateKeyToPEMBytes(key)
+			Expect(err).ToNot(HaveOccurred())
+			publicKeyBytes, err := util_rsa.FromPrivateKeyToPublicKeyPEMBytes(key)
+			Expect(err).ToNot(HaveOccurred())
+
+			offlineManager := manager.NewResourceManager(resources_memory.NewStore())
+			secret := system.NewSecretResource()
+			secret.Spec.Data = &wrapperspb.BytesValue{Value: keyBytes}
+			Expect(offlineManager.Create(ctx, secret, core_store.CreateBy(tokens.SigningKeyResourceKey(dataplane.SigningKeyPrefixFor("default"), 1)))).To(Succeed())
+			token, err := dataplane.NewIssuer(offlineManager).Generate(ctx, dataplane.Identity{Mesh: "default"}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			validator, err := dataplane.NewValidator(resManager, []config_types.MeshedPublicKey{{
+				PublicKey: config_types.PublicKey{KID: "1", Key: string(publicKeyBytes)},
+				Mesh:      "default",
+			}}, false)
+			Expect(err).ToNot(HaveOccurred())
+			err = universal.NewDataplaneTokenAuthenticator(validator).Authenticate(ctx, nil, token)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authenticator.Authenticate(ctx, nil, token)).ToNot(Succeed())
+		})
+	})
+
+	Describe("zone token", func() {
+		var issuer zone.Issuer
+		var authenticator auth.Authenticator
+
+		BeforeEach(func() {
+			issuer = zone.NewIssuer(resManager)
+			validator, err := zone.NewValidator(resManager, nil, true)
+			Expect(err).ToNot(HaveOccurred())
+			authenticator = universal.NewZoneTokenAuthenticator(validator, "zone-1")
+		})
+
+		It("should authenticate a zone ingress within the token scope", func() {
+			// given
+			token, err := issuer.Generate(ctx, zone.Identity{Zone: "zone-1", Scope: []string{zone.IngressScope}}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			// expect
+			Expect(authenticator.Authenticate(ctx, core_mesh.NewZoneIngressResource(), token)).To(Succeed())
+			Expect(authenticator.Authenticate(ctx, core_mesh.NewZoneEgressResource(), token)).To(MatchError("token cannot be used to authenticate zone egress, the token scope is [ingress]"))
+		})
+
+		It("should reject a token of another zone", func() {
+			// given
+			token, err := issuer.Generate(ctx, zone.Identity{Zone: "zone-2", Scope: zone.FullScope}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = authenticator.Authenticate(ctx, nil, token)
+
+			// then
+			Expect(err).To(MatchError("zone from the token: zone-2 is different than the zone of the control plane: zone-1"))
+		})
+	})
+})
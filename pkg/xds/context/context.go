@@ -22,9 +22,11 @@ import (
 )
 
 import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
 	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	"github.com/apache/dubbo-kubernetes/pkg/core/xds"
 	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/secrets"
 )
 
 type Context struct {
@@ -41,6 +43,7 @@ type ConnectionInfo struct {
 // This data is the same regardless of a data plane proxy and mesh we are generating the data for.
 type ControlPlaneContext struct {
 	CLACache envoy.CLACache
+	Secrets  secrets.Secrets
 	Zone     string
 }
 
@@ -68,12 +71,18 @@ func (g BaseMeshContext) Hash() string {
 }
 
 type MeshContext struct {
-	Hash                string
-	Resource            *core_mesh.MeshResource
-	Resources           Resources
-	DataplanesByName    map[string]*core_mesh.DataplaneResource
-	EndpointMap         xds.EndpointMap
-	ServicesInformation map[string]*ServiceInformation
+	Hash             string
+	Resource         *core_mesh.MeshResource
+	Resources        Resources
+	DataplanesByName map[string]*core_mesh.DataplaneResource
+	EndpointMap      xds.EndpointMap
+	// ExternalServicesEndpointMap contains the endpoints of external services reachable from the zone.
+	ExternalServicesEndpointMap xds.EndpointMap
+	ServicesInformation         map[string]*ServiceInformation
+	// VIPDomains contains the virtual IPs of the services together with the domains that resolve to them.
+	VIPDomains []xds.VIPDomains
+	// VIPOutbounds contains an outbound on the virtual IP of every service for every port the service is served on.
+	VIPOutbounds []*mesh_proto.Dataplane_Networking_Outbound
 }
 
 type ServiceInformation struct {
@@ -121,3 +130,10 @@ func (m AggregatedMeshContexts) ZoneIngresses() []*core_mesh.ZoneIngressResource
 	}
 	return nil
 }
+
+func (m AggregatedMeshContexts) ZoneEgresses() []*core_mesh.ZoneEgressResource {
+	for _, meshCtx := range m.MeshContextsByName {
+		return meshCtx.Resources.ZoneEgresses().Items // all mesh contexts has the same list
+	}
+	return nil
+}
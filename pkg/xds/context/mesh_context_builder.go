@@ -31,21 +31,31 @@ import (
 )
 
 import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	dns_server "github.com/apache/dubbo-kubernetes/pkg/config/dns-server"
+	config_manager "github.com/apache/dubbo-kubernetes/pkg/core/config/manager"
+	"github.com/apache/dubbo-kubernetes/pkg/core/datasource"
 	"github.com/apache/dubbo-kubernetes/pkg/core/dns/lookup"
+	"github.com/apache/dubbo-kubernetes/pkg/core/dns/vips"
 	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	"github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/system"
 	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
 	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
 	"github.com/apache/dubbo-kubernetes/pkg/core/resources/registry"
 	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
 	xds_topology "github.com/apache/dubbo-kubernetes/pkg/xds/topology"
 )
 
 type meshContextBuilder struct {
-	rm      manager.ReadOnlyResourceManager
-	typeSet map[core_model.ResourceType]struct{}
-	ipFunc  lookup.LookupIPFunc
-	zone    string
+	rm               manager.ReadOnlyResourceManager
+	typeSet          map[core_model.ResourceType]struct{}
+	ipFunc           lookup.LookupIPFunc
+	zone             string
+	crossZone        bool
+	dataSourceLoader datasource.Loader
+	dnsServer        *dns_server.Config
+	vipsPersistence  *vips.Persistence
 }
 
 type MeshContextBuilder interface {
@@ -65,6 +75,10 @@ func NewMeshContextBuilder(
 	types []core_model.ResourceType, // types that should be taken into account when MeshContext is built.
 	ipFunc lookup.LookupIPFunc,
 	zone string,
+	crossZone bool, // whether the services of other zones are reachable, see xds_topology.BuildEdsEndpoint
+	dataSourceLoader datasource.Loader,
+	dnsServer *dns_server.Config,
+	configManager config_manager.ConfigManager,
 ) MeshContextBuilder {
 	typeSet := map[core_model.ResourceType]struct{}{}
 	for _, typ := range types {
@@ -72,10 +86,14 @@ func NewMeshContextBuilder(
 	}
 
 	return &meshContextBuilder{
-		rm:      rm,
-		typeSet: typeSet,
-		ipFunc:  ipFunc,
-		zone:    zone,
+		rm:               rm,
+		typeSet:          typeSet,
+		ipFunc:           ipFunc,
+		zone:             zone,
+		crossZone:        crossZone,
+		dataSourceLoader: dataSourceLoader,
+		dnsServer:        dnsServer,
+		vipsPersistence:  vips.NewPersistence(configManager),
 	}
 }
 
@@ -173,7 +191,12 @@ func (m meshContextBuilder) BuildIfChanged(ctx context.Context, meshName string,
 		}
 	}
 
-	newHash := base64.StdEncoding.EncodeToString(m.hash(globalContext, managedTypes, resources))
+	vipList, err := m.fetchVIPs(ctx, meshName)
+	if err != nil {
+		return nil, err
+	}
+
+	newHash := base64.StdEncoding.EncodeToString(m.hash(globalContext, baseMeshContext, managedTypes, resources, vipList))
 	if latestMeshCtx != nil && newHash == latestMeshCtx.Hash {
 		return latestMeshCtx, nil
 	}
@@ -186,17 +209,101 @@ func (m meshContextBuilder) BuildIfChanged(ctx context.Context, meshName string,
 
 	mesh := baseMeshContext.Mesh
 	zoneIngresses := resources.ZoneIngresses().Items
-	endpointMap := xds_topology.BuildEdsEndpoint(m.zone, dataplanes, zoneIngresses)
+	zoneEgresses := resources.ZoneEgresses().Items
+	externalServices := resources.ExternalServices().Items
+	endpointMap := xds_topology.BuildEdsEndpoint(m.zone, m.crossZone, mesh, dataplanes, zoneIngresses, zoneEgresses, externalServices)
+	esEndpointMap := xds_topology.BuildExternalServicesEndpointMap(ctx, mesh, externalServices, m.dataSourceLoader, m.zone)
+	vipDomains, vipOutbounds := m.buildVIPs(vipList, dataplanes)
 
 	return &MeshContext{
-		Hash:             newHash,
-		Resource:         mesh,
-		Resources:        resources,
-		DataplanesByName: dataplanesByName,
-		EndpointMap:      endpointMap,
+		Hash:                        newHash,
+		Resource:                    mesh,
+		Resources:                   resources,
+		DataplanesByName:            dataplanesByName,
+		EndpointMap:                 endpointMap,
+		ExternalServicesEndpointMap: esEndpointMap,
+		ServicesInformation:         buildServicesInformation(mesh, dataplanes, externalServices),
+		VIPDomains:                  vipDomains,
+		VIPOutbounds:                vipOutbounds,
 	}, nil
 }
 
+func (m *meshContextBuilder) fetchVIPs(ctx context.Context, meshName string) (vips.List, error) {
+	if m.dnsServer == nil || !m.dnsServer.ServiceVipEnabled {
+		return nil, nil
+	}
+	list, err := m.vipsPersistence.Get(ctx, meshName)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch virtual IPs")
+	}
+	return list, nil
+}
+
+// buildVIPs builds the domains of the services and the applications that have a virtual IP and the outbounds on their virtual IPs.
+// Entries without any inbound left are skipped, nothing would serve the traffic sent to them.
+// The outbounds of an application send the traffic of every port to the service the application exposes on it.
+func (m *meshContextBuilder) buildVIPs(list vips.List, dataplanes []*core_mesh.DataplaneResource) ([]core_xds.VIPDomains, []*mesh_proto.Dataplane_Networking_Outbound) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+	entryPorts := vips.Ports(dataplanes)
+	var vipDomains []core_xds.VIPDomains
+	var vipOutbounds []*mesh_proto.Dataplane_Networking_Outbound
+	for _, entry := range list.Entries() {
+		ports, ok := entryPorts[entry]
+		if !ok {
+			continue
+		}
+		address := list[entry].Address
+		vipDomains = append(vipDomains, core_xds.VIPDomains{
+			Address: address,
+			Domains: []string{vips.Hostname(entry.Name, m.dnsServer.Domain)},
+		})
+		for _, port := range ports {
+			vipOutbounds = append(vipOutbounds, &mesh_proto.Dataplane_Networking_Outbound{
+				Address: address,
+				Port:    port.Port,
+				Tags: map[string]string{
+					mesh_proto.ServiceTag: port.Service,
+				},
+			})
+		}
+	}
+	return vipDomains, vipOutbounds
+}
+
+// buildServicesInformation collects the protocol of every service exposed by the Dataplanes of the mesh.
+// When the inbounds of a service declare different protocols, the common one is used.
+// External services take the protocol from their tags.
+func buildServicesInformation(mesh *core_mesh.MeshResource, dataplanes []*core_mesh.DataplaneResource, externalServices []*core_mesh.ExternalServiceResource) map[string]*ServiceInformation {
+	servicesInformation := map[string]*ServiceInformation{}
+	for _, dp := range dataplanes {
+		for _, inbound := range dp.Spec.GetNetworking().GetInbound() {
+			service := inbound.GetService()
+			if service == "" {
+				continue
+			}
+			protocol := core_mesh.ParseProtocol(inbound.GetProtocol())
+			if info, ok := servicesInformation[service]; ok {
+				info.Protocol = core_mesh.GetCommonProtocol(info.Protocol, protocol)
+				continue
+			}
+			servicesInformation[service] = &ServiceInformation{
+				TLSReadiness: mesh.MTLSEnabled(),
+				Protocol:     protocol,
+			}
+		}
+	}
+	for _, es := range externalServices {
+		servicesInformation[es.Spec.GetService()] = &ServiceInformation{
+			TLSReadiness:      true,
+			Protocol:          core_mesh.ParseProtocol(es.Spec.GetProtocol()),
+			IsExternalService: true,
+		}
+	}
+	return servicesInformation
+}
+
 type filterFn = func(rs core_model.Resource) bool
 
 func (m *meshContextBuilder) fetchResourceList(ctx context.Context, resType core_model.ResourceType, mesh *core_mesh.MeshResource, filterFn filterFn) (core_model.ResourceList, error) {
@@ -216,15 +323,19 @@ func (m *meshContextBuilder) fetchResourceList(ctx context.Context, resType core
 	}
 	listOptsFunc = append(listOptsFunc, core_store.ListOrdered())
 	list := desc.NewList()
-	// TODO: Currently, We only interested in Dataplane, Mapping, Mesh and MetaData
+	// TODO: Currently, We only interested in Dataplane, ZoneIngress, ZoneEgress, ExternalService, Mapping, Mesh, MetaData and AuthorizationPolicy
 	acceptedTypes := map[core_model.ResourceType]struct{}{
-		core_mesh.DataplaneType: {},
-		core_mesh.MappingType:   {},
-		core_mesh.MeshType:      {},
-		core_mesh.MetaDataType:  {},
+		core_mesh.DataplaneType:           {},
+		core_mesh.ZoneIngressType:         {},
+		core_mesh.ZoneEgressType:          {},
+		core_mesh.ExternalServiceType:     {},
+		core_mesh.MappingType:             {},
+		core_mesh.MeshType:                {},
+		core_mesh.MetaDataType:            {},
+		core_mesh.AuthorizationPolicyType: {},
 	}
-	if _, ok := acceptedTypes[resType]; !ok {
-		// ignore non-dataplane resources
+	if _, ok := acceptedTypes[resType]; !ok && !desc.IsPluginOriginated {
+		// ignore non-dataplane resources, policies implemented as plugins are always taken into account
 		return list, nil
 	}
 	if err := m.rm.List(ctx, list, listOptsFunc...); err != nil {
@@ -281,13 +392,17 @@ func modifyAllEntries(list core_model.ResourceList, fn func(resource core_model.
 	return newList, nil
 }
 
-func (m *meshContextBuilder) hash(globalContext *GlobalContext, managedTypes []core_model.ResourceType, resources Resources) []byte {
+func (m *meshContextBuilder) hash(globalContext *GlobalContext, baseMeshContext *BaseMeshContext, managedTypes []core_model.ResourceType, resources Resources, vipList vips.List) []byte {
 	slices.Sort(managedTypes)
 	hasher := fnv.New128a()
 	_, _ = hasher.Write(globalContext.hash)
+	_, _ = hasher.Write(baseMeshContext.hash)
 	for _, resType := range managedTypes {
 		_, _ = hasher.Write(core_model.ResourceListHash(resources.MeshLocalResources[resType]))
 	}
+	for _, entry := range vipList.Entries() {
+		_, _ = hasher.Write([]byte(entry.String() + "=" + vipList[entry].Address))
+	}
 
 	return hasher.Sum(nil)
 }
@@ -0,0 +1,144 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package context_test
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/consts"
+	"github.com/apache/dubbo-kubernetes/pkg/core/datasource"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	_ "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout"
+	timeout_api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/timeout/api/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+)
+
+var _ = Describe("MeshContextBuilder", func() {
+	var rm manager.ResourceManager
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		Expect(rm.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey("default", core_model.NoMesh))).To(Succeed())
+	})
+
+	create := func(r core_model.Resource, name string) {
+		Expect(rm.Create(context.Background(), r, core_store.CreateByKey(name, "default"))).To(Succeed())
+	}
+
+	newTimeout := func() *timeout_api.TimeoutResource {
+		timeout := timeout_api.NewTimeoutResource()
+		timeout.Spec = &timeout_api.Timeout{
+			TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+			To: []timeout_api.To{{
+				TargetRef: common_api.TargetRef{Kind: common_api.Mesh},
+				Default: timeout_api.Conf{
+					ConnectionTimeout: &k8s.Duration{Duration: 10 * time.Second},
+				},
+			}},
+		}
+		return timeout
+	}
+
+	build := func(types ...core_model.ResourceType) *xds_context.MeshContext {
+		builder := xds_context.NewMeshContextBuilder(
+			rm,
+			types,
+			net.LookupIP,
+			"zone-1",
+			false,
+			datasource.NewDataSourceLoader(rm),
+			nil,
+			nil,
+		)
+		meshCtx, err := builder.BuildIfChanged(context.Background(), "default", nil)
+		Expect(err).ToNot(HaveOccurred())
+		return meshCtx
+	}
+
+	It("should fetch the policies implemented as plugins", func() {
+		// given
+		create(newTimeout(), "timeout-1")
+
+		// when
+		meshCtx := build(core_mesh.DataplaneType, timeout_api.TimeoutType)
+
+		// then
+		items := meshCtx.Resources.ListOrEmpty(timeout_api.TimeoutType).GetItems()
+		Expect(items).To(HaveLen(1))
+		Expect(items[0].GetMeta().GetName()).To(Equal("timeout-1"))
+	})
+
+	It("should ignore the policies neither accepted nor implemented as plugins", func() {
+		// given
+		route := core_mesh.NewConditionRouteResource()
+		route.Spec = &mesh_proto.ConditionRoute{
+			Key:        "org.apache.dubbo.GreetService",
+			Scope:      consts.Service,
+			Conditions: []string{"method=greet => version=v1"},
+		}
+		create(route, "route-1")
+
+		// when
+		meshCtx := build(core_mesh.DataplaneType, core_mesh.ConditionRouteType)
+
+		// then
+		Expect(meshCtx.Resources.ListOrEmpty(core_mesh.ConditionRouteType).GetItems()).To(BeEmpty())
+	})
+
+	It("should rebuild the context when a plugin policy changes", func() {
+		// given
+		builder := xds_context.NewMeshContextBuilder(
+			rm,
+			[]core_model.ResourceType{core_mesh.DataplaneType, timeout_api.TimeoutType},
+			net.LookupIP,
+			"zone-1",
+			false,
+			datasource.NewDataSourceLoader(rm),
+			nil,
+			nil,
+		)
+		latest, err := builder.BuildIfChanged(context.Background(), "default", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		create(newTimeout(), "timeout-1")
+		meshCtx, err := builder.BuildIfChanged(context.Background(), "default", latest)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(meshCtx.Hash).ToNot(Equal(latest.Hash))
+		Expect(meshCtx.Resources.ListOrEmpty(timeout_api.TimeoutType).GetItems()).To(HaveLen(1))
+	})
+})
@@ -69,6 +69,22 @@ func (r Resources) ZoneIngresses() *core_mesh.ZoneIngressResourceList {
 	return r.ListOrEmpty(core_mesh.ZoneIngressType).(*core_mesh.ZoneIngressResourceList)
 }
 
+func (r Resources) ZoneEgresses() *core_mesh.ZoneEgressResourceList {
+	return r.ListOrEmpty(core_mesh.ZoneEgressType).(*core_mesh.ZoneEgressResourceList)
+}
+
+func (r Resources) ExternalServices() *core_mesh.ExternalServiceResourceList {
+	return r.ListOrEmpty(core_mesh.ExternalServiceType).(*core_mesh.ExternalServiceResourceList)
+}
+
 func (r Resources) Dataplanes() *core_mesh.DataplaneResourceList {
 	return r.ListOrEmpty(core_mesh.DataplaneType).(*core_mesh.DataplaneResourceList)
 }
+
+func (r Resources) AuthorizationPolicies() *core_mesh.AuthorizationPolicyResourceList {
+	return r.ListOrEmpty(core_mesh.AuthorizationPolicyType).(*core_mesh.AuthorizationPolicyResourceList)
+}
+
+func (r Resources) MetaData() *core_mesh.MetaDataResourceList {
+	return r.ListOrEmpty(core_mesh.MetaDataType).(*core_mesh.MetaDataResourceList)
+}
@@ -24,7 +24,10 @@ import (
 )
 
 import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	circuitbreaker_api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker/api/v1alpha1"
+	loadbalancer_api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/loadbalancer/api/v1alpha1"
 	v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/clusters/v3"
 	envoy_tags "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tags"
 )
@@ -96,6 +99,29 @@ func ConnectionBufferLimit(bytes uint32) ClusterBuilderOpt {
 	})
 }
 
+// ClientSideMTLS secures the connections to the upstream service when mTLS is enabled on both meshes.
+func ClientSideMTLS(tracker core_xds.SecretsTracker, mesh *core_mesh.MeshResource, upstreamService string, upstreamTLSReady bool, tags []envoy_tags.Tags) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(builder *ClusterBuilder) {
+		builder.AddConfigurer(&v3.ClientSideMTLSConfigurer{
+			SecretsTracker:   tracker,
+			UpstreamMesh:     mesh,
+			UpstreamService:  upstreamService,
+			LocalMesh:        mesh,
+			Tags:             tags,
+			UpstreamTLSReady: upstreamTLSReady,
+		})
+	})
+}
+
+// ClientSideTLS originates TLS to the external services that have it enabled.
+func ClientSideTLS(endpoints []core_xds.Endpoint) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(builder *ClusterBuilder) {
+		builder.AddConfigurer(&v3.ClientSideTLSConfigurer{
+			Endpoints: endpoints,
+		})
+	})
+}
+
 func Http2() ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(builder *ClusterBuilder) {
 		builder.AddConfigurer(&v3.Http2Configurer{})
@@ -108,6 +134,31 @@ func Http2FromEdge() ClusterBuilderOpt {
 	})
 }
 
+func CircuitBreaker(connectionLimits *circuitbreaker_api.ConnectionLimits) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(builder *ClusterBuilder) {
+		builder.AddConfigurer(&v3.CircuitBreakerConfigurer{
+			ConnectionLimits: connectionLimits,
+		})
+	})
+}
+
+func OutlierDetection(outlierDetection *circuitbreaker_api.OutlierDetection) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(builder *ClusterBuilder) {
+		builder.AddConfigurer(&v3.OutlierDetectionConfigurer{
+			OutlierDetection: outlierDetection,
+		})
+	})
+}
+
+func LoadBalancer(conf loadbalancer_api.Conf, localityAwareLbEnabled bool) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(builder *ClusterBuilder) {
+		builder.AddConfigurer(&v3.LoadBalancerConfigurer{
+			Conf:                   conf,
+			LocalityAwareLbEnabled: localityAwareLbEnabled,
+		})
+	})
+}
+
 func Http() ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(builder *ClusterBuilder) {
 		builder.AddConfigurer(&v3.HttpConfigurer{})
@@ -0,0 +1,62 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+)
+
+import (
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker/api/v1alpha1"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+type CircuitBreakerConfigurer struct {
+	ConnectionLimits *api.ConnectionLimits
+}
+
+var _ ClusterConfigurer = &CircuitBreakerConfigurer{}
+
+func (c *CircuitBreakerConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if c.ConnectionLimits == nil {
+		return nil
+	}
+	thresholds := &envoy_cluster.CircuitBreakers_Thresholds{
+		Priority: envoy_core.RoutingPriority_DEFAULT,
+	}
+	if c.ConnectionLimits.MaxConnections != nil {
+		thresholds.MaxConnections = util_proto.UInt32(*c.ConnectionLimits.MaxConnections)
+	}
+	if c.ConnectionLimits.MaxConnectionPools != nil {
+		thresholds.MaxConnectionPools = util_proto.UInt32(*c.ConnectionLimits.MaxConnectionPools)
+	}
+	if c.ConnectionLimits.MaxPendingRequests != nil {
+		thresholds.MaxPendingRequests = util_proto.UInt32(*c.ConnectionLimits.MaxPendingRequests)
+	}
+	if c.ConnectionLimits.MaxRetries != nil {
+		thresholds.MaxRetries = util_proto.UInt32(*c.ConnectionLimits.MaxRetries)
+	}
+	if c.ConnectionLimits.MaxRequests != nil {
+		thresholds.MaxRequests = util_proto.UInt32(*c.ConnectionLimits.MaxRequests)
+	}
+	cluster.CircuitBreakers = &envoy_cluster.CircuitBreakers{
+		Thresholds: []*envoy_cluster.CircuitBreakers_Thresholds{thresholds},
+	}
+	return nil
+}
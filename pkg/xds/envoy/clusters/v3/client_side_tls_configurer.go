@@ -0,0 +1,92 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clusters
+
+import (
+	"net"
+)
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	envoy_metadata "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/metadata/v3"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tags"
+	tls "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tls/v3"
+)
+
+// ClientSideTLSConfigurer originates TLS to the external services that have it enabled.
+// Every endpoint gets its own transport socket, because external services of the same
+// service name can point to different hosts with different certificates.
+type ClientSideTLSConfigurer struct {
+	Endpoints []core_xds.Endpoint
+}
+
+var _ ClusterConfigurer = &ClientSideTLSConfigurer{}
+
+func (c *ClientSideTLSConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	for _, ep := range c.Endpoints {
+		if !ep.IsExternalService() || !ep.ExternalService.TLSEnabled {
+			continue
+		}
+
+		sni := ep.ExternalService.ServerName
+		if sni == "" && net.ParseIP(ep.Target) == nil {
+			sni = ep.Target
+		}
+
+		tlsContext, err := tls.UpstreamTlsContextOutsideMesh(
+			ep.ExternalService.CaCert,
+			ep.ExternalService.ClientCert,
+			ep.ExternalService.ClientKey,
+			ep.ExternalService.AllowRenegotiation,
+			ep.ExternalService.SkipHostnameVerification,
+			ep.Target,
+			sni,
+		)
+		if err != nil {
+			return err
+		}
+
+		pbst, err := util_proto.MarshalAnyDeterministic(tlsContext)
+		if err != nil {
+			return err
+		}
+
+		cluster.TransportSocketMatches = append(cluster.TransportSocketMatches, &envoy_cluster.Cluster_TransportSocketMatch{
+			Name: ep.Target,
+			Match: &structpb.Struct{
+				Fields: envoy_metadata.MetadataFields(tags.Tags(ep.Tags).WithoutTags(mesh_proto.ServiceTag)),
+			},
+			TransportSocket: &envoy_core.TransportSocket{
+				Name: "envoy.transport_sockets.tls",
+				ConfigType: &envoy_core.TransportSocket_TypedConfig{
+					TypedConfig: pbst,
+				},
+			},
+		})
+	}
+	return nil
+}
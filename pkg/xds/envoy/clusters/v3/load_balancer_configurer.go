@@ -0,0 +1,93 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+)
+
+import (
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/loadbalancer/api/v1alpha1"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+// LoadBalancerConfigurer maps the Dubbo load balancing strategy onto the cluster.
+// Envoy has no notion of the response time of the providers, shortestresponse is
+// mapped onto the least request balancing as the requests pile up on the slow providers.
+type LoadBalancerConfigurer struct {
+	Conf api.Conf
+	// LocalityAwareLbEnabled is true when the Mesh enables the locality aware load balancing
+	LocalityAwareLbEnabled bool
+}
+
+var _ ClusterConfigurer = &LoadBalancerConfigurer{}
+
+func (c *LoadBalancerConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if c.Conf.Type != nil {
+		cluster.LbConfig = nil
+		switch *c.Conf.Type {
+		case api.Random:
+			cluster.LbPolicy = envoy_cluster.Cluster_RANDOM
+		case api.RoundRobin:
+			cluster.LbPolicy = envoy_cluster.Cluster_ROUND_ROBIN
+		case api.LeastActiveStrategy, api.ShortestResponse:
+			cluster.LbPolicy = envoy_cluster.Cluster_LEAST_REQUEST
+			if c.Conf.LeastActive != nil && c.Conf.LeastActive.ChoiceCount != nil {
+				cluster.LbConfig = &envoy_cluster.Cluster_LeastRequestLbConfig_{
+					LeastRequestLbConfig: &envoy_cluster.Cluster_LeastRequestLbConfig{
+						ChoiceCount: util_proto.UInt32(*c.Conf.LeastActive.ChoiceCount),
+					},
+				}
+			}
+		case api.ConsistentHashStrategy:
+			cluster.LbPolicy = envoy_cluster.Cluster_RING_HASH
+			if hash := c.Conf.ConsistentHash; hash != nil && (hash.MinRingSize != nil || hash.MaxRingSize != nil) {
+				config := &envoy_cluster.Cluster_RingHashLbConfig{}
+				if hash.MinRingSize != nil {
+					config.MinimumRingSize = util_proto.UInt64(uint64(*hash.MinRingSize))
+				}
+				if hash.MaxRingSize != nil {
+					config.MaximumRingSize = util_proto.UInt64(uint64(*hash.MaxRingSize))
+				}
+				cluster.LbConfig = &envoy_cluster.Cluster_RingHashLbConfig_{
+					RingHashLbConfig: config,
+				}
+			}
+		}
+	}
+	c.configureLocalityAwareness(cluster)
+	return nil
+}
+
+// configureLocalityAwareness makes the cluster respect the locality weights of the endpoints.
+// Priorities of the localities are respected by all the load balancers, but the locality
+// weighted load balancing is not supported by the hash based ones.
+func (c *LoadBalancerConfigurer) configureLocalityAwareness(cluster *envoy_cluster.Cluster) {
+	if !c.LocalityAwareLbEnabled || cluster.LbPolicy == envoy_cluster.Cluster_RING_HASH || cluster.LbPolicy == envoy_cluster.Cluster_MAGLEV {
+		return
+	}
+	if awareness := c.Conf.LocalityAwareness; awareness != nil && awareness.Disabled != nil && *awareness.Disabled {
+		return
+	}
+	if cluster.CommonLbConfig == nil {
+		cluster.CommonLbConfig = &envoy_cluster.Cluster_CommonLbConfig{}
+	}
+	cluster.CommonLbConfig.LocalityConfigSpecifier = &envoy_cluster.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+		LocalityWeightedLbConfig: &envoy_cluster.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+	}
+}
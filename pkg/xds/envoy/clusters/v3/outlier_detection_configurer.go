@@ -0,0 +1,139 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+import (
+	api "github.com/apache/dubbo-kubernetes/pkg/plugins/policies/circuitbreaker/api/v1alpha1"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+// enforcing is the % chance that a host will be actually ejected when an outlier
+// status is detected. Detectors configured by the user are always enforced.
+const enforcing = 100
+
+type OutlierDetectionConfigurer struct {
+	OutlierDetection *api.OutlierDetection
+}
+
+var _ ClusterConfigurer = &OutlierDetectionConfigurer{}
+
+func (o *OutlierDetectionConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if o.OutlierDetection == nil {
+		return nil
+	}
+	if o.OutlierDetection.Disabled != nil && *o.OutlierDetection.Disabled {
+		cluster.OutlierDetection = nil
+		return nil
+	}
+
+	outlierDetection := &envoy_cluster.OutlierDetection{
+		// Envoy enables these two detectors by default, they are only enabled
+		// below when they are configured.
+		EnforcingConsecutive_5Xx: util_proto.UInt32(0),
+		EnforcingSuccessRate:     util_proto.UInt32(0),
+	}
+	if o.OutlierDetection.Interval != nil {
+		outlierDetection.Interval = durationpb.New(o.OutlierDetection.Interval.Duration)
+	}
+	if o.OutlierDetection.BaseEjectionTime != nil {
+		outlierDetection.BaseEjectionTime = durationpb.New(o.OutlierDetection.BaseEjectionTime.Duration)
+	}
+	if o.OutlierDetection.MaxEjectionPercent != nil {
+		outlierDetection.MaxEjectionPercent = util_proto.UInt32(*o.OutlierDetection.MaxEjectionPercent)
+	}
+	if o.OutlierDetection.SplitExternalAndLocalErrors != nil {
+		outlierDetection.SplitExternalLocalOriginErrors = *o.OutlierDetection.SplitExternalAndLocalErrors
+	}
+	if detectors := o.OutlierDetection.Detectors; detectors != nil {
+		configureTotalFailures(outlierDetection, detectors.TotalFailures)
+		configureGatewayFailures(outlierDetection, detectors.GatewayFailures)
+		configureLocalOriginFailures(outlierDetection, detectors.LocalOriginFailures)
+		configureSuccessRate(outlierDetection, detectors.SuccessRate)
+		configureFailurePercentage(outlierDetection, detectors.FailurePercentage)
+	}
+	cluster.OutlierDetection = outlierDetection
+	return nil
+}
+
+func configureTotalFailures(outlierDetection *envoy_cluster.OutlierDetection, detector *api.DetectorTotalFailures) {
+	if detector == nil {
+		return
+	}
+	outlierDetection.EnforcingConsecutive_5Xx = util_proto.UInt32(enforcing)
+	if detector.Consecutive != nil {
+		outlierDetection.Consecutive_5Xx = util_proto.UInt32(*detector.Consecutive)
+	}
+}
+
+func configureGatewayFailures(outlierDetection *envoy_cluster.OutlierDetection, detector *api.DetectorGatewayFailures) {
+	if detector == nil {
+		return
+	}
+	outlierDetection.EnforcingConsecutiveGatewayFailure = util_proto.UInt32(enforcing)
+	if detector.Consecutive != nil {
+		outlierDetection.ConsecutiveGatewayFailure = util_proto.UInt32(*detector.Consecutive)
+	}
+}
+
+func configureLocalOriginFailures(outlierDetection *envoy_cluster.OutlierDetection, detector *api.DetectorLocalOriginFailures) {
+	if detector == nil {
+		return
+	}
+	outlierDetection.EnforcingConsecutiveLocalOriginFailure = util_proto.UInt32(enforcing)
+	if detector.Consecutive != nil {
+		outlierDetection.ConsecutiveLocalOriginFailure = util_proto.UInt32(*detector.Consecutive)
+	}
+}
+
+func configureSuccessRate(outlierDetection *envoy_cluster.OutlierDetection, detector *api.DetectorSuccessRate) {
+	if detector == nil {
+		return
+	}
+	outlierDetection.EnforcingSuccessRate = util_proto.UInt32(enforcing)
+	if detector.MinimumHosts != nil {
+		outlierDetection.SuccessRateMinimumHosts = util_proto.UInt32(*detector.MinimumHosts)
+	}
+	if detector.RequestVolume != nil {
+		outlierDetection.SuccessRateRequestVolume = util_proto.UInt32(*detector.RequestVolume)
+	}
+	if detector.StandardDeviationFactor != nil {
+		outlierDetection.SuccessRateStdevFactor = util_proto.UInt32(*detector.StandardDeviationFactor)
+	}
+}
+
+func configureFailurePercentage(outlierDetection *envoy_cluster.OutlierDetection, detector *api.DetectorFailurePercentage) {
+	if detector == nil {
+		return
+	}
+	outlierDetection.EnforcingFailurePercentage = util_proto.UInt32(enforcing)
+	if detector.MinimumHosts != nil {
+		outlierDetection.FailurePercentageMinimumHosts = util_proto.UInt32(*detector.MinimumHosts)
+	}
+	if detector.RequestVolume != nil {
+		outlierDetection.FailurePercentageRequestVolume = util_proto.UInt32(*detector.RequestVolume)
+	}
+	if detector.Threshold != nil {
+		outlierDetection.FailurePercentageThreshold = util_proto.UInt32(*detector.Threshold)
+	}
+}
@@ -26,6 +26,8 @@ import (
 
 import (
 	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
 	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
 	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
 	v3 "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners/v3"
@@ -64,8 +66,53 @@ func HttpConnectionManager(statsName string, forwardClientCertDetails bool) Filt
 	})
 }
 
-func TripleConnectionManager() FilterChainBuilderOpt {
-	return AddFilterChainConfigurer(&v3.TripleConfigurer{})
+// NetworkRBAC enforces the authorization policies on the connections of the filter chain.
+func NetworkRBAC(statsName string, policies []*core_mesh.AuthorizationPolicyResource) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.NetworkRBACConfigurer{
+		StatsName: statsName,
+		Policies:  policies,
+	})
+}
+
+// HttpRBAC enforces the authorization policies on the requests handled by the HttpConnectionManager.
+func HttpRBAC(policies []*core_mesh.AuthorizationPolicyResource) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.HttpRBACConfigurer{
+		Policies: policies,
+	})
+}
+
+// ServerSideMTLS secures the filter chain with the identity of the data plane proxy when mTLS is enabled on the mesh.
+func ServerSideMTLS(mesh *core_mesh.MeshResource, secretsTracker core_xds.SecretsTracker) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.ServerSideMTLSConfigurer{
+		Mesh:           mesh,
+		SecretsTracker: secretsTracker,
+	})
+}
+
+// Tracing reports the spans of the requests handled by the HttpConnectionManager to the tracing backend.
+func Tracing(backend *mesh_proto.TracingBackend) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.TracingConfigurer{
+		Backend: backend,
+	})
+}
+
+// AccessLog writes the access logs of the HttpConnectionManager or the TcpProxy to the logging backend.
+func AccessLog(
+	mesh string,
+	trafficDirection envoy_common.TrafficDirection,
+	sourceService string,
+	destinationService string,
+	backend *mesh_proto.LoggingBackend,
+	proxy *core_xds.Proxy,
+) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.AccessLogConfigurer{
+		Mesh:               mesh,
+		TrafficDirection:   trafficDirection,
+		SourceService:      sourceService,
+		DestinationService: destinationService,
+		Backend:            backend,
+		Proxy:              proxy,
+	})
 }
 
 type splitAdapter struct {
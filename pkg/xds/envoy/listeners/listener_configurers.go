@@ -63,6 +63,12 @@ func NoBindToPort() ListenerBuilderOpt {
 	return AddListenerConfigurer(&v3.TransparentProxyingConfigurer{})
 }
 
+func DNS(vips []core_xds.VIPDomains) ListenerBuilderOpt {
+	return AddListenerConfigurer(&v3.DNSConfigurer{
+		VIPs: vips,
+	})
+}
+
 func FilterChain(builder *FilterChainBuilder) ListenerBuilderOpt {
 	return AddListenerConfigurer(
 		v3.ListenerConfigureFunc(func(listener *envoy_listener.Listener) error {
@@ -0,0 +1,174 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v3
+
+import (
+	"fmt"
+	"strings"
+)
+
+import (
+	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_file "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoy_grpc "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+
+	"github.com/pkg/errors"
+
+	"google.golang.org/protobuf/proto"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+)
+
+const (
+	// accessLogSink is the name of the bootstrap cluster pointing to the access log socket of dubbo-dp.
+	accessLogSink = "access_log_sink"
+
+	DefaultHttpAccessLogFormat = `[%START_TIME%] %DUBBO_MESH% "%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%" %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% "%REQ(X-FORWARDED-FOR)%" "%REQ(USER-AGENT)%" "%REQ(X-REQUEST-ID)%" "%REQ(:AUTHORITY)%" "%DUBBO_SOURCE_SERVICE%" "%DUBBO_DESTINATION_SERVICE%" "%DOWNSTREAM_REMOTE_ADDRESS%" "%UPSTREAM_HOST%"`
+	DefaultTcpAccessLogFormat  = `[%START_TIME%] %RESPONSE_FLAGS% %DUBBO_MESH% %DOWNSTREAM_REMOTE_ADDRESS%(%DUBBO_SOURCE_SERVICE%)->%UPSTREAM_HOST%(%DUBBO_DESTINATION_SERVICE%) took %DURATION%ms, sent %BYTES_SENT% bytes, received: %BYTES_RECEIVED% bytes`
+)
+
+// AccessLogConfigurer writes the access logs of the HttpConnectionManager and the TcpProxy
+// of the filter chain to the given logging backend of the mesh.
+//
+// Besides the Envoy command operators the format of the backend can use the following placeholders:
+// %DUBBO_MESH%, %DUBBO_SOURCE_SERVICE%, %DUBBO_DESTINATION_SERVICE% and %DUBBO_TRAFFIC_DIRECTION%.
+type AccessLogConfigurer struct {
+	Mesh               string
+	TrafficDirection   envoy_common.TrafficDirection
+	SourceService      string
+	DestinationService string
+	Backend            *mesh_proto.LoggingBackend
+	Proxy              *core_xds.Proxy
+}
+
+var _ FilterChainConfigurer = &AccessLogConfigurer{}
+
+func (c *AccessLogConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.Backend == nil {
+		return nil
+	}
+	if err := UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+		accessLog, err := c.accessLog(DefaultHttpAccessLogFormat, true)
+		if err != nil {
+			return err
+		}
+		hcm.AccessLog = append(hcm.AccessLog, accessLog)
+		return nil
+	}); err != nil {
+		return err
+	}
+	return UpdateTCPProxy(filterChain, func(tcpProxy *envoy_tcp.TcpProxy) error {
+		accessLog, err := c.accessLog(DefaultTcpAccessLogFormat, false)
+		if err != nil {
+			return err
+		}
+		tcpProxy.AccessLog = append(tcpProxy.AccessLog, accessLog)
+		return nil
+	})
+}
+
+func (c *AccessLogConfigurer) accessLog(defaultFormat string, http bool) (*envoy_accesslog.AccessLog, error) {
+	format := c.Backend.GetFormat()
+	if format == "" {
+		format = defaultFormat
+	}
+	format = strings.NewReplacer(
+		"%DUBBO_MESH%", c.Mesh,
+		"%DUBBO_SOURCE_SERVICE%", c.SourceService,
+		"%DUBBO_DESTINATION_SERVICE%", c.DestinationService,
+		"%DUBBO_TRAFFIC_DIRECTION%", string(c.TrafficDirection),
+	).Replace(format)
+
+	switch c.Backend.GetType() {
+	case mesh_proto.LoggingFileType:
+		cfg := &mesh_proto.FileLoggingBackendConfig{}
+		if err := util_proto.ToTyped(c.Backend.GetConf(), cfg); err != nil {
+			return nil, errors.Wrapf(err, "could not parse the configuration of logging backend %q", c.Backend.GetName())
+		}
+		return fileAccessLog(format, cfg.GetPath())
+	case mesh_proto.LoggingTcpType:
+		cfg := &mesh_proto.TcpLoggingBackendConfig{}
+		if err := util_proto.ToTyped(c.Backend.GetConf(), cfg); err != nil {
+			return nil, errors.Wrapf(err, "could not parse the configuration of logging backend %q", c.Backend.GetName())
+		}
+		// dubbo-dp forwards the lines written to its access log socket to the TCP address at the beginning of the line.
+		if c.Proxy != nil && c.Proxy.Metadata != nil && c.Proxy.Metadata.Features.HasFeature(core_xds.FeatureTCPAccessLogViaNamedPipe) {
+			return fileAccessLog(fmt.Sprintf("%s;%s", cfg.GetAddress(), format), c.Proxy.Metadata.AccessLogSocketPath)
+		}
+		return grpcAccessLog(fmt.Sprintf("%s;%s", cfg.GetAddress(), format), http)
+	default:
+		return nil, errors.Errorf("logging backend %q has unsupported type %q", c.Backend.GetName(), c.Backend.GetType())
+	}
+}
+
+func fileAccessLog(format string, path string) (*envoy_accesslog.AccessLog, error) {
+	return accessLog("envoy.access_loggers.file", &envoy_file.FileAccessLog{
+		Path: path,
+		AccessLogFormat: &envoy_file.FileAccessLog_LogFormat{
+			LogFormat: &envoy_core.SubstitutionFormatString{
+				Format: &envoy_core.SubstitutionFormatString_TextFormatSource{
+					TextFormatSource: &envoy_core.DataSource{
+						Specifier: &envoy_core.DataSource_InlineString{
+							InlineString: format + "\n",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func grpcAccessLog(logName string, http bool) (*envoy_accesslog.AccessLog, error) {
+	commonConfig := &envoy_grpc.CommonGrpcAccessLogConfig{
+		LogName: logName,
+		GrpcService: &envoy_core.GrpcService{
+			TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
+					ClusterName: accessLogSink,
+				},
+			},
+		},
+		TransportApiVersion: envoy_core.ApiVersion_V3,
+	}
+	if http {
+		return accessLog("envoy.access_loggers.http_grpc", &envoy_grpc.HttpGrpcAccessLogConfig{CommonConfig: commonConfig})
+	}
+	return accessLog("envoy.access_loggers.tcp_grpc", &envoy_grpc.TcpGrpcAccessLogConfig{CommonConfig: commonConfig})
+}
+
+func accessLog(name string, config proto.Message) (*envoy_accesslog.AccessLog, error) {
+	typedConfig, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_accesslog.AccessLog{
+		Name: name,
+		ConfigType: &envoy_accesslog.AccessLog_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
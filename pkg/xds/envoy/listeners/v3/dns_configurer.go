@@ -0,0 +1,88 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v3
+
+import (
+	"time"
+)
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_data_dns "github.com/envoyproxy/go-control-plane/envoy/data/dns/v3"
+	envoy_dns "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/dns_filter/v3"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+import (
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+// DNSConfigurer configures the DNS filter that resolves the domains of the services to their virtual IPs.
+// The names that are not in the table are resolved by the nameservers of the host.
+type DNSConfigurer struct {
+	VIPs []core_xds.VIPDomains
+}
+
+var _ ListenerConfigurer = &DNSConfigurer{}
+
+func (c *DNSConfigurer) Configure(l *envoy_listener.Listener) error {
+	var virtualDomains []*envoy_data_dns.DnsTable_DnsVirtualDomain
+	for _, vip := range c.VIPs {
+		for _, domain := range vip.Domains {
+			virtualDomains = append(virtualDomains, &envoy_data_dns.DnsTable_DnsVirtualDomain{
+				Name: domain,
+				Endpoint: &envoy_data_dns.DnsTable_DnsEndpoint{
+					EndpointConfig: &envoy_data_dns.DnsTable_DnsEndpoint_AddressList{
+						AddressList: &envoy_data_dns.DnsTable_AddressList{
+							Address: []string{vip.Address},
+						},
+					},
+				},
+				AnswerTtl: durationpb.New(30 * time.Second),
+			})
+		}
+	}
+
+	config := &envoy_dns.DnsFilterConfig{
+		StatPrefix: "dubbo_dns",
+		ServerConfig: &envoy_dns.DnsFilterConfig_ServerContextConfig{
+			ConfigSource: &envoy_dns.DnsFilterConfig_ServerContextConfig_InlineDnsTable{
+				InlineDnsTable: &envoy_data_dns.DnsTable{
+					VirtualDomains: virtualDomains,
+				},
+			},
+		},
+		ClientConfig: &envoy_dns.DnsFilterConfig_ClientContextConfig{
+			ResolverTimeout:   durationpb.New(5 * time.Second),
+			MaxPendingLookups: 256,
+		},
+	}
+	any, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return err
+	}
+	l.ListenerFilters = append(l.ListenerFilters, &envoy_listener.ListenerFilter{
+		Name: "envoy.filters.udp.dns_filter",
+		ConfigType: &envoy_listener.ListenerFilter_TypedConfig{
+			TypedConfig: any,
+		},
+	})
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+)
+
+// HttpRBACConfigurer enforces the authorization policies on the request level.
+type HttpRBACConfigurer struct {
+	Policies []*core_mesh.AuthorizationPolicyResource
+}
+
+var _ FilterChainConfigurer = &HttpRBACConfigurer{}
+
+func (c *HttpRBACConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	var filters []*envoy_hcm.HttpFilter
+	for _, action := range authorizationActions {
+		rules := createRbacRules(c.Policies, action, true)
+		if rules == nil {
+			continue
+		}
+		pbst, err := util_proto.MarshalAnyDeterministic(&rbac.RBAC{
+			Rules: rules,
+		})
+		if err != nil {
+			return err
+		}
+		filters = append(filters, &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.rbac",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		})
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append(filters, manager.HttpFilters...)
+		return nil
+	})
+}
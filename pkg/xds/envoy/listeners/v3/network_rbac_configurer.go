@@ -0,0 +1,62 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	util_xds "github.com/apache/dubbo-kubernetes/pkg/util/xds"
+)
+
+// NetworkRBACConfigurer enforces the authorization policies on the connection level.
+type NetworkRBACConfigurer struct {
+	StatsName string
+	Policies  []*core_mesh.AuthorizationPolicyResource
+}
+
+var _ FilterChainConfigurer = &NetworkRBACConfigurer{}
+
+func (c *NetworkRBACConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	var filters []*envoy_listener.Filter
+	for _, action := range authorizationActions {
+		rules := createRbacRules(c.Policies, action, false)
+		if rules == nil {
+			continue
+		}
+		pbst, err := util_proto.MarshalAnyDeterministic(&rbac.RBAC{
+			Rules:      rules,
+			StatPrefix: util_xds.SanitizeMetric(c.StatsName) + ".",
+		})
+		if err != nil {
+			return err
+		}
+		filters = append(filters, &envoy_listener.Filter{
+			Name: "envoy.filters.network.rbac",
+			ConfigType: &envoy_listener.Filter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		})
+	}
+	filterChain.Filters = append(filters, filterChain.Filters...)
+	return nil
+}
@@ -0,0 +1,352 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v3
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	rbac_config "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+)
+
+// authorizationActions is the order of the RBAC filters in a filter chain. Audit goes first,
+// so that every request is logged, then deny and finally allow, which rejects everything it doesn't match.
+var authorizationActions = []string{
+	mesh_proto.AuthorizationActionAudit,
+	mesh_proto.AuthorizationActionDeny,
+	mesh_proto.AuthorizationActionAllow,
+}
+
+var rbacActions = map[string]rbac_config.RBAC_Action{
+	mesh_proto.AuthorizationActionAllow: rbac_config.RBAC_ALLOW,
+	mesh_proto.AuthorizationActionDeny:  rbac_config.RBAC_DENY,
+	mesh_proto.AuthorizationActionAudit: rbac_config.RBAC_LOG,
+}
+
+var rbacLog = core.Log.WithName("xds").WithName("rbac")
+
+// createRbacRules translates the policies with the given action into RBAC rules.
+// The destination side of the policies is expected to be resolved already, see permissions.MatchAuthorizationPolicies.
+// Rules whose condition can't be checked on the protocol are skipped, see createRbacPolicies.
+// Principals, namespaces and extends are matched against the identity of the peer certificate,
+// so they only match when the mesh has mTLS enabled. Samples are not enforced by Envoy.
+// It returns nil when there are no policies with the action, or no DENY or AUDIT rule is left.
+func createRbacRules(policies []*core_mesh.AuthorizationPolicyResource, action string, http bool) *rbac_config.RBAC {
+	rules := &rbac_config.RBAC{
+		Action:   rbacActions[action],
+		Policies: map[string]*rbac_config.Policy{},
+	}
+	found := false
+	for _, policy := range policies {
+		if policy.Spec.GetAction() != action {
+			continue
+		}
+		found = true
+		for name, rbacPolicy := range createRbacPolicies(policy, http) {
+			rules.Policies[name] = rbacPolicy
+		}
+	}
+	if !found {
+		return nil
+	}
+	if len(rules.Policies) == 0 {
+		if action != mesh_proto.AuthorizationActionAllow {
+			// an empty DENY or AUDIT filter matches nothing
+			return nil
+		}
+		rbacLog.Info("no ALLOW rule can be enforced on this protocol, all traffic is denied", "http", http)
+	}
+	return rules
+}
+
+func createRbacPolicies(policy *core_mesh.AuthorizationPolicyResource, http bool) map[string]*rbac_config.Policy {
+	name := policy.GetMeta().GetName()
+	if len(policy.Spec.GetRules()) == 0 {
+		return map[string]*rbac_config.Policy{
+			name: {
+				Permissions: []*rbac_config.Permission{anyPermission()},
+				Principals:  []*rbac_config.Principal{anyPrincipal()},
+			},
+		}
+	}
+
+	allMatch := policy.Spec.GetMatchType() == mesh_proto.AuthorizationMatchTypeAll
+	var principals []*rbac_config.Principal
+	var permissions []*rbac_config.Permission
+	result := map[string]*rbac_config.Policy{}
+	for i, rule := range policy.Spec.GetRules() {
+		permission, supported := createPermission(rule.GetWhen(), http)
+		if !supported {
+			// Turning the rule into one which matches everything or nothing would over-deny or over-allow,
+			// so the rule is left out. On ALLOW that means the traffic it would allow is denied.
+			if allMatch {
+				rbacLog.Info("skipping policy, the condition of one of its rules can't be checked on this protocol",
+					"policy", name, "rule", i, "http", http)
+				return nil
+			}
+			rbacLog.Info("skipping rule, its condition can't be checked on this protocol",
+				"policy", name, "rule", i, "http", http)
+			continue
+		}
+		principal := createPrincipal(rule.GetFrom())
+		if allMatch {
+			principals = append(principals, principal)
+			permissions = append(permissions, permission)
+			continue
+		}
+		result[fmt.Sprintf("%s-rule-%d", name, i)] = &rbac_config.Policy{
+			Permissions: []*rbac_config.Permission{permission},
+			Principals:  []*rbac_config.Principal{principal},
+		}
+	}
+	if allMatch {
+		result[name] = &rbac_config.Policy{
+			Permissions: []*rbac_config.Permission{andRules(permissions)},
+			Principals:  []*rbac_config.Principal{andIds(principals)},
+		}
+	}
+	return result
+}
+
+func createPrincipal(from *mesh_proto.AuthorizationPolicySource) *rbac_config.Principal {
+	var ids []*rbac_config.Principal
+	appendIds := func(principals []*rbac_config.Principal, negate bool) {
+		if len(principals) == 0 {
+			return
+		}
+		id := orIds(principals)
+		if negate {
+			id = &rbac_config.Principal{
+				Identifier: &rbac_config.Principal_NotId{NotId: id},
+			}
+		}
+		ids = append(ids, id)
+	}
+
+	appendIds(principalURIs(from.GetPrincipals()), false)
+	appendIds(principalURIs(from.GetNotPrincipals()), true)
+	appendIds(namespaceURIs(from.GetNamespaces()), false)
+	appendIds(namespaceURIs(from.GetNotNamespaces()), true)
+	appendIds(remoteIPs(from.GetIpBlocks()), false)
+	appendIds(remoteIPs(from.GetNotIpBlocks()), true)
+	appendIds(extendURIs(from.GetExtends()), false)
+	appendIds(extendURIs(from.GetNotExtends()), true)
+	return andIds(ids)
+}
+
+// createPermission returns the permission checking the condition and whether the condition can be checked at all.
+func createPermission(when *mesh_proto.AuthorizationPolicyCondition, http bool) (*rbac_config.Permission, bool) {
+	if len(when.GetValues()) == 0 && len(when.GetNotValues()) == 0 {
+		return anyPermission(), true
+	}
+	if !http {
+		return nil, false
+	}
+	header := strings.ToLower(when.GetKey())
+	var rules []*rbac_config.Permission
+	if len(when.GetValues()) > 0 {
+		values, ok := headerRules(header, when.GetValues())
+		if !ok {
+			return nil, false
+		}
+		rules = append(rules, orRules(values))
+	}
+	if len(when.GetNotValues()) > 0 {
+		notValues, ok := headerRules(header, when.GetNotValues())
+		if !ok {
+			return nil, false
+		}
+		rules = append(rules, &rbac_config.Permission{
+			Rule: &rbac_config.Permission_NotRule{NotRule: orRules(notValues)},
+		})
+	}
+	return andRules(rules), true
+}
+
+func headerRules(header string, matches []*mesh_proto.AuthorizationPolicyMatch) ([]*rbac_config.Permission, bool) {
+	var rules []*rbac_config.Permission
+	for _, match := range matches {
+		stringMatcher := &envoy_matcher.StringMatcher{}
+		switch match.GetType() {
+		case "", mesh_proto.AuthorizationMatchEquals:
+			stringMatcher.MatchPattern = &envoy_matcher.StringMatcher_Exact{Exact: match.GetValue()}
+		case mesh_proto.AuthorizationMatchRegex:
+			stringMatcher.MatchPattern = &envoy_matcher.StringMatcher_SafeRegex{
+				SafeRegex: &envoy_matcher.RegexMatcher{Regex: match.GetValue()},
+			}
+		default:
+			// ognl expressions are evaluated by Dubbo SDKs only
+			return nil, false
+		}
+		rules = append(rules, &rbac_config.Permission{
+			Rule: &rbac_config.Permission_Header{
+				Header: &envoy_route.HeaderMatcher{
+					Name: header,
+					HeaderMatchSpecifier: &envoy_route.HeaderMatcher_StringMatch{
+						StringMatch: stringMatcher,
+					},
+				},
+			},
+		})
+	}
+	return rules, true
+}
+
+func principalURIs(principals []string) []*rbac_config.Principal {
+	var ids []*rbac_config.Principal
+	for _, principal := range principals {
+		ids = append(ids, authenticated(mesh_proto.PrincipalURI(principal)))
+	}
+	return ids
+}
+
+func namespaceURIs(namespaces []string) []*rbac_config.Principal {
+	var ids []*rbac_config.Principal
+	for _, namespace := range namespaces {
+		ids = append(ids, authenticated(mesh_proto.ExtendURI(mesh_proto.KubeNamespaceTag, namespace)))
+	}
+	return ids
+}
+
+func extendURIs(extends []*mesh_proto.AuthorizationPolicyExtend) []*rbac_config.Principal {
+	var ids []*rbac_config.Principal
+	for _, extend := range extends {
+		ids = append(ids, authenticated(mesh_proto.ExtendURI(extend.GetKey(), extend.GetValue())))
+	}
+	return ids
+}
+
+func remoteIPs(blocks []string) []*rbac_config.Principal {
+	var ids []*rbac_config.Principal
+	for _, block := range blocks {
+		cidr := cidrRange(block)
+		if cidr == nil {
+			continue
+		}
+		ids = append(ids, &rbac_config.Principal{
+			Identifier: &rbac_config.Principal_DirectRemoteIp{DirectRemoteIp: cidr},
+		})
+	}
+	return ids
+}
+
+func cidrRange(block string) *envoy_core.CidrRange {
+	if !strings.Contains(block, "/") {
+		ip := net.ParseIP(block)
+		if ip == nil {
+			return nil
+		}
+		prefixLen := 128
+		if ip.To4() != nil {
+			prefixLen = 32
+		}
+		return &envoy_core.CidrRange{
+			AddressPrefix: ip.String(),
+			PrefixLen:     wrapperspb.UInt32(uint32(prefixLen)),
+		}
+	}
+	ip, ipNet, err := net.ParseCIDR(block)
+	if err != nil {
+		return nil
+	}
+	prefixLen, _ := ipNet.Mask.Size()
+	return &envoy_core.CidrRange{
+		AddressPrefix: ip.String(),
+		PrefixLen:     wrapperspb.UInt32(uint32(prefixLen)),
+	}
+}
+
+func authenticated(uri string) *rbac_config.Principal {
+	return &rbac_config.Principal{
+		Identifier: &rbac_config.Principal_Authenticated_{
+			Authenticated: &rbac_config.Principal_Authenticated{
+				PrincipalName: &envoy_matcher.StringMatcher{
+					MatchPattern: &envoy_matcher.StringMatcher_Exact{Exact: uri},
+				},
+			},
+		},
+	}
+}
+
+func anyPrincipal() *rbac_config.Principal {
+	return &rbac_config.Principal{
+		Identifier: &rbac_config.Principal_Any{Any: true},
+	}
+}
+
+func anyPermission() *rbac_config.Permission {
+	return &rbac_config.Permission{
+		Rule: &rbac_config.Permission_Any{Any: true},
+	}
+}
+
+func orIds(ids []*rbac_config.Principal) *rbac_config.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &rbac_config.Principal{
+		Identifier: &rbac_config.Principal_OrIds{OrIds: &rbac_config.Principal_Set{Ids: ids}},
+	}
+}
+
+func andIds(ids []*rbac_config.Principal) *rbac_config.Principal {
+	switch len(ids) {
+	case 0:
+		return anyPrincipal()
+	case 1:
+		return ids[0]
+	}
+	return &rbac_config.Principal{
+		Identifier: &rbac_config.Principal_AndIds{AndIds: &rbac_config.Principal_Set{Ids: ids}},
+	}
+}
+
+func orRules(rules []*rbac_config.Permission) *rbac_config.Permission {
+	if len(rules) == 1 {
+		return rules[0]
+	}
+	return &rbac_config.Permission{
+		Rule: &rbac_config.Permission_OrRules{OrRules: &rbac_config.Permission_Set{Rules: rules}},
+	}
+}
+
+func andRules(rules []*rbac_config.Permission) *rbac_config.Permission {
+	switch len(rules) {
+	case 0:
+		return anyPermission()
+	case 1:
+		return rules[0]
+	}
+	return &rbac_config.Permission{
+		Rule: &rbac_config.Permission_AndRules{AndRules: &rbac_config.Permission_Set{Rules: rules}},
+	}
+}
@@ -0,0 +1,161 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	. "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners"
+)
+
+func authorizationPolicy(name string, spec string) *core_mesh.AuthorizationPolicyResource {
+	policy := &core_mesh.AuthorizationPolicyResource{
+		Meta: &test_model.ResourceMeta{Name: name, Mesh: core_model.DefaultMesh},
+		Spec: &mesh_proto.AuthorizationPolicy{},
+	}
+	Expect(util_proto.FromYAML([]byte(spec), policy.Spec)).To(Succeed())
+	return policy
+}
+
+const (
+	allowByHeaders = `
+action: ALLOW
+rules:
+- from:
+    principals:
+    - shop
+    ipBlocks:
+    - 10.0.0.0/8
+  when:
+    key: X-Method
+    values:
+    - value: getDetail
+    - type: regex
+      value: list.*
+    notValues:
+    - value: delete
+- from:
+    notNamespaces:
+    - test
+`
+	allowByOgnl = `
+action: ALLOW
+rules:
+- when:
+    key: method
+    values:
+    - type: ognl
+      value: "method.name == 'getDetail'"
+- from:
+    principals:
+    - shop
+`
+	denyAll = `
+action: DENY
+matchType: allMatch
+rules:
+- from:
+    ipBlocks:
+    - 192.168.0.1
+- when:
+    key: x-env
+    values:
+    - value: gray
+`
+	auditAll = `
+action: AUDIT
+`
+)
+
+var _ = Describe("HttpRBACConfigurer", func() {
+	DescribeTable("should generate RBAC http filters",
+		func(policies []*core_mesh.AuthorizationPolicyResource, goldenFile string) {
+			// when
+			filterChain, err := NewFilterChainBuilder(core_xds.APIVersion(envoy_common.APIV3), "inbound").
+				Configure(HttpConnectionManager("inbound", false)).
+				Configure(HttpRBAC(policies)).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(matchers.MatchGoldenYAML("testdata", goldenFile))
+		},
+		Entry("header conditions, principals and ip blocks",
+			[]*core_mesh.AuthorizationPolicyResource{authorizationPolicy("allow-shop", allowByHeaders)},
+			"rbac.http.headers.golden.yaml"),
+		Entry("ognl conditions are skipped",
+			[]*core_mesh.AuthorizationPolicyResource{authorizationPolicy("allow-ognl", allowByOgnl)},
+			"rbac.http.ognl.golden.yaml"),
+		Entry("audit, deny and allow in order",
+			[]*core_mesh.AuthorizationPolicyResource{
+				authorizationPolicy("allow-shop", allowByHeaders),
+				authorizationPolicy("deny-all", denyAll),
+				authorizationPolicy("audit-all", auditAll),
+			},
+			"rbac.http.actions.golden.yaml"),
+	)
+})
+
+var _ = Describe("NetworkRBACConfigurer", func() {
+	DescribeTable("should generate RBAC network filters",
+		func(policies []*core_mesh.AuthorizationPolicyResource, goldenFile string) {
+			// when
+			filterChain, err := NewFilterChainBuilder(core_xds.APIVersion(envoy_common.APIV3), "inbound").
+				Configure(NetworkRBAC("inbound:127.0.0.1:20880", policies)).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(matchers.MatchGoldenYAML("testdata", goldenFile))
+		},
+		Entry("rules with http conditions are skipped",
+			[]*core_mesh.AuthorizationPolicyResource{authorizationPolicy("allow-shop", allowByHeaders)},
+			"rbac.network.skipped.golden.yaml"),
+		Entry("allow rules which all need http deny everything",
+			[]*core_mesh.AuthorizationPolicyResource{authorizationPolicy("allow-ognl", `
+action: ALLOW
+rules:
+- when:
+    key: method
+    values:
+    - value: getDetail
+`)},
+			"rbac.network.deny-everything.golden.yaml"),
+		Entry("deny policies which need http are left out",
+			[]*core_mesh.AuthorizationPolicyResource{
+				authorizationPolicy("deny-all", denyAll),
+				authorizationPolicy("audit-all", auditAll),
+			},
+			"rbac.network.deny-skipped.golden.yaml"),
+	)
+})
@@ -0,0 +1,80 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	. "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("ServerSideMTLSConfigurer", func() {
+	type testCase struct {
+		enabledBackend string
+		goldenFile     string
+	}
+
+	DescribeTable("should secure the filter chain with the identity of the proxy",
+		func(given testCase) {
+			// given
+			mesh := &core_mesh.MeshResource{
+				Meta: &test_model.ResourceMeta{Name: core_model.DefaultMesh},
+				Spec: &mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: given.enabledBackend,
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{Name: "ca-1", Type: "builtin"},
+						},
+					},
+				},
+			}
+			tracker := envoy_common.NewSecretsTracker(core_model.DefaultMesh, []string{core_model.DefaultMesh})
+
+			// when
+			filterChain, err := NewFilterChainBuilder(core_xds.APIVersion(envoy_common.APIV3), "inbound").
+				Configure(ServerSideMTLS(mesh, tracker)).
+				Build()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+			Expect(tracker.UsedIdentity()).To(Equal(given.enabledBackend != ""))
+		},
+		Entry("mTLS enabled", testCase{
+			enabledBackend: "ca-1",
+			goldenFile:     "server-mtls.enabled.golden.yaml",
+		}),
+		Entry("mTLS disabled", testCase{
+			enabledBackend: "",
+			goldenFile:     "server-mtls.disabled.golden.yaml",
+		}),
+	)
+})
@@ -0,0 +1,130 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v3
+
+import (
+	net_url "net/url"
+)
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_trace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	"github.com/pkg/errors"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy/names"
+)
+
+const defaultTracingSampling = 100.0
+
+// TracingConfigurer reports the spans of the requests handled by the HttpConnectionManager
+// to the given tracing backend of the mesh.
+type TracingConfigurer struct {
+	Backend *mesh_proto.TracingBackend
+}
+
+var _ FilterChainConfigurer = &TracingConfigurer{}
+
+func (c *TracingConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.Backend == nil {
+		return nil
+	}
+	return UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+		tracing, err := c.tracing()
+		if err != nil {
+			return err
+		}
+		hcm.Tracing = tracing
+		return nil
+	})
+}
+
+func (c *TracingConfigurer) tracing() (*envoy_hcm.HttpConnectionManager_Tracing, error) {
+	sampling := defaultTracingSampling
+	if c.Backend.GetSampling() != nil {
+		sampling = c.Backend.GetSampling().GetValue()
+	}
+
+	var provider *envoy_trace.Tracing_Http
+	switch c.Backend.GetType() {
+	case mesh_proto.TracingZipkinType:
+		zipkin, err := ZipkinConfig(c.Backend)
+		if err != nil {
+			return nil, err
+		}
+		typedConfig, err := util_proto.MarshalAnyDeterministic(zipkin)
+		if err != nil {
+			return nil, err
+		}
+		provider = &envoy_trace.Tracing_Http{
+			Name: "envoy.tracers.zipkin",
+			ConfigType: &envoy_trace.Tracing_Http_TypedConfig{
+				TypedConfig: typedConfig,
+			},
+		}
+	default:
+		return nil, errors.Errorf("tracing backend %q has unsupported type %q", c.Backend.GetName(), c.Backend.GetType())
+	}
+
+	return &envoy_hcm.HttpConnectionManager_Tracing{
+		RandomSampling: &envoy_type.Percent{Value: sampling},
+		Provider:       provider,
+	}, nil
+}
+
+// ZipkinConfig converts the configuration of the zipkin tracing backend into the configuration of the Envoy tracer.
+// Spans are sent to the cluster named after the backend, see names.GetTracingClusterName.
+func ZipkinConfig(backend *mesh_proto.TracingBackend) (*envoy_trace.ZipkinConfig, error) {
+	cfg := &mesh_proto.ZipkinTracingBackendConfig{}
+	if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+		return nil, errors.Wrapf(err, "could not parse the configuration of tracing backend %q", backend.GetName())
+	}
+	url, err := net_url.ParseRequestURI(cfg.GetUrl())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid url of tracing backend %q", backend.GetName())
+	}
+	version, err := zipkinCollectorEndpointVersion(cfg.GetApiVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_trace.ZipkinConfig{
+		CollectorCluster:         names.GetTracingClusterName(backend.GetName()),
+		CollectorEndpoint:        url.Path,
+		CollectorHostname:        url.Host,
+		TraceId_128Bit:           cfg.GetTraceId128Bit(),
+		CollectorEndpointVersion: version,
+		SharedSpanContext:        cfg.GetSharedSpanContext(),
+	}, nil
+}
+
+func zipkinCollectorEndpointVersion(apiVersion string) (envoy_trace.ZipkinConfig_CollectorEndpointVersion, error) {
+	switch apiVersion {
+	case "", "httpJson":
+		return envoy_trace.ZipkinConfig_HTTP_JSON, nil
+	case "httpProto":
+		return envoy_trace.ZipkinConfig_HTTP_PROTO, nil
+	default:
+		return 0, errors.Errorf("unsupported zipkin api version %q", apiVersion)
+	}
+}
@@ -98,6 +98,10 @@ func GetDNSListenerName() string {
 	return Join("dubbo", "dns")
 }
 
+func GetProbeListenerName() string {
+	return Join("dubbo", "probes")
+}
+
 func GetGatewayListenerName(gatewayName string, protoName string, port uint32) string {
 	return Join(gatewayName, protoName, formatPort(port))
 }
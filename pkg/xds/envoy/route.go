@@ -18,9 +18,31 @@
 package envoy
 
 type Route struct {
+	Match    *RouteMatch
 	Clusters []Cluster
 }
 
+// RouteMatch narrows down the requests handled by a route.
+// A route without a match handles every request.
+type RouteMatch struct {
+	ExactPath  string
+	PrefixPath string
+}
+
+func (m *RouteMatch) GetExactPath() string {
+	if m == nil {
+		return ""
+	}
+	return m.ExactPath
+}
+
+func (m *RouteMatch) GetPrefixPath() string {
+	if m == nil {
+		return ""
+	}
+	return m.PrefixPath
+}
+
 func NewRouteFromCluster(cluster Cluster) Route {
 	return Route{
 		Clusters: []Cluster{cluster},
@@ -60,3 +82,9 @@ func WithCluster(cluster Cluster) NewRouteOpt {
 		route.Clusters = append(route.Clusters, cluster)
 	})
 }
+
+func WithMatchExactPath(path string) NewRouteOpt {
+	return newRouteOptFunc(func(route *Route) {
+		route.Match = &RouteMatch{ExactPath: path}
+	})
+}
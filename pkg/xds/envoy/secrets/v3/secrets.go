@@ -0,0 +1,66 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v3
+
+import (
+	"bytes"
+)
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+)
+
+import (
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+)
+
+func CreateCaSecret(secret *core_xds.CaSecret, name string) *envoy_auth.Secret {
+	return &envoy_auth.Secret{
+		Name: name,
+		Type: &envoy_auth.Secret_ValidationContext{
+			ValidationContext: &envoy_auth.CertificateValidationContext{
+				TrustedCa: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: bytes.Join(secret.PemCerts, []byte("\n")),
+					},
+				},
+			},
+		},
+	}
+}
+
+func CreateIdentitySecret(secret *core_xds.IdentitySecret, name string) *envoy_auth.Secret {
+	return &envoy_auth.Secret{
+		Name: name,
+		Type: &envoy_auth.Secret_TlsCertificate{
+			TlsCertificate: &envoy_auth.TlsCertificate{
+				CertificateChain: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: bytes.Join(secret.PemCerts, []byte("\n")),
+					},
+				},
+				PrivateKey: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: secret.PemKey,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tls
+
+import (
+	"fmt"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tags"
+)
+
+const (
+	// IdentityCertResource is the category of the SDS secret holding the identity certificate of a Dataplane.
+	IdentityCertResource = "identity_cert"
+	// MeshCaResource is the category of the SDS secret holding the trust bundle of a Mesh.
+	MeshCaResource = "mesh_ca"
+)
+
+// DubboALPNProtocols are set for UpstreamTlsContext to show that mTLS is created by the mesh.
+var DubboALPNProtocols = []string{"dubbo"}
+
+// MeshSpiffeIDPrefix returns the prefix shared by SPIFFE IDs of all services in the mesh.
+func MeshSpiffeIDPrefix(mesh string) string {
+	return fmt.Sprintf("spiffe://%s/", mesh)
+}
+
+// SNIFromTags builds the SNI of an upstream cluster: the service name followed by the remaining tags.
+func SNIFromTags(tags tags.Tags) string {
+	extraTags := tags.WithoutTags(mesh_proto.ServiceTag).String()
+	service := tags[mesh_proto.ServiceTag]
+	if extraTags == "" {
+		return service
+	}
+	return fmt.Sprintf("%s{%s}", service, extraTags)
+}
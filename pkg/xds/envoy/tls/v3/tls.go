@@ -0,0 +1,188 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	xds_tls "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tls"
+)
+
+// CreateDownstreamTlsContext creates DownstreamTlsContext for incoming connections
+// It verifies that incoming connection has TLS certificate signed by Mesh CA with URI SAN of prefix spiffe://{mesh_name}/
+// It secures inbound listener with certificate of "identity_cert" that will be received from the SDS (it contains URI SANs of all inbounds).
+func CreateDownstreamTlsContext(downstreamMesh *core_mesh.MeshResource, secretsTracker core_xds.SecretsTracker) (*envoy_tls.DownstreamTlsContext, error) {
+	if !downstreamMesh.MTLSEnabled() {
+		return nil, nil
+	}
+	validationSANMatchers := MeshSpiffeIDPrefixMatcher(downstreamMesh.GetMeta().GetName())
+	commonTlsContext := createCommonTlsContext(
+		secretsTracker.RequestIdentityCert(),
+		secretsTracker.RequestCa(downstreamMesh.GetMeta().GetName()),
+		validationSANMatchers,
+	)
+	return &envoy_tls.DownstreamTlsContext{
+		CommonTlsContext:         commonTlsContext,
+		RequireClientCertificate: util_proto.Bool(true),
+	}, nil
+}
+
+// CreateUpstreamTlsContext creates UpstreamTlsContext for outgoing connections
+// It verifies that the upstream server has TLS certificate signed by Mesh CA with URI SAN of spiffe://{mesh_name}/{upstream_service}
+// The downstream client exposes for the upstream server cert with multiple URI SANs, which means that if DP has inbound with services "web" and "web-api" and communicates with "backend"
+// the upstream server ("backend") will see that DP with TLS certificate of URIs of "web" and "web-api".
+//
+// Pass "*" for upstreamService to validate that upstream service is a service that is part of the mesh (but not specific one)
+func CreateUpstreamTlsContext(identity core_xds.IdentityCertRequest, ca core_xds.CaRequest, upstreamService string, sni string) (*envoy_tls.UpstreamTlsContext, error) {
+	var validationSANMatchers []*envoy_tls.SubjectAltNameMatcher
+	for _, meshName := range ca.MeshName() {
+		if upstreamService == "*" {
+			validationSANMatchers = append(validationSANMatchers, MeshSpiffeIDPrefixMatcher(meshName)...)
+		} else {
+			validationSANMatchers = append(validationSANMatchers, &envoy_tls.SubjectAltNameMatcher{
+				SanType: envoy_tls.SubjectAltNameMatcher_URI,
+				Matcher: ServiceSpiffeIDMatcher(meshName, upstreamService),
+			})
+		}
+	}
+	commonTlsContext := createCommonTlsContext(identity, ca, validationSANMatchers)
+	commonTlsContext.AlpnProtocols = xds_tls.DubboALPNProtocols
+	return &envoy_tls.UpstreamTlsContext{
+		CommonTlsContext: commonTlsContext,
+		Sni:              sni,
+	}, nil
+}
+
+// UpstreamTlsContextOutsideMesh creates UpstreamTlsContext for connections to the services outside the mesh.
+// The certificates are provided inline, because they are not issued by the Mesh CA.
+// Unless hostname verification is skipped, the upstream has to present a certificate with the DNS SAN of the hostname.
+func UpstreamTlsContextOutsideMesh(
+	ca, cert, key []byte,
+	allowRenegotiation bool,
+	skipHostnameVerification bool,
+	hostname string,
+	sni string,
+) (*envoy_tls.UpstreamTlsContext, error) {
+	var tlsCertificates []*envoy_tls.TlsCertificate
+	if cert != nil && key != nil {
+		tlsCertificates = []*envoy_tls.TlsCertificate{
+			{
+				CertificateChain: dataSourceFromBytes(cert),
+				PrivateKey:       dataSourceFromBytes(key),
+			},
+		}
+	}
+
+	var validationContextType *envoy_tls.CommonTlsContext_ValidationContext
+	if ca != nil {
+		var matchNames []*envoy_tls.SubjectAltNameMatcher
+		if !skipHostnameVerification {
+			matchNames = []*envoy_tls.SubjectAltNameMatcher{{
+				SanType: envoy_tls.SubjectAltNameMatcher_DNS,
+				Matcher: &envoy_type_matcher.StringMatcher{
+					MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+						Exact: hostname,
+					},
+				},
+			}}
+		}
+		validationContextType = &envoy_tls.CommonTlsContext_ValidationContext{
+			ValidationContext: &envoy_tls.CertificateValidationContext{
+				TrustedCa:                 dataSourceFromBytes(ca),
+				MatchTypedSubjectAltNames: matchNames,
+			},
+		}
+	}
+
+	return &envoy_tls.UpstreamTlsContext{
+		AllowRenegotiation: allowRenegotiation,
+		Sni:                sni,
+		CommonTlsContext: &envoy_tls.CommonTlsContext{
+			TlsCertificates:       tlsCertificates,
+			ValidationContextType: validationContextType,
+		},
+	}, nil
+}
+
+func dataSourceFromBytes(bytes []byte) *envoy_core.DataSource {
+	return &envoy_core.DataSource{
+		Specifier: &envoy_core.DataSource_InlineBytes{
+			InlineBytes: bytes,
+		},
+	}
+}
+
+func createCommonTlsContext(ownIdentity core_xds.IdentityCertRequest, ca core_xds.CaRequest, validationSANMatchers []*envoy_tls.SubjectAltNameMatcher) *envoy_tls.CommonTlsContext {
+	meshCaSecret := NewSecretConfigSource(ca.Name())
+	identitySecret := NewSecretConfigSource(ownIdentity.Name())
+
+	return &envoy_tls.CommonTlsContext{
+		ValidationContextType: &envoy_tls.CommonTlsContext_CombinedValidationContext{
+			CombinedValidationContext: &envoy_tls.CommonTlsContext_CombinedCertificateValidationContext{
+				DefaultValidationContext: &envoy_tls.CertificateValidationContext{
+					MatchTypedSubjectAltNames: validationSANMatchers,
+				},
+				ValidationContextSdsSecretConfig: meshCaSecret,
+			},
+		},
+		TlsCertificateSdsSecretConfigs: []*envoy_tls.SdsSecretConfig{
+			identitySecret,
+		},
+	}
+}
+
+// NewSecretConfigSource points Envoy to a secret delivered over ADS.
+func NewSecretConfigSource(secretName string) *envoy_tls.SdsSecretConfig {
+	return &envoy_tls.SdsSecretConfig{
+		Name: secretName,
+		SdsConfig: &envoy_core.ConfigSource{
+			ResourceApiVersion: envoy_core.ApiVersion_V3,
+			ConfigSourceSpecifier: &envoy_core.ConfigSource_Ads{
+				Ads: &envoy_core.AggregatedConfigSource{},
+			},
+		},
+	}
+}
+
+func MeshSpiffeIDPrefixMatcher(mesh string) []*envoy_tls.SubjectAltNameMatcher {
+	stringMatcher := &envoy_type_matcher.StringMatcher{
+		MatchPattern: &envoy_type_matcher.StringMatcher_Prefix{
+			Prefix: xds_tls.MeshSpiffeIDPrefix(mesh),
+		},
+	}
+	return []*envoy_tls.SubjectAltNameMatcher{{
+		SanType: envoy_tls.SubjectAltNameMatcher_URI,
+		Matcher: stringMatcher,
+	}}
+}
+
+func ServiceSpiffeIDMatcher(mesh string, service string) *envoy_type_matcher.StringMatcher {
+	return &envoy_type_matcher.StringMatcher{
+		MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+			Exact: mesh_proto.SpiffeID(mesh, service),
+		},
+	}
+}
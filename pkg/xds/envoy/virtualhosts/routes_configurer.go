@@ -19,9 +19,12 @@ package virtualhosts
 
 import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+
+	"github.com/pkg/errors"
 )
 
 import (
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
 	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
 )
 
@@ -30,5 +33,75 @@ type RoutesConfigurer struct {
 }
 
 func (c RoutesConfigurer) Configure(virtualHost *envoy_config_route_v3.VirtualHost) error {
+	for _, route := range c.Routes {
+		if len(route.Clusters) == 0 {
+			continue
+		}
+		action, err := c.routeAction(route.Clusters)
+		if err != nil {
+			return err
+		}
+		virtualHost.Routes = append(virtualHost.Routes, &envoy_config_route_v3.Route{
+			Match: c.routeMatch(route.Match),
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: action,
+			},
+		})
+	}
 	return nil
 }
+
+func (c RoutesConfigurer) routeMatch(match *envoy_common.RouteMatch) *envoy_config_route_v3.RouteMatch {
+	switch {
+	case match.GetExactPath() != "":
+		return &envoy_config_route_v3.RouteMatch{
+			PathSpecifier: &envoy_config_route_v3.RouteMatch_Path{
+				Path: match.GetExactPath(),
+			},
+		}
+	case match.GetPrefixPath() != "":
+		return &envoy_config_route_v3.RouteMatch{
+			PathSpecifier: &envoy_config_route_v3.RouteMatch_Prefix{
+				Prefix: match.GetPrefixPath(),
+			},
+		}
+	default:
+		return &envoy_config_route_v3.RouteMatch{
+			PathSpecifier: &envoy_config_route_v3.RouteMatch_Prefix{
+				Prefix: "/",
+			},
+		}
+	}
+}
+
+func (c RoutesConfigurer) routeAction(clusters []envoy_common.Cluster) (*envoy_config_route_v3.RouteAction, error) {
+	if len(clusters) == 1 {
+		return &envoy_config_route_v3.RouteAction{
+			ClusterSpecifier: &envoy_config_route_v3.RouteAction_Cluster{
+				Cluster: clusters[0].Name(),
+			},
+		}, nil
+	}
+	var weightedClusters []*envoy_config_route_v3.WeightedCluster_ClusterWeight
+	for _, cluster := range clusters {
+		clusterImpl, ok := cluster.(*envoy_common.ClusterImpl)
+		if !ok {
+			return nil, errors.Errorf("cluster %q of a weighted route has no weight, got %T", cluster.Name(), cluster)
+		}
+		weight := clusterImpl.Weight()
+		if weight == 0 {
+			weight = 1
+		}
+		weightedClusters = append(weightedClusters, &envoy_config_route_v3.WeightedCluster_ClusterWeight{
+			Name:   cluster.Name(),
+			Weight: util_proto.UInt32(weight),
+		})
+	}
+	return &envoy_config_route_v3.RouteAction{
+		ClusterSpecifier: &envoy_config_route_v3.RouteAction_WeightedClusters{
+			WeightedClusters: &envoy_config_route_v3.WeightedCluster{
+				Clusters: weightedClusters,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,182 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package virtualhosts_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tags"
+	. "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/virtualhosts"
+)
+
+// opaqueCluster is a Cluster that carries no weight.
+type opaqueCluster struct {
+	name string
+}
+
+func (c opaqueCluster) Service() string         { return c.name }
+func (c opaqueCluster) Name() string            { return c.name }
+func (c opaqueCluster) Mesh() string            { return "" }
+func (c opaqueCluster) Tags() tags.Tags         { return nil }
+func (c opaqueCluster) Hash() string            { return c.name }
+func (c opaqueCluster) IsExternalService() bool { return false }
+
+var _ = Describe("RoutesConfigurer", func() {
+	backend := envoy_common.NewCluster(
+		envoy_common.WithService("backend"),
+		envoy_common.WithName("backend"),
+	)
+	backendV1 := envoy_common.NewCluster(
+		envoy_common.WithService("backend"),
+		envoy_common.WithName("backend-v1"),
+		envoy_common.WithWeight(90),
+	)
+	backendV2 := envoy_common.NewCluster(
+		envoy_common.WithService("backend"),
+		envoy_common.WithName("backend-v2"),
+	)
+
+	type testCase struct {
+		routes   envoy_common.Routes
+		expected string
+	}
+
+	DescribeTable("should generate routes",
+		func(given testCase) {
+			// when
+			virtualHost, err := NewVirtualHostBuilder(core_xds.APIVersion(envoy_common.APIV3), "backend").
+				Configure(Routes(given.routes)).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			actual, err := proto.ToYAML(virtualHost)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("catch-all route to a single cluster", testCase{
+			routes: envoy_common.Routes{
+				envoy_common.NewRoute(envoy_common.WithCluster(backend)),
+			},
+			expected: `
+            domains:
+            - '*'
+            name: backend
+            routes:
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("exact and prefix paths before the catch-all route", testCase{
+			routes: envoy_common.Routes{
+				envoy_common.NewRoute(
+					envoy_common.WithMatchExactPath("/org.apache.dubbo.GreetService/greet"),
+					envoy_common.WithCluster(backend),
+				),
+				{
+					Match:    &envoy_common.RouteMatch{PrefixPath: "/org.apache.dubbo.GreetService/"},
+					Clusters: []envoy_common.Cluster{backend},
+				},
+				envoy_common.NewRoute(envoy_common.WithCluster(backend)),
+			},
+			expected: `
+            domains:
+            - '*'
+            name: backend
+            routes:
+            - match:
+                path: /org.apache.dubbo.GreetService/greet
+              route:
+                cluster: backend
+            - match:
+                prefix: /org.apache.dubbo.GreetService/
+              route:
+                cluster: backend
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("weighted clusters with the zero weight defaulting to 1", testCase{
+			routes: envoy_common.Routes{
+				envoy_common.NewRoute(
+					envoy_common.WithCluster(backendV1),
+					envoy_common.WithCluster(backendV2),
+				),
+			},
+			expected: `
+            domains:
+            - '*'
+            name: backend
+            routes:
+            - match:
+                prefix: /
+              route:
+                weightedClusters:
+                  clusters:
+                  - name: backend-v1
+                    weight: 90
+                  - name: backend-v2
+                    weight: 1
+`,
+		}),
+		Entry("routes without clusters are skipped", testCase{
+			routes: envoy_common.Routes{
+				envoy_common.NewRoute(envoy_common.WithMatchExactPath("/unused")),
+				envoy_common.NewRoute(envoy_common.WithCluster(backend)),
+			},
+			expected: `
+            domains:
+            - '*'
+            name: backend
+            routes:
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+	)
+
+	It("should fail on a weighted route with a cluster that has no weight", func() {
+		// given
+		routes := envoy_common.Routes{
+			envoy_common.NewRoute(
+				envoy_common.WithCluster(backendV1),
+				envoy_common.WithCluster(opaqueCluster{name: "opaque"}),
+			),
+		}
+
+		// when
+		_, err := NewVirtualHostBuilder(core_xds.APIVersion(envoy_common.APIV3), "backend").
+			Configure(Routes(routes)).
+			Build()
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`cluster "opaque" of a weighted route has no weight`)))
+	})
+})
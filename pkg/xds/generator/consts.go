@@ -27,3 +27,6 @@ const (
 	allIPv4           = "0.0.0.0"
 	allIPv6           = "::"
 )
+
+// methodsParam is the parameter of a Dubbo service listing the methods it exposes.
+const methodsParam = "methods"
@@ -0,0 +1,64 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"context"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_listeners "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners"
+	envoy_names "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/names"
+)
+
+// OriginDNS is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginDNS = "dns"
+
+// DNSGenerator generates the listener that resolves the domains of the services to their virtual IPs,
+// it is generated only when the proxy runs with the builtin DNS.
+type DNSGenerator struct{}
+
+func (g DNSGenerator) Generator(_ context.Context, _ *core_xds.ResourceSet, xdsCtx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	dnsPort := proxy.Metadata.GetDNSPort()
+	if dnsPort == 0 {
+		return nil, nil
+	}
+
+	listenerName := envoy_names.GetDNSListenerName()
+	listener, err := envoy_listeners.NewInboundListenerBuilder(proxy.APIVersion, core_mesh.IPv4Loopback.String(), dnsPort, core_xds.SocketAddressProtocolUDP).
+		WithOverwriteName(listenerName).
+		Configure(envoy_listeners.DNS(xdsCtx.Mesh.VIPDomains)).
+		Build()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not generate listener %s", listenerName)
+	}
+	resources := core_xds.NewResourceSet()
+	resources.Add(&core_xds.Resource{
+		Name:     listenerName,
+		Resource: listener,
+		Origin:   OriginDNS,
+	})
+	return resources, nil
+}
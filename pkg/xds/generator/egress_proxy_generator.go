@@ -0,0 +1,238 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"context"
+	"sort"
+)
+
+import (
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+
+	"github.com/pkg/errors"
+
+	"golang.org/x/exp/maps"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	envoy_clusters "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/clusters"
+	envoy_listeners "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners"
+	envoy_names "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/names"
+	envoy_secrets "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/secrets/v3"
+	envoy_tags "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tags"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tls"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/generator/zoneproxy"
+)
+
+// Egress is a marker to indicate by which ProxyGenerator resources were generated.
+const Egress = "egress"
+
+// EgressGenerator generates the configuration of ZoneEgress. Data plane proxies of meshes
+// with zoneEgress enabled send the traffic to other zones through ZoneEgress, which picks
+// the destination by the SNI of the mTLS connection and passes it through to the ZoneIngress
+// of the zone the service lives in. The traffic to external services is the exception:
+// ZoneEgress terminates the mTLS and originates the connection to the external service itself.
+type EgressGenerator struct{}
+
+func (g EgressGenerator) Generator(ctx context.Context, _ *core_xds.ResourceSet, xdsCtx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+
+	networking := proxy.ZoneEgressProxy.ZoneEgressResource.Spec.GetNetworking()
+	address, port := networking.GetAddress(), networking.GetPort()
+	listenerBuilder := envoy_listeners.NewInboundListenerBuilder(proxy.APIVersion, address, port, core_xds.SocketAddressProtocolTCP).
+		Configure(envoy_listeners.TLSInspector())
+
+	for _, mr := range proxy.ZoneEgressProxy.MeshResourcesList {
+		meshName := mr.Mesh.GetMeta().GetName()
+		dest := zoneproxy.BuildMeshDestinations(
+			nil,
+			xds_context.Resources{MeshLocalResources: mr.Resources},
+		)
+
+		services := g.addFilterChains(proxy.APIVersion, listenerBuilder, meshName, dest, mr.EndpointMap)
+
+		cdsResources, err := zoneproxy.GenerateCDS(dest, services, proxy.APIVersion, meshName, Egress)
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(cdsResources...)
+
+		edsResources, err := zoneproxy.GenerateEDS(services, mr.EndpointMap, proxy.APIVersion, meshName, Egress)
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(edsResources...)
+
+		esResources, err := g.generateExternalServices(ctx, xdsCtx, proxy, listenerBuilder, mr)
+		if err != nil {
+			return nil, err
+		}
+		resources.AddSet(esResources)
+	}
+
+	listener, err := listenerBuilder.Build()
+	if err != nil {
+		return nil, err
+	}
+	if len(listener.(*envoy_listener_v3.Listener).FilterChains) > 0 {
+		resources.Add(&core_xds.Resource{
+			Name:     listener.GetName(),
+			Origin:   Egress,
+			Resource: listener,
+		})
+	}
+
+	return resources, nil
+}
+
+// addFilterChains adds a filter chain for every destination of the services of the mesh.
+// The filter chain matches the SNI set by the client side mTLS of the data plane proxy,
+// so the TLS connection is not terminated on ZoneEgress.
+func (g EgressGenerator) addFilterChains(
+	apiVersion core_xds.APIVersion,
+	listenerBuilder *envoy_listeners.ListenerBuilder,
+	meshName string,
+	destinationsPerService map[string][]envoy_tags.Tags,
+	endpointMap core_xds.EndpointMap,
+) envoy_common.Services {
+	servicesAcc := envoy_common.NewServicesAccumulator(nil)
+
+	serviceNames := maps.Keys(endpointMap)
+	sort.Strings(serviceNames)
+
+	sniUsed := map[string]struct{}{}
+	for _, serviceName := range serviceNames {
+		destinations := destinationsPerService[serviceName]
+		destinations = append(destinations, destinationsPerService[mesh_proto.MatchAllTag]...)
+		clusterName := envoy_names.GetMeshClusterName(meshName, serviceName)
+
+		for _, destination := range destinations {
+			meshDestination := destination.
+				WithTags(mesh_proto.ServiceTag, serviceName).
+				WithTags("mesh", meshName)
+
+			sni := tls.SNIFromTags(meshDestination)
+			if _, ok := sniUsed[sni]; ok {
+				continue
+			}
+			sniUsed[sni] = struct{}{}
+
+			cluster := envoy_common.NewCluster(
+				envoy_common.WithName(clusterName),
+				envoy_common.WithService(serviceName),
+				envoy_common.WithTags(destination.WithoutTags(mesh_proto.ServiceTag)),
+			)
+			cluster.SetMesh(meshName)
+
+			filterChain := envoy_listeners.FilterChain(
+				envoy_listeners.NewFilterChainBuilder(apiVersion, envoy_names.GetEgressFilterChainName(serviceName, meshName)).Configure(
+					envoy_listeners.MatchServerNames(sni),
+					envoy_listeners.TcpProxyDeprecatedWithMetadata(clusterName, cluster),
+				),
+			)
+
+			listenerBuilder.Configure(filterChain)
+
+			servicesAcc.Add(cluster)
+		}
+	}
+
+	return servicesAcc.Services()
+}
+
+// generateExternalServices adds a filter chain terminating the mTLS of the data plane proxies
+// and a cluster with the endpoints of the external service for every external service of the mesh.
+// ZoneEgress presents the identity of the zone-egress service issued by the CA of the mesh.
+func (g EgressGenerator) generateExternalServices(
+	ctx context.Context,
+	xdsCtx xds_context.Context,
+	proxy *core_xds.Proxy,
+	listenerBuilder *envoy_listeners.ListenerBuilder,
+	mr *core_xds.MeshEgressResources,
+) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+	if len(mr.ExternalServicesEndpointMap) == 0 || !mr.Mesh.MTLSEnabled() {
+		return resources, nil
+	}
+
+	meshName := mr.Mesh.GetMeta().GetName()
+	secretsTracker := envoy_common.NewSecretsTracker(meshName, []string{meshName})
+
+	serviceNames := maps.Keys(mr.ExternalServicesEndpointMap)
+	sort.Strings(serviceNames)
+
+	for _, serviceName := range serviceNames {
+		endpoints := mr.ExternalServicesEndpointMap[serviceName]
+		clusterName := envoy_names.GetMeshClusterName(meshName, serviceName)
+
+		cluster := envoy_common.NewCluster(
+			envoy_common.WithName(clusterName),
+			envoy_common.WithService(serviceName),
+			envoy_common.WithExternalService(true),
+		)
+		sni := tls.SNIFromTags(envoy_tags.Tags{
+			mesh_proto.ServiceTag: serviceName,
+			"mesh":                meshName,
+		})
+
+		listenerBuilder.Configure(envoy_listeners.FilterChain(
+			envoy_listeners.NewFilterChainBuilder(proxy.APIVersion, envoy_names.GetEgressFilterChainName(serviceName, meshName)).Configure(
+				envoy_listeners.MatchServerNames(sni),
+				envoy_listeners.ServerSideMTLS(mr.Mesh, secretsTracker),
+				envoy_listeners.TcpProxyDeprecated(clusterName, cluster),
+			),
+		))
+
+		esCluster, err := envoy_clusters.NewClusterBuilder(proxy.APIVersion, clusterName).
+			Configure(envoy_clusters.ProvidedEndpointCluster(false, endpoints...)).
+			Configure(envoy_clusters.ClientSideTLS(endpoints)).
+			Build()
+		if err != nil {
+			return nil, errors.Wrapf(err, "build CDS for external service %s failed", serviceName)
+		}
+		resources.Add(&core_xds.Resource{
+			Name:     clusterName,
+			Origin:   Egress,
+			Resource: esCluster,
+		})
+	}
+
+	identity, ca, err := xdsCtx.ControlPlane.Secrets.GetForZoneEgress(ctx, proxy.ZoneEgressProxy.ZoneEgressResource, mr.Mesh)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate zone egress identity cert and CA")
+	}
+	identityName := secretsTracker.RequestIdentityCert().Name()
+	resources.Add(&core_xds.Resource{
+		Name:     identityName,
+		Origin:   Egress,
+		Resource: envoy_secrets.CreateIdentitySecret(identity, identityName),
+	})
+	caName := secretsTracker.RequestCa(meshName).Name()
+	resources.Add(&core_xds.Resource{
+		Name:     caName,
+		Origin:   Egress,
+		Resource: envoy_secrets.CreateCaSecret(ca, caName),
+	})
+
+	return resources, nil
+}
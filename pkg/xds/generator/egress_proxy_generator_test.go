@@ -0,0 +1,176 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package generator_test
+
+import (
+	"context"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/test/matchers"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/generator"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/secrets"
+)
+
+// zoneEgressSecrets issues static certificates to ZoneEgress.
+type zoneEgressSecrets struct {
+	secrets.Secrets
+}
+
+func (zoneEgressSecrets) GetForZoneEgress(context.Context, *core_mesh.ZoneEgressResource, *core_mesh.MeshResource) (*core_xds.IdentitySecret, *core_xds.CaSecret, error) {
+	return &core_xds.IdentitySecret{
+		PemCerts: [][]byte{[]byte("IDENTITY")},
+		PemKey:   []byte("KEY"),
+	}, &core_xds.CaSecret{
+		PemCerts: [][]byte{[]byte("CA")},
+	}, nil
+}
+
+var _ = Describe("EgressGenerator", func() {
+	newMesh := func(name string, mtls bool) *core_mesh.MeshResource {
+		mesh := &core_mesh.MeshResource{
+			Meta: &test_model.ResourceMeta{Name: name},
+			Spec: &mesh_proto.Mesh{
+				Routing: &mesh_proto.Routing{ZoneEgress: true},
+			},
+		}
+		if mtls {
+			mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+				EnabledBackend: "ca-1",
+				Backends: []*mesh_proto.CertificateAuthorityBackend{
+					{Name: "ca-1", Type: "builtin"},
+				},
+			}
+		}
+		return mesh
+	}
+
+	externalServices := core_xds.EndpointMap{
+		"httpbin": {{
+			Target: "httpbin.org",
+			Port:   443,
+			Tags:   map[string]string{mesh_proto.ServiceTag: "httpbin"},
+			Weight: 1,
+			ExternalService: &core_xds.ExternalService{
+				TLSEnabled: true,
+				ServerName: "httpbin.org",
+			},
+		}},
+	}
+
+	type testCase struct {
+		meshResources []*core_xds.MeshEgressResources
+		goldenFile    string
+	}
+
+	DescribeTable("should generate the configuration of ZoneEgress",
+		func(given testCase) {
+			// given
+			xdsCtx := xds_context.Context{
+				ControlPlane: &xds_context.ControlPlaneContext{
+					Secrets: zoneEgressSecrets{},
+					Zone:    "zone-1",
+				},
+			}
+			proxy := &core_xds.Proxy{
+				Id:         *core_xds.BuildProxyId("", "zone-1-egress"),
+				APIVersion: core_xds.APIVersion(envoy_common.APIV3),
+				Zone:       "zone-1",
+				ZoneEgressProxy: &core_xds.ZoneEgressProxy{
+					ZoneEgressResource: &core_mesh.ZoneEgressResource{
+						Meta: &test_model.ResourceMeta{Name: "zone-1-egress"},
+						Spec: &mesh_proto.ZoneEgress{
+							Zone: "zone-1",
+							Networking: &mesh_proto.ZoneEgress_Networking{
+								Address: "192.168.0.100",
+								Port:    10002,
+							},
+						},
+					},
+					MeshResourcesList: given.meshResources,
+				},
+			}
+
+			// when
+			resources, err := generator.EgressGenerator{}.Generator(context.Background(), nil, xdsCtx, proxy)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := resources.List().ToDeltaDiscoveryResponse()
+			Expect(err).ToNot(HaveOccurred())
+			actual, err := util_proto.ToYAML(resp)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(matchers.MatchGoldenYAML("testdata", given.goldenFile))
+		},
+		Entry("passes through the traffic of the services of other zones", testCase{
+			meshResources: []*core_xds.MeshEgressResources{
+				{
+					Mesh: newMesh(core_model.DefaultMesh, false),
+					EndpointMap: core_xds.EndpointMap{
+						"backend": {{
+							Target: "2.2.2.2",
+							Port:   10001,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "backend",
+								mesh_proto.ZoneTag:    "zone-2",
+							},
+							Weight: 2,
+						}},
+					},
+					ExternalServicesEndpointMap: externalServices,
+					Resources:                   map[core_model.ResourceType]core_model.ResourceList{},
+				},
+			},
+			goldenFile: "egress.cross-zone.golden.yaml",
+		}),
+		Entry("terminates mTLS of the traffic to external services", testCase{
+			meshResources: []*core_xds.MeshEgressResources{
+				{
+					Mesh:                        newMesh(core_model.DefaultMesh, true),
+					EndpointMap:                 core_xds.EndpointMap{},
+					ExternalServicesEndpointMap: externalServices,
+					Resources:                   map[core_model.ResourceType]core_model.ResourceList{},
+				},
+			},
+			goldenFile: "egress.external-services.golden.yaml",
+		}),
+		Entry("no listener without services", testCase{
+			meshResources: []*core_xds.MeshEgressResources{
+				{
+					Mesh:        newMesh(core_model.DefaultMesh, false),
+					EndpointMap: core_xds.EndpointMap{},
+					Resources:   map[core_model.ResourceType]core_model.ResourceList{},
+				},
+			},
+			goldenFile: "egress.empty.golden.yaml",
+		}),
+	)
+})
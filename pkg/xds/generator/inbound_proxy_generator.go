@@ -26,6 +26,7 @@ import (
 )
 
 import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
 	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	"github.com/apache/dubbo-kubernetes/pkg/core/validators"
 	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
@@ -70,7 +71,7 @@ func (g InboundProxyGenerator) Generator(ctx context.Context, _ *core_xds.Resour
 		switch protocol {
 		case core_mesh.ProtocolHTTP:
 			clusterBuilder.Configure(envoy_clusters.Http())
-		case core_mesh.ProtocolHTTP2, core_mesh.ProtocolGRPC:
+		case core_mesh.ProtocolHTTP2, core_mesh.ProtocolGRPC, core_mesh.ProtocolTriple:
 			clusterBuilder.Configure(envoy_clusters.Http2())
 		}
 		envoyCluster, err := clusterBuilder.Build()
@@ -92,42 +93,55 @@ func (g InboundProxyGenerator) Generator(ctx context.Context, _ *core_xds.Resour
 		// generate LDS resource
 		service := iface.GetService()
 		inboundListenerName := envoy_names.GetInboundListenerName(endpoint.DataplaneIP, endpoint.DataplanePort)
+		authorizationPolicies := proxy.Policies.AuthorizationPolicies[endpoint]
 		filterChainBuilder := func(serverSideMTLS bool) *envoy_listeners.FilterChainBuilder {
 			filterChainBuilder := envoy_listeners.NewFilterChainBuilder(proxy.APIVersion, envoy_common.AnonymousResource)
+			if serverSideMTLS {
+				filterChainBuilder.Configure(envoy_listeners.ServerSideMTLS(xdsCtx.Mesh.Resource, proxy.SecretsTracker))
+			}
 			switch protocol {
-			case core_mesh.ProtocolTriple:
-				// TODO: implement the logic of Triple
-				// currently, we use the tcp proxy for the triple protocol
-				filterChainBuilder.
-					Configure(envoy_listeners.TripleConnectionManager()).
-					Configure(envoy_listeners.TcpProxyDeprecated(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName))))
 			// configuration for HTTP case
 			case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2:
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
+					Configure(envoy_listeners.HttpRBAC(authorizationPolicies)).
 					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
-			case core_mesh.ProtocolGRPC:
+			// Triple is compatible with gRPC over HTTP/2
+			case core_mesh.ProtocolGRPC, core_mesh.ProtocolTriple:
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
+					Configure(envoy_listeners.HttpRBAC(authorizationPolicies)).
 					Configure(envoy_listeners.GrpcStats()).
 					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
 			case core_mesh.ProtocolKafka:
 				filterChainBuilder.
 					Configure(envoy_listeners.Kafka(localClusterName)).
-					Configure(envoy_listeners.TcpProxyDeprecated(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName))))
+					Configure(envoy_listeners.TcpProxyDeprecated(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName)))).
+					Configure(envoy_listeners.NetworkRBAC(localClusterName, authorizationPolicies))
 			case core_mesh.ProtocolTCP:
 				fallthrough
 			default:
 				// configuration for non-HTTP cases
-				filterChainBuilder.Configure(envoy_listeners.TcpProxyDeprecated(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName))))
+				filterChainBuilder.
+					Configure(envoy_listeners.TcpProxyDeprecated(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName)))).
+					Configure(envoy_listeners.NetworkRBAC(localClusterName, authorizationPolicies))
 			}
-			return filterChainBuilder
+			return filterChainBuilder.
+				Configure(envoy_listeners.Tracing(xdsCtx.Mesh.Resource.GetTracingBackend(""))).
+				Configure(envoy_listeners.AccessLog(
+					xdsCtx.Mesh.Resource.GetMeta().GetName(),
+					envoy_common.TrafficDirectionInbound,
+					mesh_proto.MatchAllTag,
+					service,
+					xdsCtx.Mesh.Resource.GetLoggingBackend(""),
+					proxy,
+				))
 		}
 
 		listenerBuilder := envoy_listeners.NewInboundListenerBuilder(proxy.APIVersion, endpoint.DataplaneIP, endpoint.DataplanePort, core_xds.SocketAddressProtocolTCP).
 			Configure(envoy_listeners.TagsMetadata(iface.GetTags()))
 
-		listenerBuilder.Configure(envoy_listeners.FilterChain(filterChainBuilder(false)))
+		listenerBuilder.Configure(envoy_listeners.FilterChain(filterChainBuilder(xdsCtx.Mesh.Resource.MTLSEnabled())))
 
 		inboundListener, err := listenerBuilder.Build()
 		if err != nil {
@@ -20,6 +20,7 @@ package generator
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 import (
@@ -56,8 +57,10 @@ func (g OutboundProxyGenerator) Generator(ctx context.Context, _ *model.Resource
 		return resources, nil
 	}
 
-	// TODO: implement the logic of tlsReadiness
 	tlsReadiness := make(map[string]bool)
+	for serviceName, info := range xdsCtx.Mesh.ServicesInformation {
+		tlsReadiness[serviceName] = info.TLSReadiness
+	}
 	servicesAcc := envoy_common.NewServicesAccumulator(tlsReadiness)
 
 	outboundsMultipleIPs := buildOutboundsWithMultipleIPs(proxy.Dataplane, outbounds)
@@ -69,6 +72,9 @@ func (g OutboundProxyGenerator) Generator(ctx context.Context, _ *model.Resource
 
 		// Infer the compatible protocol for all the apps for the given service
 		protocol := inferProtocol(xdsCtx.Mesh, clusters)
+		if protocol == core_mesh.ProtocolTriple {
+			routes = append(tripleMethodRoutes(xdsCtx.Mesh, outbound.Tags[mesh_proto.ServiceTag], clusters), routes...)
+		}
 
 		servicesAcc.Add(clusters...)
 
@@ -109,13 +115,8 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 	filterChainBuilder := func() *envoy_listeners.FilterChainBuilder {
 		filterChainBuilder := envoy_listeners.NewFilterChainBuilder(proxy.APIVersion, envoy_common.AnonymousResource)
 		switch protocol {
-		case core_mesh.ProtocolTriple:
-			// TODO: implement the logic of Triple
-			// currently, we use the tcp proxy for the triple protocol
-			filterChainBuilder.
-				Configure(envoy_listeners.TripleConnectionManager()).
-				Configure(envoy_listeners.TcpProxyDeprecated(serviceName, routes.Clusters()...))
-		case core_mesh.ProtocolGRPC:
+		// Triple is compatible with gRPC over HTTP/2
+		case core_mesh.ProtocolGRPC, core_mesh.ProtocolTriple:
 			filterChainBuilder.
 				Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
 				Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, proxy.Dataplane.Spec.TagSet())).
@@ -137,7 +138,16 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 				Configure(envoy_listeners.TcpProxyDeprecated(serviceName, routes.Clusters()...))
 		}
 
-		return filterChainBuilder
+		return filterChainBuilder.
+			Configure(envoy_listeners.Tracing(ctx.Mesh.Resource.GetTracingBackend(""))).
+			Configure(envoy_listeners.AccessLog(
+				ctx.Mesh.Resource.GetMeta().GetName(),
+				envoy_common.TrafficDirectionOutbound,
+				proxy.Dataplane.Spec.GetIdentifyingService(),
+				serviceName,
+				ctx.Mesh.Resource.GetLoggingBackend(""),
+				proxy,
+			))
 	}()
 	listener, err := envoy_listeners.NewOutboundListenerBuilder(proxy.APIVersion, oface.DataplaneIP, oface.DataplanePort, model.SocketAddressProtocolTCP).
 		Configure(envoy_listeners.FilterChain(filterChainBuilder)).
@@ -162,30 +172,34 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 			clusterName := cluster.Name()
 			edsClusterBuilder := envoy_clusters.NewClusterBuilder(proxy.APIVersion, clusterName)
 
-			// clusterTags := []envoy_tags.Tags{cluster.Tags()}
+			clusterTags := []envoy_tags.Tags{cluster.Tags()}
 
 			if service.HasExternalService() {
 				if ctx.Mesh.Resource.ZoneEgressEnabled() {
+					// ZoneEgress terminates mTLS and originates the TLS to the external service
 					edsClusterBuilder.
-						Configure(envoy_clusters.EdsCluster())
+						Configure(envoy_clusters.EdsCluster()).
+						Configure(envoy_clusters.ClientSideMTLS(proxy.SecretsTracker, ctx.Mesh.Resource, mesh_proto.ZoneEgressServiceName, true, clusterTags))
 				} else {
 					endpoints := proxy.Routing.ExternalServiceOutboundTargets[serviceName]
 					isIPv6 := proxy.Dataplane.IsIPv6()
 
 					edsClusterBuilder.
-						Configure(envoy_clusters.ProvidedEndpointCluster(isIPv6, endpoints...))
+						Configure(envoy_clusters.ProvidedEndpointCluster(isIPv6, endpoints...)).
+						Configure(envoy_clusters.ClientSideTLS(endpoints))
 				}
 
 				switch protocol {
 				case core_mesh.ProtocolHTTP:
 					edsClusterBuilder.Configure(envoy_clusters.Http())
-				case core_mesh.ProtocolHTTP2, core_mesh.ProtocolGRPC:
+				case core_mesh.ProtocolHTTP2, core_mesh.ProtocolGRPC, core_mesh.ProtocolTriple:
 					edsClusterBuilder.Configure(envoy_clusters.Http2())
 				default:
 				}
 			} else {
 				edsClusterBuilder.
 					Configure(envoy_clusters.EdsCluster()).
+					Configure(envoy_clusters.ClientSideMTLS(proxy.SecretsTracker, ctx.Mesh.Resource, serviceName, service.TLSReady(), clusterTags)).
 					Configure(envoy_clusters.Http2())
 			}
 
@@ -318,6 +332,47 @@ func (OutboundProxyGenerator) determineRoutes(
 	return routes
 }
 
+// tripleMethodRoutes gives every method of the Triple services exposed by the providers of the service
+// a route of its own, so that rules can be applied per method. Triple requests
+// follow the gRPC convention of "/{interface}/{method}" paths.
+func tripleMethodRoutes(meshCtx xds_context.MeshContext, service string, clusters []envoy_common.Cluster) envoy_common.Routes {
+	apps := map[string]struct{}{}
+	for _, dataplane := range meshCtx.DataplanesByName {
+		for _, inbound := range dataplane.Spec.GetNetworking().GetInbound() {
+			if app := dataplane.Meta.GetLabels()[mesh_proto.AppTag]; app != "" && inbound.GetService() == service {
+				apps[app] = struct{}{}
+			}
+		}
+	}
+
+	paths := map[string]struct{}{}
+	for _, metadata := range meshCtx.Resources.MetaData().Items {
+		if _, ok := apps[metadata.Spec.GetApp()]; !ok {
+			continue
+		}
+		for _, info := range metadata.Spec.GetServices() {
+			if core_mesh.ParseProtocol(info.GetProtocol()) != core_mesh.ProtocolTriple {
+				continue
+			}
+			for _, method := range strings.Split(info.GetParams()[methodsParam], ",") {
+				if method = strings.TrimSpace(method); method != "" {
+					paths[fmt.Sprintf("/%s/%s", info.GetName(), method)] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var routes envoy_common.Routes
+	for _, path := range maps.SortedKeys(paths) {
+		opts := []envoy_common.NewRouteOpt{envoy_common.WithMatchExactPath(path)}
+		for _, cluster := range clusters {
+			opts = append(opts, envoy_common.WithCluster(cluster))
+		}
+		routes = append(routes, envoy_common.NewRoute(opts...))
+	}
+	return routes
+}
+
 type OutboundWithMultipleIPs struct {
 	Tags      map[string]string
 	Addresses []mesh_proto.OutboundInterface
@@ -0,0 +1,210 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package generator
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	envoy_tags "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/tags"
+)
+
+var _ = Describe("tripleMethodRoutes", func() {
+	dataplane := func(name, app, service string) *core_mesh.DataplaneResource {
+		return &core_mesh.DataplaneResource{
+			Meta: &test_model.ResourceMeta{
+				Name:   name,
+				Mesh:   core_model.DefaultMesh,
+				Labels: map[string]string{mesh_proto.AppTag: app},
+			},
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "10.0.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+						Port: 50051,
+						Tags: map[string]string{mesh_proto.ServiceTag: service},
+					}},
+				},
+			},
+		}
+	}
+
+	metadata := func(app string, services map[string]*mesh_proto.ServiceInfo) *core_mesh.MetaDataResource {
+		return &core_mesh.MetaDataResource{
+			Meta: &test_model.ResourceMeta{Name: app + "-revision", Mesh: core_model.DefaultMesh},
+			Spec: &mesh_proto.MetaData{App: app, Services: services},
+		}
+	}
+
+	meshContext := func(dataplanes []*core_mesh.DataplaneResource, metadata ...*core_mesh.MetaDataResource) xds_context.MeshContext {
+		resources := xds_context.NewResources()
+		resources.MeshLocalResources[core_mesh.MetaDataType] = &core_mesh.MetaDataResourceList{Items: metadata}
+		byName := map[string]*core_mesh.DataplaneResource{}
+		for _, dp := range dataplanes {
+			byName[dp.Meta.GetName()] = dp
+		}
+		return xds_context.MeshContext{
+			Resources:        resources,
+			DataplanesByName: byName,
+		}
+	}
+
+	greeter := envoy_common.NewCluster(
+		envoy_common.WithService("greeter"),
+		envoy_common.WithName("greeter"),
+	)
+
+	It("should give every method of the Triple services of the providers a route", func() {
+		// given
+		meshCtx := meshContext(
+			[]*core_mesh.DataplaneResource{
+				dataplane("greeter-1", "greeter-app", "greeter"),
+				dataplane("other-1", "other-app", "other"),
+			},
+			metadata("greeter-app", map[string]*mesh_proto.ServiceInfo{
+				"org.apache.dubbo.GreetService:tri": {
+					Name:     "org.apache.dubbo.GreetService",
+					Protocol: "tri",
+					Params:   map[string]string{methodsParam: "sayHello, greet,,"},
+				},
+				"org.apache.dubbo.LegacyService:dubbo": {
+					Name:     "org.apache.dubbo.LegacyService",
+					Protocol: "dubbo",
+					Params:   map[string]string{methodsParam: "ping"},
+				},
+			}),
+			metadata("other-app", map[string]*mesh_proto.ServiceInfo{
+				"org.apache.dubbo.OtherService:tri": {
+					Name:     "org.apache.dubbo.OtherService",
+					Protocol: "tri",
+					Params:   map[string]string{methodsParam: "other"},
+				},
+			}),
+		)
+
+		// when
+		routes := tripleMethodRoutes(meshCtx, "greeter", []envoy_common.Cluster{greeter})
+
+		// then
+		Expect(routes).To(Equal(envoy_common.Routes{
+			envoy_common.NewRoute(
+				envoy_common.WithMatchExactPath("/org.apache.dubbo.GreetService/greet"),
+				envoy_common.WithCluster(greeter),
+			),
+			envoy_common.NewRoute(
+				envoy_common.WithMatchExactPath("/org.apache.dubbo.GreetService/sayHello"),
+				envoy_common.WithCluster(greeter),
+			),
+		}))
+	})
+
+	It("should not generate routes when no provider exposes a Triple service", func() {
+		// given
+		meshCtx := meshContext(
+			[]*core_mesh.DataplaneResource{dataplane("greeter-1", "greeter-app", "greeter")},
+			metadata("greeter-app", map[string]*mesh_proto.ServiceInfo{
+				"org.apache.dubbo.LegacyService:dubbo": {
+					Name:     "org.apache.dubbo.LegacyService",
+					Protocol: "dubbo",
+					Params:   map[string]string{methodsParam: "ping"},
+				},
+			}),
+		)
+
+		// when
+		routes := tripleMethodRoutes(meshCtx, "greeter", []envoy_common.Cluster{greeter})
+
+		// then
+		Expect(routes).To(BeEmpty())
+	})
+
+	It("should not match the providers without an app label to the metadata without an app", func() {
+		// given
+		meshCtx := meshContext(
+			[]*core_mesh.DataplaneResource{dataplane("greeter-1", "", "greeter")},
+			metadata("", map[string]*mesh_proto.ServiceInfo{
+				"org.apache.dubbo.GreetService:tri": {
+					Name:     "org.apache.dubbo.GreetService",
+					Protocol: "tri",
+					Params:   map[string]string{methodsParam: "greet"},
+				},
+			}),
+		)
+
+		// when
+		routes := tripleMethodRoutes(meshCtx, "greeter", []envoy_common.Cluster{greeter})
+
+		// then
+		Expect(routes).To(BeEmpty())
+	})
+})
+
+var _ = Describe("inferProtocol", func() {
+	cluster := func(service string) envoy_common.Cluster {
+		return envoy_common.NewCluster(
+			envoy_common.WithService(service),
+			envoy_common.WithName(service),
+			envoy_common.WithTags(envoy_tags.Tags{mesh_proto.ServiceTag: service}),
+		)
+	}
+
+	meshContext := func(protocols map[string]core_mesh.Protocol) xds_context.MeshContext {
+		servicesInformation := map[string]*xds_context.ServiceInformation{}
+		for service, protocol := range protocols {
+			servicesInformation[service] = &xds_context.ServiceInformation{Protocol: protocol}
+		}
+		return xds_context.MeshContext{ServicesInformation: servicesInformation}
+	}
+
+	type testCase struct {
+		protocols map[string]core_mesh.Protocol
+		expected  core_mesh.Protocol
+	}
+
+	DescribeTable("should infer the protocol of the outbound",
+		func(given testCase) {
+			var clusters []envoy_common.Cluster
+			for _, service := range []string{"greeter", "legacy"} {
+				if _, ok := given.protocols[service]; ok {
+					clusters = append(clusters, cluster(service))
+				}
+			}
+			Expect(inferProtocol(meshContext(given.protocols), clusters)).To(Equal(given.expected))
+		},
+		Entry("dubbo", testCase{
+			protocols: map[string]core_mesh.Protocol{"legacy": core_mesh.ProtocolDubbo},
+			expected:  core_mesh.ProtocolDubbo,
+		}),
+		Entry("dubbo and triple", testCase{
+			protocols: map[string]core_mesh.Protocol{"greeter": core_mesh.ProtocolTriple, "legacy": core_mesh.ProtocolDubbo},
+			expected:  core_mesh.ProtocolTCP,
+		}),
+		Entry("dubbo and grpc", testCase{
+			protocols: map[string]core_mesh.Protocol{"greeter": core_mesh.ProtocolGRPC, "legacy": core_mesh.ProtocolDubbo},
+			expected:  core_mesh.ProtocolTCP,
+		}),
+	)
+})
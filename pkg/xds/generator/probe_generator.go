@@ -0,0 +1,98 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"context"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+	envoy_clusters "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/clusters"
+	envoy_listeners "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/listeners"
+	envoy_names "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/names"
+	envoy_routes "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/routes"
+	envoy_virtual_hosts "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/virtualhosts"
+)
+
+// OriginProbes is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginProbes = "probe"
+
+// ProbeProxyGenerator generates the listener of the virtual probes. Kubelet reaches the application
+// probes through this listener without mTLS, every endpoint is forwarded to the local application port.
+type ProbeProxyGenerator struct{}
+
+func (g ProbeProxyGenerator) Generator(_ context.Context, _ *core_xds.ResourceSet, _ xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	probes := proxy.Dataplane.Spec.GetProbes()
+	if probes == nil || probes.GetPort() == 0 {
+		return nil, nil
+	}
+
+	resources := core_xds.NewResourceSet()
+	localClusters := map[uint32]bool{}
+	for _, endpoint := range proxy.Dataplane.Spec.Networking.GetInboundInterfaces() {
+		localClusters[endpoint.WorkloadPort] = true
+	}
+
+	virtualHostBuilder := envoy_virtual_hosts.NewVirtualHostBuilder(proxy.APIVersion, "probe")
+	for _, endpoint := range probes.GetEndpoints() {
+		clusterName := envoy_names.GetLocalClusterName(endpoint.GetInboundPort())
+		// probed port is not necessarily an inbound of the Dataplane (i.e. a container
+		// that is not selected by any Service), in such case the local cluster is generated here
+		if !localClusters[endpoint.GetInboundPort()] {
+			cluster, err := envoy_clusters.NewClusterBuilder(proxy.APIVersion, clusterName).
+				Configure(envoy_clusters.ProvidedEndpointCluster(false, core_xds.Endpoint{Target: core_mesh.IPv4Loopback.String(), Port: endpoint.GetInboundPort()})).
+				Build()
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not generate cluster %s", clusterName)
+			}
+			resources.Add(&core_xds.Resource{
+				Name:     clusterName,
+				Resource: cluster,
+				Origin:   OriginProbes,
+			})
+			localClusters[endpoint.GetInboundPort()] = true
+		}
+		virtualHostBuilder.Configure(envoy_virtual_hosts.Route(endpoint.GetPath(), endpoint.GetInboundPath(), clusterName, true))
+	}
+
+	listenerName := envoy_names.GetProbeListenerName()
+	listener, err := envoy_listeners.NewInboundListenerBuilder(proxy.APIVersion, proxy.Dataplane.Spec.GetNetworking().GetAddress(), probes.GetPort(), core_xds.SocketAddressProtocolTCP).
+		WithOverwriteName(listenerName).
+		Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion, envoy_common.AnonymousResource).
+			Configure(envoy_listeners.HttpConnectionManager(listenerName, false)).
+			Configure(envoy_listeners.HttpStaticRoute(envoy_routes.NewRouteConfigurationBuilder(proxy.APIVersion, listenerName).
+				Configure(envoy_routes.VirtualHost(virtualHostBuilder)))))).
+		Build()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not generate listener %s", listenerName)
+	}
+	resources.Add(&core_xds.Resource{
+		Name:     listenerName,
+		Resource: listener,
+		Origin:   OriginProbes,
+	})
+	return resources, nil
+}
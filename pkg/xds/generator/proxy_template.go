@@ -32,6 +32,7 @@ import (
 const (
 	DefaultProxy = "default-proxy"
 	IngressProxy = "ingress-proxy"
+	EgressProxy  = "egress-proxy"
 )
 
 type ProxyTemplateGenerator struct {
@@ -60,7 +61,12 @@ func NewDefaultProxyProfile() core.ResourceGenerator {
 	return core.CompositeResourceGenerator{
 		InboundProxyGenerator{},
 		OutboundProxyGenerator{},
+		TracingProxyGenerator{},
+		ProbeProxyGenerator{},
+		DNSGenerator{},
 		generator.NewGenerator(),
+		// SecretsProxyGenerator has to be the last generator, so it can deliver every secret requested by the generators above
+		SecretsProxyGenerator{},
 	}
 }
 
@@ -69,6 +75,7 @@ var predefinedProfiles = make(map[string]core.ResourceGenerator)
 func init() {
 	RegisterProfile(DefaultProxy, NewDefaultProxyProfile())
 	RegisterProfile(IngressProxy, core.CompositeResourceGenerator{IngressGenerator{}})
+	RegisterProfile(EgressProxy, core.CompositeResourceGenerator{EgressGenerator{}})
 }
 
 func RegisterProfile(profileName string, generator core.ResourceGenerator) {
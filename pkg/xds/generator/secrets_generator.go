@@ -0,0 +1,96 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"context"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_secrets "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/secrets/v3"
+)
+
+// OriginSecrets is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginSecrets = "secrets"
+
+// SecretsProxyGenerator delivers the identity certificate and the trust bundles referenced
+// by the other generators through the SecretsTracker, therefore it has to run as the last one.
+type SecretsProxyGenerator struct{}
+
+func (g SecretsProxyGenerator) Generator(ctx context.Context, _ *core_xds.ResourceSet, xdsCtx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+
+	tracker := proxy.SecretsTracker
+	if tracker == nil || proxy.Dataplane == nil {
+		return resources, nil
+	}
+	usedIdentity := tracker.UsedIdentity()
+	usedCas := tracker.UsedCas()
+	usedAllInOne := tracker.UsedAllInOne()
+	if !usedIdentity && len(usedCas) == 0 && !usedAllInOne {
+		return resources, nil
+	}
+
+	mesh := xdsCtx.Mesh.Resource
+	identity, cas, err := xdsCtx.ControlPlane.Secrets.GetForDataPlane(ctx, proxy.Dataplane, mesh, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate dataplane identity cert and CAs")
+	}
+
+	if usedIdentity {
+		resources.Add(&core_xds.Resource{
+			Name:     tracker.RequestIdentityCert().Name(),
+			Origin:   OriginSecrets,
+			Resource: envoy_secrets.CreateIdentitySecret(identity, tracker.RequestIdentityCert().Name()),
+		})
+	}
+
+	for meshName := range usedCas {
+		ca, ok := cas[meshName]
+		if !ok {
+			return nil, errors.Errorf("couldn't find CA for mesh %q", meshName)
+		}
+		name := tracker.RequestCa(meshName).Name()
+		resources.Add(&core_xds.Resource{
+			Name:     name,
+			Origin:   OriginSecrets,
+			Resource: envoy_secrets.CreateCaSecret(ca, name),
+		})
+	}
+
+	if usedAllInOne {
+		_, allInOneCa, err := xdsCtx.ControlPlane.Secrets.GetAllInOne(ctx, mesh, proxy.Dataplane, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate all in one CA")
+		}
+		name := tracker.RequestAllInOneCa().Name()
+		resources.Add(&core_xds.Resource{
+			Name:     name,
+			Origin:   OriginSecrets,
+			Resource: envoy_secrets.CreateCaSecret(allInOneCa, name),
+		})
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"context"
+	net_url "net/url"
+	"strconv"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/util/net"
+	util_proto "github.com/apache/dubbo-kubernetes/pkg/util/proto"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_clusters "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/clusters"
+	envoy_names "github.com/apache/dubbo-kubernetes/pkg/xds/envoy/names"
+)
+
+// OriginTracing is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginTracing = "tracing"
+
+// TracingProxyGenerator generates the clusters of the collectors of the tracing backends of the mesh.
+// Clusters are generated for every backend, so the TrafficTrace policies can pick any of them.
+type TracingProxyGenerator struct{}
+
+func (g TracingProxyGenerator) Generator(_ context.Context, _ *core_xds.ResourceSet, xdsCtx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+	for _, backend := range xdsCtx.Mesh.Resource.Spec.GetTracing().GetBackends() {
+		if backend.GetType() != mesh_proto.TracingZipkinType {
+			continue
+		}
+		endpoint, err := zipkinCollectorEndpoint(backend)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate the cluster of tracing backend %q", backend.GetName())
+		}
+		clusterName := envoy_names.GetTracingClusterName(backend.GetName())
+		cluster, err := envoy_clusters.NewClusterBuilder(proxy.APIVersion, clusterName).
+			Configure(envoy_clusters.ProvidedEndpointCluster(net.IsAddressIPv6(endpoint.Target), endpoint)).
+			Configure(envoy_clusters.ClientSideTLS([]core_xds.Endpoint{endpoint})).
+			Build()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate cluster %s", clusterName)
+		}
+		resources.Add(&core_xds.Resource{
+			Name:     clusterName,
+			Origin:   OriginTracing,
+			Resource: cluster,
+		})
+	}
+	return resources, nil
+}
+
+func zipkinCollectorEndpoint(backend *mesh_proto.TracingBackend) (core_xds.Endpoint, error) {
+	cfg := &mesh_proto.ZipkinTracingBackendConfig{}
+	if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+		return core_xds.Endpoint{}, err
+	}
+	url, err := net_url.ParseRequestURI(cfg.GetUrl())
+	if err != nil {
+		return core_xds.Endpoint{}, err
+	}
+	port := uint64(80)
+	if url.Scheme == "https" {
+		port = 443
+	}
+	if url.Port() != "" {
+		if port, err = strconv.ParseUint(url.Port(), 10, 32); err != nil {
+			return core_xds.Endpoint{}, errors.Wrapf(err, "invalid port of url %q", cfg.GetUrl())
+		}
+	}
+	endpoint := core_xds.Endpoint{
+		Target: url.Hostname(),
+		Port:   uint32(port),
+	}
+	if url.Scheme == "https" {
+		endpoint.ExternalService = &core_xds.ExternalService{TLSEnabled: true}
+	}
+	return endpoint, nil
+}
@@ -0,0 +1,68 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+)
+
+type CaProvider interface {
+	// Get returns all PEM encoded CAs, a list of CAs that were used to generate a secret and an error.
+	Get(ctx context.Context, mesh *core_mesh.MeshResource) (*core_xds.CaSecret, []string, error)
+}
+
+func NewCaProvider(caManagers core_ca.Managers) CaProvider {
+	return &meshCaProvider{
+		caManagers: caManagers,
+	}
+}
+
+type meshCaProvider struct {
+	caManagers core_ca.Managers
+}
+
+func (s *meshCaProvider) Get(ctx context.Context, mesh *core_mesh.MeshResource) (*core_xds.CaSecret, []string, error) {
+	backend := mesh.GetEnabledCertificateAuthorityBackend()
+	if backend == nil {
+		return nil, nil, errors.New("CA backend is nil")
+	}
+
+	caManager, exist := s.caManagers[backend.Type]
+	if !exist {
+		return nil, nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
+	}
+
+	certs, err := caManager.GetRootCert(ctx, mesh.GetMeta().GetName(), backend)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get root certs")
+	}
+
+	return &core_xds.CaSecret{
+		PemCerts: certs,
+	}, []string{backend.Name}, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_ca "github.com/apache/dubbo-kubernetes/pkg/core/ca"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+)
+
+type IdentityProvider interface {
+	// Get returns PEM encoded cert + key, backend that was used to generate this pair and an error.
+	Get(ctx context.Context, tags mesh_proto.MultiValueTagSet, mesh *core_mesh.MeshResource) (*core_xds.IdentitySecret, string, error)
+}
+
+func NewIdentityProvider(caManagers core_ca.Managers) IdentityProvider {
+	return &identityCertProvider{
+		caManagers: caManagers,
+	}
+}
+
+type identityCertProvider struct {
+	caManagers core_ca.Managers
+}
+
+func (s *identityCertProvider) Get(ctx context.Context, tags mesh_proto.MultiValueTagSet, mesh *core_mesh.MeshResource) (*core_xds.IdentitySecret, string, error) {
+	backend := mesh.GetEnabledCertificateAuthorityBackend()
+	if backend == nil {
+		return nil, "", errors.Errorf("CA default backend in mesh %q has to be defined", mesh.GetMeta().GetName())
+	}
+
+	caManager, exist := s.caManagers[backend.Type]
+	if !exist {
+		return nil, "", errors.Errorf("CA manager of type %s not exist", backend.Type)
+	}
+
+	pair, err := caManager.GenerateDataplaneCert(ctx, mesh.GetMeta().GetName(), backend, tags)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "could not generate dataplane cert for mesh: %q backend: %q services: %q", mesh.GetMeta().GetName(), backend.Name, tags.Values(mesh_proto.ServiceTag))
+	}
+
+	return &core_xds.IdentitySecret{
+		PemCerts: [][]byte{pair.CertPEM},
+		PemKey:   pair.KeyPEM,
+	}, backend.Name, nil
+}
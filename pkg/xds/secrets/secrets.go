@@ -0,0 +1,337 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"reflect"
+	"sync"
+	"time"
+)
+
+import (
+	"github.com/pkg/errors"
+
+	"google.golang.org/protobuf/proto"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+)
+
+var log = core.Log.WithName("xds").WithName("secrets")
+
+// Secrets provides the identity and the trust bundles of data plane proxies.
+// Generated certificates are cached and regenerated only when the mTLS settings
+// of the mesh or the tags of the proxy change, or when the certificate is about to expire.
+type Secrets interface {
+	GetForDataPlane(ctx context.Context, dataplane *core_mesh.DataplaneResource, mesh *core_mesh.MeshResource, otherMeshes []*core_mesh.MeshResource) (*core_xds.IdentitySecret, map[string]*core_xds.CaSecret, error)
+	GetAllInOne(ctx context.Context, mesh *core_mesh.MeshResource, dataplane *core_mesh.DataplaneResource, otherMeshes []*core_mesh.MeshResource) (*core_xds.IdentitySecret, *core_xds.CaSecret, error)
+	GetForZoneEgress(ctx context.Context, zoneEgress *core_mesh.ZoneEgressResource, mesh *core_mesh.MeshResource) (*core_xds.IdentitySecret, *core_xds.CaSecret, error)
+	Info(dpKey core_model.ResourceKey) *Info
+	ZoneEgressInfo(zoneEgressKey core_model.ResourceKey, mesh string) *Info
+	Cleanup(dpKey core_model.ResourceKey)
+}
+
+type MeshInfo struct {
+	MTLS *mesh_proto.Mesh_Mtls
+}
+
+type Info struct {
+	Expiration time.Time
+	Generation time.Time
+
+	Tags mesh_proto.MultiValueTagSet
+
+	IssuedBackend     string
+	SupportedBackends []string
+
+	OwnMesh        MeshInfo
+	OtherMeshInfos []MeshInfo
+}
+
+func (i *Info) CertLifetime() time.Duration {
+	return i.Expiration.Sub(i.Generation)
+}
+
+// ExpiringSoon reports whether 4/5 of the certificate lifetime has already passed.
+func (i *Info) ExpiringSoon() bool {
+	return core.Now().After(i.Generation.Add(i.CertLifetime() / 5 * 4))
+}
+
+func NewSecrets(caProvider CaProvider, identityProvider IdentityProvider) Secrets {
+	return &secrets{
+		caProvider:        caProvider,
+		identityProvider:  identityProvider,
+		cachedCerts:       map[core_model.ResourceKey]*certs{},
+		cachedEgressCerts: map[core_model.ResourceKey]*certs{},
+	}
+}
+
+type secrets struct {
+	caProvider       CaProvider
+	identityProvider IdentityProvider
+
+	sync.RWMutex
+	cachedCerts map[core_model.ResourceKey]*certs
+	// cachedEgressCerts holds the certificates of zone egresses. A zone egress serves
+	// many meshes, so the entries are keyed by the mesh and the name of the egress.
+	cachedEgressCerts map[core_model.ResourceKey]*certs
+}
+
+var _ Secrets = &secrets{}
+
+type MeshCa struct {
+	Mesh     string
+	CaSecret *core_xds.CaSecret
+}
+
+type certs struct {
+	identity *core_xds.IdentitySecret
+	ownCa    MeshCa
+	otherCas []MeshCa
+	info     *Info
+}
+
+func (c *certs) Info() *Info {
+	if c == nil {
+		return nil
+	}
+	return c.info
+}
+
+func (c *certs) CaSecrets() map[string]*core_xds.CaSecret {
+	cas := map[string]*core_xds.CaSecret{c.ownCa.Mesh: c.ownCa.CaSecret}
+	for _, otherCa := range c.otherCas {
+		cas[otherCa.Mesh] = otherCa.CaSecret
+	}
+	return cas
+}
+
+// AllInOneCa merges the root certificates of all meshes into a single trust bundle.
+func (c *certs) AllInOneCa() *core_xds.CaSecret {
+	allInOne := &core_xds.CaSecret{}
+	allInOne.PemCerts = append(allInOne.PemCerts, c.ownCa.CaSecret.PemCerts...)
+	for _, otherCa := range c.otherCas {
+		allInOne.PemCerts = append(allInOne.PemCerts, otherCa.CaSecret.PemCerts...)
+	}
+	return allInOne
+}
+
+func (s *secrets) Info(dpKey core_model.ResourceKey) *Info {
+	return s.certs(dpKey).Info()
+}
+
+func (s *secrets) ZoneEgressInfo(zoneEgressKey core_model.ResourceKey, mesh string) *Info {
+	return s.cached(s.cachedEgressCerts, core_model.ResourceKey{Mesh: mesh, Name: zoneEgressKey.Name}).Info()
+}
+
+func (s *secrets) certs(dpKey core_model.ResourceKey) *certs {
+	return s.cached(s.cachedCerts, dpKey)
+}
+
+func (s *secrets) cached(cache map[core_model.ResourceKey]*certs, key core_model.ResourceKey) *certs {
+	s.RLock()
+	defer s.RUnlock()
+	return cache[key]
+}
+
+func (s *secrets) Cleanup(dpKey core_model.ResourceKey) {
+	s.Lock()
+	delete(s.cachedCerts, dpKey)
+	if dpKey.Mesh == "" {
+		for key := range s.cachedEgressCerts {
+			if key.Name == dpKey.Name {
+				delete(s.cachedEgressCerts, key)
+			}
+		}
+	}
+	s.Unlock()
+}
+
+func (s *secrets) GetForDataPlane(
+	ctx context.Context,
+	dataplane *core_mesh.DataplaneResource,
+	mesh *core_mesh.MeshResource,
+	otherMeshes []*core_mesh.MeshResource,
+) (*core_xds.IdentitySecret, map[string]*core_xds.CaSecret, error) {
+	certs, err := s.get(ctx, s.cachedCerts, core_model.MetaToResourceKey(dataplane.GetMeta()), dataplane.Spec.TagSet(), mesh, otherMeshes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return certs.identity, certs.CaSecrets(), nil
+}
+
+func (s *secrets) GetAllInOne(
+	ctx context.Context,
+	mesh *core_mesh.MeshResource,
+	dataplane *core_mesh.DataplaneResource,
+	otherMeshes []*core_mesh.MeshResource,
+) (*core_xds.IdentitySecret, *core_xds.CaSecret, error) {
+	certs, err := s.get(ctx, s.cachedCerts, core_model.MetaToResourceKey(dataplane.GetMeta()), dataplane.Spec.TagSet(), mesh, otherMeshes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return certs.identity, certs.AllInOneCa(), nil
+}
+
+// GetForZoneEgress returns the identity of the zone egress in the given mesh. Zone egress
+// terminates mTLS of the traffic going to external services, so it is issued a certificate
+// of the dedicated zone-egress service in every mesh it serves.
+func (s *secrets) GetForZoneEgress(
+	ctx context.Context,
+	zoneEgress *core_mesh.ZoneEgressResource,
+	mesh *core_mesh.MeshResource,
+) (*core_xds.IdentitySecret, *core_xds.CaSecret, error) {
+	key := core_model.ResourceKey{
+		Mesh: mesh.GetMeta().GetName(),
+		Name: zoneEgress.GetMeta().GetName(),
+	}
+	tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+		mesh_proto.ServiceTag: {mesh_proto.ZoneEgressServiceName},
+	})
+	certs, err := s.get(ctx, s.cachedEgressCerts, key, tags, mesh, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return certs.identity, certs.ownCa.CaSecret, nil
+}
+
+func (s *secrets) get(
+	ctx context.Context,
+	cache map[core_model.ResourceKey]*certs,
+	key core_model.ResourceKey,
+	tags mesh_proto.MultiValueTagSet,
+	mesh *core_mesh.MeshResource,
+	otherMeshes []*core_mesh.MeshResource,
+) (*certs, error) {
+	if !mesh.MTLSEnabled() {
+		return nil, errors.Errorf("mTLS is not enabled in mesh %q", mesh.GetMeta().GetName())
+	}
+
+	current := s.cached(cache, key)
+	if shouldGenerate, reason := s.shouldGenerateCerts(current.Info(), tags, mesh, otherMeshes); shouldGenerate {
+		log.V(1).Info("generating certificate", "proxy", key, "reason", reason)
+		certs, err := s.generateCerts(ctx, tags, mesh, otherMeshes)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate certificates")
+		}
+		s.Lock()
+		cache[key] = certs
+		s.Unlock()
+		return certs, nil
+	}
+	return current, nil
+}
+
+func (s *secrets) shouldGenerateCerts(info *Info, tags mesh_proto.MultiValueTagSet, mesh *core_mesh.MeshResource, otherMeshes []*core_mesh.MeshResource) (bool, string) {
+	if info == nil {
+		return true, "mTLS is enabled and DP hasn't received a certificate yet"
+	}
+	if !proto.Equal(info.OwnMesh.MTLS, mesh.Spec.GetMtls()) {
+		return true, "mTLS settings of the mesh have changed"
+	}
+	if len(info.OtherMeshInfos) != len(otherMeshes) {
+		return true, "another mesh has been added or removed"
+	}
+	for i, otherMesh := range otherMeshes {
+		if !proto.Equal(info.OtherMeshInfos[i].MTLS, otherMesh.Spec.GetMtls()) {
+			return true, "mTLS settings of another mesh have changed"
+		}
+	}
+	if !reflect.DeepEqual(info.Tags, tags) {
+		return true, "DP tags have changed"
+	}
+	if info.ExpiringSoon() {
+		return true, "the certificate expiring soon"
+	}
+	return false, ""
+}
+
+func (s *secrets) generateCerts(
+	ctx context.Context,
+	tags mesh_proto.MultiValueTagSet,
+	mesh *core_mesh.MeshResource,
+	otherMeshes []*core_mesh.MeshResource,
+) (*certs, error) {
+	identity, issuedBackend, err := s.identityProvider.Get(ctx, tags, mesh)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get Dataplane cert pair")
+	}
+
+	ownCa, supportedBackends, err := s.caProvider.Get(ctx, mesh)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get mesh CA cert")
+	}
+
+	var otherCas []MeshCa
+	var otherMeshInfos []MeshInfo
+	for _, otherMesh := range otherMeshes {
+		otherMeshInfos = append(otherMeshInfos, MeshInfo{
+			MTLS: otherMesh.Spec.GetMtls(),
+		})
+		// We need to track mesh info even when mTLS is disabled, so the certificates
+		// are regenerated once it gets enabled.
+		if !otherMesh.MTLSEnabled() {
+			continue
+		}
+		otherCa, _, err := s.caProvider.Get(ctx, otherMesh)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get other mesh %q CA cert", otherMesh.GetMeta().GetName())
+		}
+		otherCas = append(otherCas, MeshCa{
+			Mesh:     otherMesh.GetMeta().GetName(),
+			CaSecret: otherCa,
+		})
+	}
+
+	block, _ := pem.Decode(identity.PemCerts[0])
+	if block == nil {
+		return nil, errors.New("could not decode the identity certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not extract info about certificate")
+	}
+
+	return &certs{
+		identity: identity,
+		ownCa: MeshCa{
+			Mesh:     mesh.GetMeta().GetName(),
+			CaSecret: ownCa,
+		},
+		otherCas: otherCas,
+		info: &Info{
+			Expiration:        cert.NotAfter,
+			Generation:        core.Now(),
+			Tags:              tags,
+			IssuedBackend:     issuedBackend,
+			SupportedBackends: supportedBackends,
+			OwnMesh: MeshInfo{
+				MTLS: mesh.Spec.GetMtls(),
+			},
+			OtherMeshInfos: otherMeshInfos,
+		},
+	}, nil
+}
@@ -0,0 +1,215 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package secrets_test
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"time"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	ca_issuer "github.com/apache/dubbo-kubernetes/pkg/core/ca/issuer"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	util_tls "github.com/apache/dubbo-kubernetes/pkg/tls"
+	. "github.com/apache/dubbo-kubernetes/pkg/xds/secrets"
+)
+
+type staticCaProvider struct {
+	ca *util_tls.KeyPair
+}
+
+func (s *staticCaProvider) Get(context.Context, *core_mesh.MeshResource) (*core_xds.CaSecret, []string, error) {
+	return &core_xds.CaSecret{PemCerts: [][]byte{s.ca.CertPEM}}, []string{"ca-1"}, nil
+}
+
+// countingIdentityProvider issues certificates valid for the given lifetime and counts the issued certificates.
+type countingIdentityProvider struct {
+	ca       *util_tls.KeyPair
+	lifetime time.Duration
+	issued   int
+}
+
+func (c *countingIdentityProvider) Get(_ context.Context, tags mesh_proto.MultiValueTagSet, mesh *core_mesh.MeshResource) (*core_xds.IdentitySecret, string, error) {
+	pair, err := ca_issuer.NewWorkloadCert(*c.ca, mesh.GetMeta().GetName(), tags, ca_issuer.WithExpirationTime(c.lifetime))
+	if err != nil {
+		return nil, "", err
+	}
+	c.issued++
+	return &core_xds.IdentitySecret{
+		PemCerts: [][]byte{pair.CertPEM},
+		PemKey:   pair.KeyPEM,
+	}, "ca-1", nil
+}
+
+var _ = Describe("Secrets", func() {
+	var secrets Secrets
+	var identityProvider *countingIdentityProvider
+	var now time.Time
+
+	newMesh := func(backend string) *core_mesh.MeshResource {
+		return &core_mesh.MeshResource{
+			Meta: &test_model.ResourceMeta{Name: core_model.DefaultMesh},
+			Spec: &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: backend,
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{Name: "ca-1", Type: "builtin"},
+						{Name: "ca-2", Type: "builtin"},
+					},
+				},
+			},
+		}
+	}
+
+	newDataplane := func(version string) *core_mesh.DataplaneResource {
+		return &core_mesh.DataplaneResource{
+			Meta: &test_model.ResourceMeta{Name: "backend-1", Mesh: core_model.DefaultMesh},
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "10.0.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+						Port: 20880,
+						Tags: map[string]string{
+							mesh_proto.ServiceTag: "backend",
+							"version":             version,
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+		core.Now = func() time.Time {
+			return now
+		}
+		ca, err := util_tls.GenerateCA(util_tls.DefaultKeyType, pkix.Name{CommonName: core_model.DefaultMesh})
+		Expect(err).ToNot(HaveOccurred())
+		identityProvider = &countingIdentityProvider{ca: ca, lifetime: 10 * time.Hour}
+		secrets = NewSecrets(&staticCaProvider{ca: ca}, identityProvider)
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	It("should generate a certificate on the first request and cache it", func() {
+		// given
+		mesh := newMesh("ca-1")
+		dataplane := newDataplane("v1")
+		key := core_model.MetaToResourceKey(dataplane.GetMeta())
+
+		// when
+		first, cas, err := secrets.GetForDataPlane(context.Background(), dataplane, mesh, nil)
+		Expect(err).ToNot(HaveOccurred())
+		second, _, err := secrets.GetForDataPlane(context.Background(), dataplane, mesh, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(identityProvider.issued).To(Equal(1))
+		Expect(second).To(BeIdenticalTo(first))
+		Expect(cas).To(HaveKey(core_model.DefaultMesh))
+		info := secrets.Info(key)
+		Expect(info.Generation).To(Equal(now))
+		Expect(info.Expiration).To(Equal(now.Add(10 * time.Hour).UTC()))
+		Expect(info.IssuedBackend).To(Equal("ca-1"))
+		Expect(info.SupportedBackends).To(ConsistOf("ca-1"))
+	})
+
+	DescribeTable("should rotate the certificate once 4/5 of its lifetime has passed",
+		func(elapsed time.Duration, expectedIssued int) {
+			// given
+			mesh := newMesh("ca-1")
+			dataplane := newDataplane("v1")
+			_, _, err := secrets.GetForDataPlane(context.Background(), dataplane, mesh, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			now = now.Add(elapsed)
+			_, _, err = secrets.GetForDataPlane(context.Background(), dataplane, mesh, nil)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identityProvider.issued).To(Equal(expectedIssued))
+		},
+		Entry("before 4/5 of the lifetime", 7*time.Hour+59*time.Minute, 1),
+		Entry("after 4/5 of the lifetime", 8*time.Hour+time.Minute, 2),
+	)
+
+	It("should regenerate the certificate when the tags of the dataplane change", func() {
+		// given
+		mesh := newMesh("ca-1")
+		_, _, err := secrets.GetForDataPlane(context.Background(), newDataplane("v1"), mesh, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, _, err = secrets.GetForDataPlane(context.Background(), newDataplane("v2"), mesh, nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(identityProvider.issued).To(Equal(2))
+	})
+
+	It("should regenerate the certificate when the mTLS settings of the mesh change", func() {
+		// given
+		dataplane := newDataplane("v1")
+		_, _, err := secrets.GetForDataPlane(context.Background(), dataplane, newMesh("ca-1"), nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, _, err = secrets.GetForDataPlane(context.Background(), dataplane, newMesh("ca-2"), nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(identityProvider.issued).To(Equal(2))
+	})
+
+	It("should forget the certificate on cleanup", func() {
+		// given
+		dataplane := newDataplane("v1")
+		key := core_model.MetaToResourceKey(dataplane.GetMeta())
+		_, _, err := secrets.GetForDataPlane(context.Background(), dataplane, newMesh("ca-1"), nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		secrets.Cleanup(key)
+
+		// then
+		Expect(secrets.Info(key)).To(BeNil())
+	})
+
+	It("should fail when mTLS is disabled", func() {
+		// when
+		_, _, err := secrets.GetForDataPlane(context.Background(), newDataplane("v1"), newMesh(""), nil)
+
+		// then
+		Expect(err).To(MatchError(`mTLS is not enabled in mesh "default"`))
+	})
+})
@@ -35,6 +35,7 @@ import (
 	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
 	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
 	"github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/secrets"
 )
 
 var sinkLog = core.Log.WithName("xds").WithName("sink")
@@ -46,8 +47,9 @@ type DataplaneInsightSink interface {
 type DataplaneInsightStore interface {
 	// Upsert creates or updates the subscription, storing it with
 	// the key dataplaneID. dataplaneType gives the resource type of
-	// the dataplane proxy that has subscribed.
-	Upsert(ctx context.Context, dataplaneType core_model.ResourceType, dataplaneID core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription) error
+	// the dataplane proxy that has subscribed. certInfo is set only
+	// when a new certificate was issued for the dataplane proxy.
+	Upsert(ctx context.Context, dataplaneType core_model.ResourceType, dataplaneID core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription, certInfo *secrets.Info) error
 }
 
 func NewDataplaneInsightSink(
@@ -57,6 +59,7 @@ func NewDataplaneInsightSink(
 	generationTicker func() *time.Ticker,
 	flushBackoff time.Duration,
 	store DataplaneInsightStore,
+	secrets secrets.Secrets,
 ) DataplaneInsightSink {
 	return &dataplaneInsightSink{
 		flushTicker:      newTicker,
@@ -65,6 +68,7 @@ func NewDataplaneInsightSink(
 		accessor:         accessor,
 		flushBackoff:     flushBackoff,
 		store:            store,
+		secrets:          secrets,
 	}
 }
 
@@ -76,6 +80,7 @@ type dataplaneInsightSink struct {
 	dataplaneType    core_model.ResourceType
 	accessor         SubscriptionStatusAccessor
 	store            DataplaneInsightStore
+	secrets          secrets.Secrets
 	flushBackoff     time.Duration
 }
 
@@ -87,6 +92,7 @@ func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
 	defer generationTicker.Stop()
 
 	var lastStoredState *mesh_proto.DiscoverySubscription
+	var lastStoredCertGeneration time.Time
 	var generation uint32
 
 	flush := func(closing bool) {
@@ -99,13 +105,20 @@ func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
 		}
 		currentState.Generation = generation
 
-		if proto.Equal(currentState, lastStoredState) {
+		var certInfo *secrets.Info
+		if s.dataplaneType == core_mesh.DataplaneType {
+			if info := s.secrets.Info(dataplaneID); info != nil && !info.Generation.Equal(lastStoredCertGeneration) {
+				certInfo = info
+			}
+		}
+
+		if proto.Equal(currentState, lastStoredState) && certInfo == nil {
 			return
 		}
 
 		ctx := context.TODO()
 
-		if err := s.store.Upsert(ctx, s.dataplaneType, dataplaneID, currentState); err != nil {
+		if err := s.store.Upsert(ctx, s.dataplaneType, dataplaneID, currentState, certInfo); err != nil {
 			switch {
 			case closing:
 				// When XDS stream is closed, Dataplane Status Tracker executes OnStreamClose which closes stop channel
@@ -127,6 +140,9 @@ func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
 		} else {
 			sinkLog.V(1).Info("DataplaneInsight saved", "dataplaneid", dataplaneID, "subscription", currentState)
 			lastStoredState = currentState
+			if certInfo != nil {
+				lastStoredCertGeneration = certInfo.Generation
+			}
 		}
 	}
 
@@ -161,7 +177,7 @@ type dataplaneInsightStore struct {
 	resManager manager.ResourceManager
 }
 
-func (s *dataplaneInsightStore) Upsert(ctx context.Context, dataplaneType core_model.ResourceType, dataplaneID core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription) error {
+func (s *dataplaneInsightStore) Upsert(ctx context.Context, dataplaneType core_model.ResourceType, dataplaneID core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription, certInfo *secrets.Info) error {
 	switch dataplaneType {
 	case core_mesh.ZoneIngressType:
 		return manager.Upsert(ctx, s.resManager, dataplaneID, core_mesh.NewZoneIngressInsightResource(), func(resource core_model.Resource) error {
@@ -174,6 +190,11 @@ func (s *dataplaneInsightStore) Upsert(ctx context.Context, dataplaneType core_m
 			if err := insight.Spec.UpdateSubscription(subscription); err != nil {
 				return err
 			}
+			if certInfo != nil {
+				if err := insight.Spec.UpdateCert(certInfo.Generation, certInfo.Expiration, certInfo.IssuedBackend, certInfo.SupportedBackends); err != nil {
+					return err
+				}
+			}
 			return nil
 		})
 	default:
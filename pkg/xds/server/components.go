@@ -29,6 +29,7 @@ import (
 	util_xds "github.com/apache/dubbo-kubernetes/pkg/util/xds"
 	"github.com/apache/dubbo-kubernetes/pkg/xds/cache/cla"
 	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/secrets"
 	v3 "github.com/apache/dubbo-kubernetes/pkg/xds/server/v3"
 )
 
@@ -39,6 +40,7 @@ var (
 	}
 	HashMeshIncludedGlobalResources = map[core_model.ResourceType]bool{
 		core_mesh.ZoneIngressType: true,
+		core_mesh.ZoneEgressType:  true,
 	}
 )
 
@@ -51,7 +53,11 @@ func RegisterXDS(rt core_runtime.Runtime) error {
 
 	envoyCpCtx := &xds_context.ControlPlaneContext{
 		CLACache: claCache,
-		Zone:     "",
+		Secrets: secrets.NewSecrets(
+			secrets.NewCaProvider(rt.CaManagers()),
+			secrets.NewIdentityProvider(rt.CaManagers()),
+		),
+		Zone: "",
 	}
 	if err := v3.RegisterXDS(statsCallbacks, envoyCpCtx, rt); err != nil {
 		return errors.Wrap(err, "could not register V3 XDS")
@@ -37,6 +37,7 @@ import (
 	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
 	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
 	"github.com/apache/dubbo-kubernetes/pkg/xds/generator"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/secrets"
 	xds_callbacks "github.com/apache/dubbo-kubernetes/pkg/xds/server/callbacks"
 	xds_sync "github.com/apache/dubbo-kubernetes/pkg/xds/sync"
 )
@@ -52,7 +53,8 @@ func RegisterXDS(
 	metadataTracker := xds_callbacks.NewDataplaneMetadataTracker()
 	reconciler := DefaultReconciler(rt, xdsContext, statsCallbacks)
 	ingressReconciler := DefaultIngressReconciler(rt, xdsContext, statsCallbacks)
-	watchdogFactory, err := xds_sync.DefaultDataplaneWatchdogFactory(rt, metadataTracker, reconciler, ingressReconciler, nil, envoyCpCtx, core_xds.APIVersion(envoy.APIV3))
+	egressReconciler := DefaultEgressReconciler(rt, xdsContext, statsCallbacks)
+	watchdogFactory, err := xds_sync.DefaultDataplaneWatchdogFactory(rt, metadataTracker, reconciler, ingressReconciler, egressReconciler, envoyCpCtx, core_xds.APIVersion(envoy.APIV3))
 	if err != nil {
 		return err
 	}
@@ -65,7 +67,7 @@ func RegisterXDS(
 		util_xds_v3.AdaptCallbacks(xds_callbacks.DataplaneCallbacksToXdsCallbacks(
 			xds_callbacks.NewDataplaneLifecycle(rt.AppContext(), rt.ResourceManager(), rt.Config().XdsServer.DataplaneDeregistrationDelay.Duration, rt.GetInstanceId())),
 		),
-		util_xds_v3.AdaptCallbacks(DefaultDataplaneStatusTracker(rt)),
+		util_xds_v3.AdaptCallbacks(DefaultDataplaneStatusTracker(rt, envoyCpCtx.Secrets)),
 		util_xds_v3.AdaptCallbacks(xds_callbacks.NewNackBackoff(10)),
 	}
 
@@ -107,7 +109,19 @@ func DefaultIngressReconciler(
 	}
 }
 
-func DefaultDataplaneStatusTracker(rt core_runtime.Runtime) xds_callbacks.DataplaneStatusTracker {
+func DefaultEgressReconciler(
+	rt core_runtime.Runtime,
+	xdsContext XdsContext,
+	statsCallbacks util_xds.StatsCallbacks,
+) xds_sync.SnapshotReconciler {
+	return &reconciler{
+		generator:      &TemplateSnapshotGenerator{[]string{generator.EgressProxy}},
+		cacher:         &simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()},
+		statsCallbacks: nil,
+	}
+}
+
+func DefaultDataplaneStatusTracker(rt core_runtime.Runtime, secrets secrets.Secrets) xds_callbacks.DataplaneStatusTracker {
 	return xds_callbacks.NewDataplaneStatusTracker(rt,
 		func(dataplaneType core_model.ResourceType, accessor xds_callbacks.SubscriptionStatusAccessor) xds_callbacks.DataplaneInsightSink {
 			return xds_callbacks.NewDataplaneInsightSink(
@@ -122,6 +136,7 @@ func DefaultDataplaneStatusTracker(rt core_runtime.Runtime) xds_callbacks.Datapl
 				},
 				rt.Config().XdsServer.DataplaneStatusFlushInterval.Duration/10,
 				xds_callbacks.NewDataplaneInsightStore(rt.ResourceManager()),
+				secrets,
 			)
 		})
 }
@@ -46,6 +46,17 @@ func DefaultIngressProxyBuilder(
 	}
 }
 
+func DefaultEgressProxyBuilder(
+	rt core_runtime.Runtime,
+	apiVersion core_xds.APIVersion,
+) *EgressProxyBuilder {
+	return &EgressProxyBuilder{
+		ResManager: rt.ReadOnlyResourceManager(),
+		apiVersion: apiVersion,
+		zone:       rt.Config().Multizone.Zone.Name,
+	}
+}
+
 func DefaultDataplaneWatchdogFactory(
 	rt core_runtime.Runtime,
 	metadataTracker DataplaneMetadataTracker,
@@ -67,11 +78,18 @@ func DefaultDataplaneWatchdogFactory(
 		apiVersion,
 	)
 
+	egressProxyBuilder := DefaultEgressProxyBuilder(
+		rt,
+		apiVersion,
+	)
+
 	deps := DataplaneWatchdogDependencies{
 		DataplaneProxyBuilder: dataplaneProxyBuilder,
 		DataplaneReconciler:   dataplaneReconciler,
 		IngressProxyBuilder:   ingressProxyBuilder,
 		IngressReconciler:     ingressReconciler,
+		EgressProxyBuilder:    egressProxyBuilder,
+		EgressReconciler:      egressReconciler,
 		EnvoyCpCtx:            envoyCpCtx,
 		MeshCache:             rt.MeshCache(),
 		MetadataTracker:       metadataTracker,
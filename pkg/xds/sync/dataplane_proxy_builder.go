@@ -23,9 +23,13 @@ import (
 
 import (
 	"github.com/pkg/errors"
+
+	"google.golang.org/protobuf/proto"
 )
 
 import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core/permissions"
 	core_plugins "github.com/apache/dubbo-kubernetes/pkg/core/plugins"
 	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
@@ -33,6 +37,7 @@ import (
 	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
 	"github.com/apache/dubbo-kubernetes/pkg/plugins/policies/core/ordered"
 	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	"github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
 )
 
 type DataplaneProxyBuilder struct {
@@ -40,11 +45,15 @@ type DataplaneProxyBuilder struct {
 	APIVersion core_xds.APIVersion
 }
 
-func (p *DataplaneProxyBuilder) Build(ctx context.Context, key core_model.ResourceKey, meshContext xds_context.MeshContext) (*core_xds.Proxy, error) {
+func (p *DataplaneProxyBuilder) Build(ctx context.Context, key core_model.ResourceKey, meshContext xds_context.MeshContext, metadata *core_xds.DataplaneMetadata) (*core_xds.Proxy, error) {
 	dp, found := meshContext.DataplanesByName[key.Name]
 	if !found {
 		return nil, core_store.ErrorResourceNotFound(core_mesh.DataplaneType, key.Name, key.Mesh)
 	}
+	// the services are reachable on their virtual IPs only when the proxy resolves their domains
+	if metadata.GetDNSPort() != 0 && len(meshContext.VIPOutbounds) > 0 {
+		dp = withVIPOutbounds(dp, meshContext.VIPOutbounds)
+	}
 
 	routing := p.resolveRouting(ctx, meshContext, dp)
 
@@ -57,19 +66,42 @@ func (p *DataplaneProxyBuilder) Build(ctx context.Context, key core_model.Resour
 		APIVersion: p.APIVersion,
 		Policies:   *matchedPolicies,
 		Dataplane:  dp,
+		Metadata:   metadata,
 		Routing:    *routing,
 		Zone:       p.Zone,
+		// only the own mesh is trusted, cross-mesh communication is not supported yet
+		SecretsTracker: envoy.NewSecretsTracker(meshContext.Resource.GetMeta().GetName(), []string{meshContext.Resource.GetMeta().GetName()}),
 	}
 	return proxy, nil
 }
 
+// withVIPOutbounds returns a copy of the Dataplane that also consumes the services on their virtual IPs.
+// The outbounds are copied as well, since the mesh context is shared by all the proxies of the mesh.
+func withVIPOutbounds(dataplane *core_mesh.DataplaneResource, vipOutbounds []*mesh_proto.Dataplane_Networking_Outbound) *core_mesh.DataplaneResource {
+	spec := proto.Clone(dataplane.Spec).(*mesh_proto.Dataplane)
+	if spec.Networking == nil {
+		spec.Networking = &mesh_proto.Dataplane_Networking{}
+	}
+	for _, outbound := range vipOutbounds {
+		spec.Networking.Outbound = append(spec.Networking.Outbound, proto.Clone(outbound).(*mesh_proto.Dataplane_Networking_Outbound))
+	}
+	return &core_mesh.DataplaneResource{
+		Meta: dataplane.Meta,
+		Spec: spec,
+	}
+}
+
 func (p *DataplaneProxyBuilder) resolveRouting(
 	ctx context.Context,
 	meshContext xds_context.MeshContext,
 	dataplane *core_mesh.DataplaneResource,
 ) *core_xds.Routing {
-	// external services may not necessarily be in the same mesh
+	// when ZoneEgress is enabled external services are reached through it,
+	// so their endpoints are already a part of the mesh endpoint map
 	endpointMap := core_xds.EndpointMap{}
+	if !meshContext.Resource.ZoneEgressEnabled() {
+		endpointMap = meshContext.ExternalServicesEndpointMap
+	}
 
 	routing := &core_xds.Routing{
 		OutboundTargets:                meshContext.EndpointMap,
@@ -82,7 +114,8 @@ func (p *DataplaneProxyBuilder) resolveRouting(
 func (p *DataplaneProxyBuilder) matchPolicies(meshContext xds_context.MeshContext, dataplane *core_mesh.DataplaneResource, outboundSelectors core_xds.DestinationMap) (*core_xds.MatchedPolicies, error) {
 	resources := meshContext.Resources
 	matchedPolicies := &core_xds.MatchedPolicies{
-		Dynamic: core_xds.PluginOriginatedPolicies{},
+		AuthorizationPolicies: permissions.MatchAuthorizationPolicies(dataplane, resources.AuthorizationPolicies().Items),
+		Dynamic:               core_xds.PluginOriginatedPolicies{},
 	}
 	for _, p := range core_plugins.Plugins().PolicyPlugins(ordered.Policies) {
 		res, err := p.Plugin.MatchedPolicies(dataplane, resources)
@@ -42,6 +42,8 @@ type DataplaneWatchdogDependencies struct {
 	DataplaneReconciler   SnapshotReconciler
 	IngressProxyBuilder   *IngressProxyBuilder
 	IngressReconciler     SnapshotReconciler
+	EgressProxyBuilder    *EgressProxyBuilder
+	EgressReconciler      SnapshotReconciler
 	EnvoyCpCtx            *xds_context.ControlPlaneContext
 	MetadataTracker       DataplaneMetadataTracker
 	ResManager            core_manager.ReadOnlyResourceManager
@@ -107,9 +109,13 @@ func (d *DataplaneWatchdog) Cleanup() error {
 	proxyID := core_xds.FromResourceKey(d.key)
 	switch d.dpType {
 	case mesh_proto.DataplaneProxyType:
+		d.EnvoyCpCtx.Secrets.Cleanup(d.key)
 		return d.DataplaneReconciler.Clear(&proxyID)
 	case mesh_proto.IngressProxyType:
 		return d.IngressReconciler.Clear(&proxyID)
+	case mesh_proto.EgressProxyType:
+		d.EnvoyCpCtx.Secrets.Cleanup(d.key)
+		return d.EgressReconciler.Clear(&proxyID)
 	default:
 		return nil
 	}
@@ -156,7 +162,55 @@ func (d *DataplaneWatchdog) syncIngress(ctx context.Context, metadata *core_xds.
 }
 
 func (d *DataplaneWatchdog) syncEgress(ctx context.Context, metadata *core_xds.DataplaneMetadata) (SyncResult, error) {
-	return SyncResult{}, nil
+	envoyCtx := &xds_context.Context{
+		ControlPlane: d.EnvoyCpCtx,
+		Mesh:         xds_context.MeshContext{}, // ZoneEgress does not have a mesh!
+	}
+
+	aggregatedMeshCtxs, err := xds_context.AggregateMeshContexts(ctx, d.ResManager, d.MeshCache.GetMeshContext)
+	if err != nil {
+		return SyncResult{}, errors.Wrap(err, "could not aggregate mesh contexts")
+	}
+
+	result := SyncResult{
+		ProxyType: mesh_proto.EgressProxyType,
+	}
+	syncForConfig := aggregatedMeshCtxs.Hash != d.lastHash
+	// check if we need to regenerate config because a certificate ZoneEgress presents to data plane proxies is about to expire.
+	syncForCert := false
+	for _, mesh := range aggregatedMeshCtxs.Meshes {
+		if certInfo := d.EnvoyCpCtx.Secrets.ZoneEgressInfo(d.key, mesh.GetMeta().GetName()); certInfo != nil && certInfo.ExpiringSoon() {
+			syncForCert = true
+		}
+	}
+	if !syncForConfig && !syncForCert {
+		result.Status = SkipStatus
+		return result, nil
+	}
+	if syncForConfig {
+		d.log.V(1).Info("snapshot hash updated, reconcile", "prev", d.lastHash, "current", aggregatedMeshCtxs.Hash)
+	}
+	if syncForCert {
+		d.log.V(1).Info("certs expiring soon, reconcile")
+	}
+
+	proxy, err := d.EgressProxyBuilder.Build(ctx, d.key, aggregatedMeshCtxs)
+	if err != nil {
+		return SyncResult{}, errors.Wrap(err, "could not build egress proxy")
+	}
+	proxy.Metadata = metadata
+	changed, err := d.EgressReconciler.Reconcile(ctx, *envoyCtx, proxy)
+	if err != nil {
+		return SyncResult{}, errors.Wrap(err, "could not reconcile")
+	}
+	d.lastHash = aggregatedMeshCtxs.Hash
+
+	if changed {
+		result.Status = ChangedStatus
+	} else {
+		result.Status = GeneratedStatus
+	}
+	return result, nil
 }
 
 // syncDataplane syncs state of the Dataplane.
@@ -166,28 +220,39 @@ func (d *DataplaneWatchdog) syncDataplane(ctx context.Context, metadata *core_xd
 	if err != nil {
 		return SyncResult{}, errors.Wrap(err, "could not get mesh context")
 	}
+	if !meshCtx.Resource.MTLSEnabled() {
+		d.EnvoyCpCtx.Secrets.Cleanup(d.key) // we need to cleanup secrets if mtls is disabled
+	}
+
 	// check if we need to regenerate config because Dubbo policies has changed.
 	syncForConfig := meshCtx.Hash != d.lastHash
+	// check if we need to regenerate config because the certificate of the Dataplane is about to expire.
+	syncForCert := false
+	if certInfo := d.EnvoyCpCtx.Secrets.Info(d.key); certInfo != nil {
+		syncForCert = certInfo.ExpiringSoon()
+	}
 	result := SyncResult{
 		ProxyType: mesh_proto.DataplaneProxyType,
 	}
-	if !syncForConfig {
+	if !syncForConfig && !syncForCert {
 		result.Status = SkipStatus
 		return result, nil
 	}
 	if syncForConfig {
 		d.log.V(1).Info("snapshot hash updated, reconcile", "prev", d.lastHash, "current", meshCtx.Hash)
 	}
+	if syncForCert {
+		d.log.V(1).Info("certs expiring soon, reconcile")
+	}
 
 	envoyCtx := &xds_context.Context{
 		ControlPlane: d.EnvoyCpCtx,
 		Mesh:         meshCtx,
 	}
-	proxy, err := d.DataplaneProxyBuilder.Build(ctx, d.key, meshCtx)
+	proxy, err := d.DataplaneProxyBuilder.Build(ctx, d.key, meshCtx, metadata)
 	if err != nil {
 		return SyncResult{}, errors.Wrap(err, "could not build dataplane proxy")
 	}
-	proxy.Metadata = metadata
 	changed, err := d.DataplaneReconciler.Reconcile(ctx, *envoyCtx, proxy)
 	if err != nil {
 		return SyncResult{}, errors.Wrap(err, "could not reconcile")
@@ -0,0 +1,91 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync
+
+import (
+	"context"
+)
+
+import (
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	xds_topology "github.com/apache/dubbo-kubernetes/pkg/xds/topology"
+)
+
+type EgressProxyBuilder struct {
+	ResManager manager.ReadOnlyResourceManager
+
+	apiVersion core_xds.APIVersion
+	zone       string
+}
+
+func (p *EgressProxyBuilder) Build(
+	ctx context.Context,
+	key core_model.ResourceKey,
+	aggregatedMeshCtxs xds_context.AggregatedMeshContexts,
+) (*core_xds.Proxy, error) {
+	zoneEgress := core_mesh.NewZoneEgressResource()
+	if err := p.ResManager.Get(ctx, zoneEgress, core_store.GetBy(key)); err != nil {
+		return nil, err
+	}
+
+	proxy := &core_xds.Proxy{
+		Id:              core_xds.FromResourceKey(key),
+		APIVersion:      p.apiVersion,
+		Zone:            p.zone,
+		ZoneEgressProxy: p.buildZoneEgressProxy(zoneEgress, aggregatedMeshCtxs),
+	}
+
+	return proxy, nil
+}
+
+func (p *EgressProxyBuilder) buildZoneEgressProxy(
+	zoneEgress *core_mesh.ZoneEgressResource,
+	aggregatedMeshCtxs xds_context.AggregatedMeshContexts,
+) *core_xds.ZoneEgressProxy {
+	var meshResourcesList []*core_xds.MeshEgressResources
+
+	zoneIngresses := aggregatedMeshCtxs.ZoneIngresses()
+	for _, mesh := range aggregatedMeshCtxs.Meshes {
+		// traffic of the mesh goes through ZoneEgress only when the mesh enables it
+		if !mesh.ZoneEgressEnabled() {
+			continue
+		}
+
+		meshName := mesh.GetMeta().GetName()
+		meshCtx := aggregatedMeshCtxs.MustGetMeshContext(meshName)
+
+		meshResources := &core_xds.MeshEgressResources{
+			Mesh:                        mesh,
+			EndpointMap:                 xds_topology.BuildEgressEndpointMap(p.zone, meshName, zoneIngresses),
+			ExternalServicesEndpointMap: meshCtx.ExternalServicesEndpointMap,
+			Resources:                   meshCtx.Resources.MeshLocalResources,
+		}
+
+		meshResourcesList = append(meshResourcesList, meshResources)
+	}
+
+	return &core_xds.ZoneEgressProxy{
+		ZoneEgressResource: zoneEgress,
+		MeshResourcesList:  meshResourcesList,
+	}
+}
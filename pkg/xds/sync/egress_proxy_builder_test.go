@@ -0,0 +1,145 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package sync
+
+import (
+	"context"
+)
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	"github.com/apache/dubbo-kubernetes/pkg/core/resources/manager"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_store "github.com/apache/dubbo-kubernetes/pkg/core/resources/store"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	"github.com/apache/dubbo-kubernetes/pkg/plugins/resources/memory"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	xds_context "github.com/apache/dubbo-kubernetes/pkg/xds/context"
+	envoy_common "github.com/apache/dubbo-kubernetes/pkg/xds/envoy"
+)
+
+var _ = Describe("EgressProxyBuilder", func() {
+	var builder *EgressProxyBuilder
+
+	BeforeEach(func() {
+		store := memory.NewStore()
+		zoneEgress := &core_mesh.ZoneEgressResource{
+			Spec: &mesh_proto.ZoneEgress{
+				Zone: "zone-1",
+				Networking: &mesh_proto.ZoneEgress_Networking{
+					Address: "192.168.0.100",
+					Port:    10002,
+				},
+			},
+		}
+		Expect(store.Create(context.Background(), zoneEgress, core_store.CreateByKey("zone-1-egress", core_model.NoMesh))).To(Succeed())
+
+		builder = &EgressProxyBuilder{
+			ResManager: manager.NewResourceManager(store),
+			apiVersion: core_xds.APIVersion(envoy_common.APIV3),
+			zone:       "zone-1",
+		}
+	})
+
+	meshContext := func(mesh *core_mesh.MeshResource, zoneIngresses ...*core_mesh.ZoneIngressResource) xds_context.MeshContext {
+		resources := xds_context.NewResources()
+		resources.MeshLocalResources[core_mesh.ZoneIngressType] = &core_mesh.ZoneIngressResourceList{Items: zoneIngresses}
+		return xds_context.MeshContext{
+			Resource:  mesh,
+			Resources: resources,
+			ExternalServicesEndpointMap: core_xds.EndpointMap{
+				"httpbin": {{Target: "httpbin.org", Port: 443, Weight: 1}},
+			},
+		}
+	}
+
+	It("should serve only the meshes with zoneEgress enabled", func() {
+		// given
+		withEgress := &core_mesh.MeshResource{
+			Meta: &test_model.ResourceMeta{Name: "with-egress"},
+			Spec: &mesh_proto.Mesh{Routing: &mesh_proto.Routing{ZoneEgress: true}},
+		}
+		withoutEgress := &core_mesh.MeshResource{
+			Meta: &test_model.ResourceMeta{Name: "without-egress"},
+			Spec: &mesh_proto.Mesh{},
+		}
+		zoneIngress := &core_mesh.ZoneIngressResource{
+			Meta: &test_model.ResourceMeta{Name: "zone-2-ingress"},
+			Spec: &mesh_proto.ZoneIngress{
+				Zone: "zone-2",
+				Networking: &mesh_proto.ZoneIngress_Networking{
+					AdvertisedAddress: "2.2.2.2",
+					AdvertisedPort:    10001,
+				},
+				AvailableServices: []*mesh_proto.ZoneIngress_AvailableService{
+					{
+						Mesh:      "with-egress",
+						Instances: 1,
+						Tags:      map[string]string{mesh_proto.ServiceTag: "backend"},
+					},
+					{
+						Mesh:      "without-egress",
+						Instances: 1,
+						Tags:      map[string]string{mesh_proto.ServiceTag: "web"},
+					},
+				},
+			},
+		}
+		aggregated := xds_context.AggregatedMeshContexts{
+			Meshes: []*core_mesh.MeshResource{withEgress, withoutEgress},
+			MeshContextsByName: map[string]xds_context.MeshContext{
+				"with-egress":    meshContext(withEgress, zoneIngress),
+				"without-egress": meshContext(withoutEgress, zoneIngress),
+			},
+		}
+
+		// when
+		proxy, err := builder.Build(context.Background(), core_model.ResourceKey{Name: "zone-1-egress"}, aggregated)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proxy.Zone).To(Equal("zone-1"))
+		Expect(proxy.ZoneEgressProxy.ZoneEgressResource.Spec.GetNetworking().GetPort()).To(Equal(uint32(10002)))
+		Expect(proxy.ZoneEgressProxy.MeshResourcesList).To(HaveLen(1))
+		meshResources := proxy.ZoneEgressProxy.MeshResourcesList[0]
+		Expect(meshResources.Mesh).To(BeIdenticalTo(withEgress))
+		Expect(meshResources.EndpointMap).To(Equal(core_xds.EndpointMap{
+			"backend": {{
+				Target: "2.2.2.2",
+				Port:   10001,
+				Tags:   map[string]string{mesh_proto.ServiceTag: "backend"},
+				Weight: 1,
+			}},
+		}))
+		Expect(meshResources.ExternalServicesEndpointMap).To(HaveKey("httpbin"))
+	})
+
+	It("should fail when the ZoneEgress does not exist", func() {
+		// when
+		_, err := builder.Build(context.Background(), core_model.ResourceKey{Name: "unknown"}, xds_context.AggregatedMeshContexts{})
+
+		// then
+		Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+	})
+})
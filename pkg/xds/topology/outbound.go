@@ -17,24 +17,186 @@
 
 package topology
 
+import (
+	"context"
+	"net"
+	"strconv"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
 import (
 	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	system_proto "github.com/apache/dubbo-kubernetes/api/system/v1alpha1"
+	"github.com/apache/dubbo-kubernetes/pkg/core"
+	"github.com/apache/dubbo-kubernetes/pkg/core/datasource"
 	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
 	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
 )
 
+var outboundLog = core.Log.WithName("xds").WithName("topology").WithName("outbound")
+
+// BuildEdsEndpoint builds the endpoints of the services reachable from the data plane proxies of the mesh.
+// Services of other zones are added only when crossZone is set, that is when the control plane
+// is a zone of a multi-zone deployment. Otherwise only the local data plane proxies are used.
 func BuildEdsEndpoint(
 	localZone string,
+	crossZone bool,
+	mesh *core_mesh.MeshResource,
 	dataplanes []*core_mesh.DataplaneResource,
 	zoneIngresses []*core_mesh.ZoneIngressResource,
+	zoneEgresses []*core_mesh.ZoneEgressResource,
+	externalServices []*core_mesh.ExternalServiceResource,
+) core_xds.EndpointMap {
+	outbound := core_xds.EndpointMap{}
+
+	var ingressInstances uint32
+	if crossZone {
+		if mesh.ZoneEgressEnabled() {
+			ingressInstances = fillEgressOutbounds(outbound, zoneIngresses, zoneEgresses, localZone, mesh.GetMeta().GetName())
+		} else {
+			ingressInstances = fillIngressOutbounds(outbound, zoneIngresses, localZone, mesh.GetMeta().GetName())
+		}
+	}
+	if mesh.ZoneEgressEnabled() {
+		fillExternalServicesOutboundsThroughEgress(outbound, externalServices, zoneEgresses, localZone)
+	}
+
+	endpointWeight := uint32(1)
+	if ingressInstances > 0 {
+		endpointWeight = ingressInstances
+	}
+	fillDataplaneOutbounds(outbound, dataplanes, endpointWeight, localZone)
+
+	return outbound
+}
+
+// BuildEgressEndpointMap builds the endpoints ZoneEgress forwards the traffic of the mesh to.
+// Services of other zones are always reached through the public address of their ZoneIngress.
+func BuildEgressEndpointMap(
+	localZone string,
+	meshName string,
+	zoneIngresses []*core_mesh.ZoneIngressResource,
 ) core_xds.EndpointMap {
 	outbound := core_xds.EndpointMap{}
 
-	fillDataplaneOutbounds(outbound, dataplanes, 1, localZone)
+	fillIngressOutbounds(outbound, zoneIngresses, localZone, meshName)
 
 	return outbound
 }
 
+// BuildExternalServicesEndpointMap builds the endpoints of the external services reachable from the zone.
+// Dataplanes use them to reach external services directly when ZoneEgress is disabled,
+// otherwise ZoneEgress uses them to forward the traffic of the mesh.
+func BuildExternalServicesEndpointMap(
+	ctx context.Context,
+	mesh *core_mesh.MeshResource,
+	externalServices []*core_mesh.ExternalServiceResource,
+	loader datasource.Loader,
+	zone string,
+) core_xds.EndpointMap {
+	outbound := core_xds.EndpointMap{}
+	for _, externalService := range externalServices {
+		if !externalService.IsReachableFromZone(zone) {
+			continue
+		}
+		endpoint, err := NewExternalServiceEndpoint(ctx, externalService, mesh, loader, zone)
+		if err != nil {
+			outboundLog.Error(err, "unable to create ExternalService endpoint. Endpoint won't be included in the XDS.", "name", externalService.Meta.GetName(), "mesh", externalService.Meta.GetMesh())
+			continue
+		}
+		service := externalService.Spec.GetService()
+		outbound[service] = append(outbound[service], *endpoint)
+	}
+	return outbound
+}
+
+func NewExternalServiceEndpoint(
+	ctx context.Context,
+	externalService *core_mesh.ExternalServiceResource,
+	mesh *core_mesh.MeshResource,
+	loader datasource.Loader,
+	zone string,
+) (*core_xds.Endpoint, error) {
+	spec := externalService.Spec
+	tls := spec.GetNetworking().GetTls()
+	meshName := mesh.GetMeta().GetName()
+
+	caCert, err := loadBytes(ctx, tls.GetCaCert(), meshName, loader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load caCert")
+	}
+	clientCert, err := loadBytes(ctx, tls.GetClientCert(), meshName, loader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load clientCert")
+	}
+	clientKey, err := loadBytes(ctx, tls.GetClientKey(), meshName, loader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load clientKey")
+	}
+
+	tags := cloneTags(spec.GetTags())
+	return &core_xds.Endpoint{
+		Target:   externalService.GetHost(),
+		Port:     externalService.GetPortUInt32(),
+		Tags:     tags,
+		Weight:   1,
+		Locality: GetLocality(zone, getZone(tags), true),
+		ExternalService: &core_xds.ExternalService{
+			TLSEnabled:               tls.GetEnabled(),
+			CaCert:                   caCert,
+			ClientCert:               clientCert,
+			ClientKey:                clientKey,
+			AllowRenegotiation:       tls.GetAllowRenegotiation(),
+			SkipHostnameVerification: tls.GetSkipHostnameVerification(),
+			ServerName:               tls.GetServerName().GetValue(),
+		},
+	}, nil
+}
+
+func loadBytes(ctx context.Context, source *system_proto.DataSource, meshName string, loader datasource.Loader) ([]byte, error) {
+	if source == nil {
+		return nil, nil
+	}
+	return loader.Load(ctx, meshName, source)
+}
+
+// fillExternalServicesOutboundsThroughEgress adds an endpoint pointing to every ZoneEgress
+// of the local zone for every external service, so the traffic leaves the mesh through ZoneEgress.
+func fillExternalServicesOutboundsThroughEgress(
+	outbound core_xds.EndpointMap,
+	externalServices []*core_mesh.ExternalServiceResource,
+	zoneEgresses []*core_mesh.ZoneEgressResource,
+	localZone string,
+) {
+	for _, externalService := range externalServices {
+		if !externalService.IsReachableFromZone(localZone) {
+			continue
+		}
+		serviceTags := externalService.Spec.GetTags()
+		serviceName := externalService.Spec.GetService()
+
+		for _, ze := range zoneEgresses {
+			if ze.IsRemoteEgress(localZone) {
+				continue
+			}
+			zeNetworking := ze.Spec.GetNetworking()
+
+			outbound[serviceName] = append(outbound[serviceName], core_xds.Endpoint{
+				Target:   zeNetworking.GetAddress(),
+				Port:     zeNetworking.GetPort(),
+				Tags:     cloneTags(serviceTags),
+				Weight:   1,
+				Locality: GetLocality(localZone, getZone(serviceTags), true),
+				// the endpoint is marked as an external service, so the TLS is terminated by ZoneEgress
+				ExternalService: &core_xds.ExternalService{},
+			})
+		}
+	}
+}
+
 // endpointWeight defines default weight for in-cluster endpoint.
 // Examples of having service "backend":
 //  1. Single-zone deployment, 2 instances in one cluster (zone1)
@@ -83,6 +245,93 @@ func fillDataplaneOutbounds(
 	}
 }
 
+// fillIngressOutbounds adds an endpoint pointing to the public address of the ZoneIngress
+// for every service available in other zones. It returns the number of ZoneIngress instances.
+func fillIngressOutbounds(
+	outbound core_xds.EndpointMap,
+	zoneIngresses []*core_mesh.ZoneIngressResource,
+	localZone string,
+	meshName string,
+) uint32 {
+	ziInstances := map[string]struct{}{}
+
+	for _, zi := range zoneIngresses {
+		if !zi.IsRemoteIngress(localZone) || !zi.HasPublicAddress() {
+			continue
+		}
+
+		ziNetworking := zi.Spec.GetNetworking()
+		ziAddress := ziNetworking.GetAdvertisedAddress()
+		ziPort := ziNetworking.GetAdvertisedPort()
+		ziInstances[net.JoinHostPort(ziAddress, strconv.FormatUint(uint64(ziPort), 10))] = struct{}{}
+
+		for _, service := range zi.Spec.GetAvailableServices() {
+			if service.GetMesh() != meshName {
+				continue
+			}
+			serviceTags := cloneTags(service.GetTags())
+			serviceName := serviceTags[mesh_proto.ServiceTag]
+
+			outbound[serviceName] = append(outbound[serviceName], core_xds.Endpoint{
+				Target:   ziAddress,
+				Port:     ziPort,
+				Tags:     serviceTags,
+				Weight:   service.GetInstances(),
+				Locality: GetLocality(localZone, getZone(serviceTags), true),
+			})
+		}
+	}
+
+	return uint32(len(ziInstances))
+}
+
+// fillEgressOutbounds adds an endpoint pointing to every ZoneEgress of the local zone
+// for every service available in other zones, so the traffic leaves the zone through ZoneEgress.
+// It returns the number of remote ZoneIngress instances the traffic is eventually balanced between.
+func fillEgressOutbounds(
+	outbound core_xds.EndpointMap,
+	zoneIngresses []*core_mesh.ZoneIngressResource,
+	zoneEgresses []*core_mesh.ZoneEgressResource,
+	localZone string,
+	meshName string,
+) uint32 {
+	ziInstances := map[string]struct{}{}
+
+	for _, zi := range zoneIngresses {
+		if !zi.IsRemoteIngress(localZone) || !zi.HasPublicAddress() {
+			continue
+		}
+
+		ziNetworking := zi.Spec.GetNetworking()
+		ziInstances[net.JoinHostPort(ziNetworking.GetAdvertisedAddress(), strconv.FormatUint(uint64(ziNetworking.GetAdvertisedPort()), 10))] = struct{}{}
+
+		for _, service := range zi.Spec.GetAvailableServices() {
+			if service.GetMesh() != meshName {
+				continue
+			}
+			serviceName := service.GetTags()[mesh_proto.ServiceTag]
+
+			for _, ze := range zoneEgresses {
+				if ze.IsRemoteEgress(localZone) {
+					continue
+				}
+				serviceTags := cloneTags(service.GetTags())
+				zeNetworking := ze.Spec.GetNetworking()
+
+				outbound[serviceName] = append(outbound[serviceName], core_xds.Endpoint{
+					Target:   zeNetworking.GetAddress(),
+					Port:     zeNetworking.GetPort(),
+					Tags:     serviceTags,
+					Weight:   service.GetInstances(),
+					Locality: GetLocality(localZone, getZone(serviceTags), true),
+				})
+			}
+		}
+	}
+
+	return uint32(len(ziInstances))
+}
+
 func cloneTags(tags map[string]string) map[string]string {
 	result := map[string]string{}
 	for tag, value := range tags {
@@ -0,0 +1,245 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package topology_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+import (
+	mesh_proto "github.com/apache/dubbo-kubernetes/api/mesh/v1alpha1"
+	core_mesh "github.com/apache/dubbo-kubernetes/pkg/core/resources/apis/mesh"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+	core_xds "github.com/apache/dubbo-kubernetes/pkg/core/xds"
+	test_model "github.com/apache/dubbo-kubernetes/pkg/test/resources/model"
+	. "github.com/apache/dubbo-kubernetes/pkg/xds/topology"
+)
+
+var _ = Describe("BuildEdsEndpoint", func() {
+	newMesh := func(zoneEgress bool) *core_mesh.MeshResource {
+		return &core_mesh.MeshResource{
+			Meta: &test_model.ResourceMeta{Name: core_model.DefaultMesh},
+			Spec: &mesh_proto.Mesh{
+				Routing: &mesh_proto.Routing{ZoneEgress: zoneEgress},
+			},
+		}
+	}
+
+	dataplanes := []*core_mesh.DataplaneResource{{
+		Meta: &test_model.ResourceMeta{Name: "backend-1", Mesh: core_model.DefaultMesh},
+		Spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+					Port: 20880,
+					Tags: map[string]string{
+						mesh_proto.ServiceTag: "backend",
+						mesh_proto.ZoneTag:    "zone-1",
+					},
+				}},
+			},
+		},
+	}}
+
+	zoneIngress := func(name, zone, advertisedAddress string) *core_mesh.ZoneIngressResource {
+		return &core_mesh.ZoneIngressResource{
+			Meta: &test_model.ResourceMeta{Name: name},
+			Spec: &mesh_proto.ZoneIngress{
+				Zone: zone,
+				Networking: &mesh_proto.ZoneIngress_Networking{
+					Address:           "10.0.0.1",
+					Port:              10001,
+					AdvertisedAddress: advertisedAddress,
+					AdvertisedPort:    10001,
+				},
+				AvailableServices: []*mesh_proto.ZoneIngress_AvailableService{
+					{
+						Mesh:      core_model.DefaultMesh,
+						Instances: 2,
+						Tags: map[string]string{
+							mesh_proto.ServiceTag: "backend",
+							mesh_proto.ZoneTag:    zone,
+						},
+					},
+					{
+						Mesh:      "other",
+						Instances: 1,
+						Tags: map[string]string{
+							mesh_proto.ServiceTag: "backend",
+							mesh_proto.ZoneTag:    zone,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	zoneIngresses := []*core_mesh.ZoneIngressResource{
+		zoneIngress("zone-2-ingress", "zone-2", "2.2.2.2"),
+		zoneIngress("zone-1-ingress", "zone-1", "1.1.1.1"),
+		zoneIngress("zone-3-ingress-without-public-address", "zone-3", ""),
+	}
+
+	zoneEgresses := []*core_mesh.ZoneEgressResource{
+		{
+			Meta: &test_model.ResourceMeta{Name: "zone-1-egress"},
+			Spec: &mesh_proto.ZoneEgress{
+				Zone:       "zone-1",
+				Networking: &mesh_proto.ZoneEgress_Networking{Address: "192.168.0.100", Port: 10002},
+			},
+		},
+		{
+			Meta: &test_model.ResourceMeta{Name: "zone-2-egress"},
+			Spec: &mesh_proto.ZoneEgress{
+				Zone:       "zone-2",
+				Networking: &mesh_proto.ZoneEgress_Networking{Address: "192.168.1.100", Port: 10002},
+			},
+		},
+	}
+
+	localEndpoint := func(weight uint32) core_xds.Endpoint {
+		return core_xds.Endpoint{
+			Target: "192.168.0.1",
+			Port:   20880,
+			Tags: map[string]string{
+				mesh_proto.ServiceTag: "backend",
+				mesh_proto.ZoneTag:    "zone-1",
+			},
+			Weight:   weight,
+			Locality: &core_xds.Locality{Zone: "zone-1", Priority: 0},
+		}
+	}
+
+	remoteTags := map[string]string{
+		mesh_proto.ServiceTag: "backend",
+		mesh_proto.ZoneTag:    "zone-2",
+	}
+
+	type testCase struct {
+		crossZone  bool
+		zoneEgress bool
+		expected   core_xds.EndpointMap
+	}
+
+	DescribeTable("should build the endpoints of the services",
+		func(given testCase) {
+			// when
+			endpoints := BuildEdsEndpoint("zone-1", given.crossZone, newMesh(given.zoneEgress), dataplanes, zoneIngresses, zoneEgresses, nil)
+
+			// then
+			Expect(endpoints).To(Equal(given.expected))
+		},
+		Entry("only local data plane proxies without cross-zone", testCase{
+			crossZone:  false,
+			zoneEgress: true,
+			expected: core_xds.EndpointMap{
+				"backend": {localEndpoint(1)},
+			},
+		}),
+		Entry("remote services through the public address of ZoneIngress", testCase{
+			crossZone:  true,
+			zoneEgress: false,
+			expected: core_xds.EndpointMap{
+				"backend": {
+					{
+						Target:   "2.2.2.2",
+						Port:     10001,
+						Tags:     remoteTags,
+						Weight:   2,
+						Locality: &core_xds.Locality{Zone: "zone-2", Priority: 1},
+					},
+					localEndpoint(1),
+				},
+			},
+		}),
+		Entry("remote services through the local ZoneEgress", testCase{
+			crossZone:  true,
+			zoneEgress: true,
+			expected: core_xds.EndpointMap{
+				"backend": {
+					{
+						Target:   "192.168.0.100",
+						Port:     10002,
+						Tags:     remoteTags,
+						Weight:   2,
+						Locality: &core_xds.Locality{Zone: "zone-2", Priority: 1},
+					},
+					localEndpoint(1),
+				},
+			},
+		}),
+	)
+
+	It("should weight the local endpoints by the number of remote ZoneIngress instances", func() {
+		// given
+		ingresses := append([]*core_mesh.ZoneIngressResource{
+			zoneIngress("zone-3-ingress", "zone-3", "3.3.3.3"),
+		}, zoneIngresses...)
+
+		// when
+		endpoints := BuildEdsEndpoint("zone-1", true, newMesh(false), dataplanes, ingresses, nil, nil)
+
+		// then
+		Expect(endpoints["backend"]).To(HaveLen(3))
+		Expect(endpoints["backend"][2]).To(Equal(localEndpoint(2)))
+	})
+})
+
+var _ = Describe("BuildEgressEndpointMap", func() {
+	It("should reach the services of other zones of the mesh through their ZoneIngress", func() {
+		// given
+		zoneIngresses := []*core_mesh.ZoneIngressResource{
+			{
+				Meta: &test_model.ResourceMeta{Name: "zone-2-ingress"},
+				Spec: &mesh_proto.ZoneIngress{
+					Zone: "zone-2",
+					Networking: &mesh_proto.ZoneIngress_Networking{
+						AdvertisedAddress: "2.2.2.2",
+						AdvertisedPort:    10001,
+					},
+					AvailableServices: []*mesh_proto.ZoneIngress_AvailableService{
+						{
+							Mesh:      core_model.DefaultMesh,
+							Instances: 3,
+							Tags:      map[string]string{mesh_proto.ServiceTag: "backend"},
+						},
+						{
+							Mesh:      "other",
+							Instances: 1,
+							Tags:      map[string]string{mesh_proto.ServiceTag: "web"},
+						},
+					},
+				},
+			},
+		}
+
+		// when
+		endpoints := BuildEgressEndpointMap("zone-1", core_model.DefaultMesh, zoneIngresses)
+
+		// then
+		Expect(endpoints).To(Equal(core_xds.EndpointMap{
+			"backend": {{
+				Target: "2.2.2.2",
+				Port:   10001,
+				Tags:   map[string]string{mesh_proto.ServiceTag: "backend"},
+				Weight: 3,
+			}},
+		}))
+	})
+})
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+import (
+	"github.com/spf13/cobra"
+)
+
+import (
+	"github.com/apache/dubbo-kubernetes/tools/policy-gen/generator/pkg/parse"
+	"github.com/apache/dubbo-kubernetes/tools/policy-gen/generator/pkg/save"
+)
+
+func newHelpers(rootArgs *args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "helpers",
+		Short: "Generate helpers for the policy",
+		Long:  "Generate the methods used by the rules to access the targetRef and the 'to' and 'from' items of the policy, or its single item when it has neither.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			policyName := filepath.Base(rootArgs.pluginDir)
+			policyPath := filepath.Join(rootArgs.pluginDir, "api", rootArgs.version, policyName+".go")
+			if _, err := os.Stat(policyPath); err != nil {
+				return err
+			}
+
+			pconfig, err := parse.Policy(policyPath)
+			if err != nil {
+				return err
+			}
+
+			outPath := filepath.Join(filepath.Dir(policyPath), "zz_generated.helpers.go")
+			return save.GoTemplate(helpersTemplate, pconfig, outPath)
+		},
+	}
+
+	return cmd
+}
+
+var helpersTemplate = template.Must(template.New("helpers").Parse(`
+// Generated by tools/policy-gen.
+// Run "make generate" to update this file.
+
+// nolint:whitespace
+package {{.Package}}
+
+import (
+	common_api "github.com/apache/dubbo-kubernetes/api/common/v1alpha1"
+	core_model "github.com/apache/dubbo-kubernetes/pkg/core/resources/model"
+)
+
+func (x *{{.Name}}) GetTargetRef() common_api.TargetRef {
+	return x.TargetRef
+}
+{{- if .HasTo }}
+
+func (x *To) GetTargetRef() common_api.TargetRef {
+	return x.TargetRef
+}
+
+func (x *To) GetDefault() interface{} {
+	return x.Default
+}
+
+func (x *{{.Name}}) GetToList() []core_model.PolicyItem {
+	var result []core_model.PolicyItem
+	for i := range x.To {
+		item := x.To[i]
+		result = append(result, &item)
+	}
+	return result
+}
+{{- end }}
+{{- if .HasFrom }}
+
+func (x *From) GetTargetRef() common_api.TargetRef {
+	return x.TargetRef
+}
+
+func (x *From) GetDefault() interface{} {
+	return x.Default
+}
+
+func (x *{{.Name}}) GetFromList() []core_model.PolicyItem {
+	var result []core_model.PolicyItem
+	for i := range x.From {
+		item := x.From[i]
+		result = append(result, &item)
+	}
+	return result
+}
+{{- end }}
+{{- if not (or .HasTo .HasFrom) }}
+
+func (x *{{.Name}}) GetDefault() interface{} {
+	return x.Default
+}
+
+func (x *{{.Name}}) GetPolicyItem() core_model.PolicyItem {
+	return &policyItem{
+		{{.Name}}: x,
+	}
+}
+
+// policyItem is an auxiliary struct with the implementation of the GetTargetRef() to always return the whole mesh,
+// because the top-level targetRef of a single item policy only selects the proxies.
+type policyItem struct {
+	*{{.Name}}
+}
+
+var _ core_model.PolicyItem = &policyItem{}
+
+func (p *policyItem) GetTargetRef() common_api.TargetRef {
+	return common_api.TargetRef{Kind: common_api.Mesh}
+}
+{{- end }}
+`))
@@ -33,6 +33,7 @@ func newRootCmd() *cobra.Command {
 	cmd.AddCommand(newK8sResource(rootArgs))
 	cmd.AddCommand(newOpenAPI(rootArgs))
 	cmd.AddCommand(newPluginFile(rootArgs))
+	cmd.AddCommand(newHelpers(rootArgs))
 
 	cmd.PersistentFlags().StringVar(&rootArgs.pluginDir, "plugin-dir", "", "path to the policy plugin director")
 	cmd.PersistentFlags().StringVar(&rootArgs.version, "version", "v1alpha1", "policy version")
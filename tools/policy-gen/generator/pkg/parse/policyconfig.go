@@ -28,6 +28,8 @@ type PolicyConfig struct {
 	Path                string
 	AlternativeNames    []string
 	GoModule            string
+	HasTo               bool
+	HasFrom             bool
 }
 
 func Policy(path string) (PolicyConfig, error) {
@@ -65,7 +67,23 @@ func Policy(path string) (PolicyConfig, error) {
 		return PolicyConfig{}, err
 	}
 
-	return newPolicyConfig(packageName, mainStruct.Name.String(), markers)
+	res, err := newPolicyConfig(packageName, mainStruct.Name.String(), markers)
+	if err != nil {
+		return PolicyConfig{}, err
+	}
+	if structType, ok := mainStruct.Type.(*ast.StructType); ok {
+		for _, field := range structType.Fields.List {
+			for _, name := range field.Names {
+				switch name.Name {
+				case "To":
+					res.HasTo = true
+				case "From":
+					res.HasFrom = true
+				}
+			}
+		}
+	}
+	return res, nil
 }
 
 func parseMarkers(cg *ast.CommentGroup) (map[string]string, error) {